@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"runtime/debug"
@@ -11,23 +12,44 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/gofiber/fiber/v2/middleware/monitor"
 	"github.com/gofiber/swagger"
 	"github.com/rahmatrdn/go-skeleton/config"
 	_ "github.com/rahmatrdn/go-skeleton/docs"
 	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	grpc_handler "github.com/rahmatrdn/go-skeleton/internal/grpc/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/rahmatrdn/go-skeleton/internal/health"
 	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
 	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
 	"github.com/rahmatrdn/go-skeleton/internal/parser"
 	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/queue/consumer"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/memory"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/redis"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
 	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
+	"github.com/rahmatrdn/go-skeleton/proto/pb"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/helmet"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/subosito/gotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 func init() {
@@ -51,6 +73,13 @@ func main() {
 	// Initialize config variable from .env file
 	cfg := config.NewConfig()
 
+	// OpenTelemetry tracing (if needed), see TRACING_EXPORTER
+	shutdownTracing, err := config.NewTracerProvider(context.Background(), cfg, "api")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
 	app := fiber.New(config.NewFiberConfiguration(cfg))
 	app.Get("/apidoc/*", swagger.HandlerDefault)
 
@@ -61,14 +90,14 @@ func main() {
 	// logger = logger.WithOptions(zap.AddCallerSkip(1))
 
 	presenterJson := json.NewJsonPresenter()
+	// Errors are written as application/problem+json to clients accepting it, see PROBLEM_DETAILS_DEFAULT
+	json.UseProblemDetails(cfg.ProblemDetailsOption.Default, cfg.ProblemDetailsOption.TypeBaseURL)
+	// Causes of internal errors are only sent to clients outside production
+	json.ExposeInternalErrors(cfg.AppEnv != "production")
+	// Validation messages are in the language of Accept-Language, see DEFAULT_LANGUAGE
+	i18n.SetDefault(cfg.LanguageOption.Default)
 	parser := parser.NewParser()
 
-	// RabbitMQ Configuration (if needed)
-	// queue, err := config.NewRabbitMQInstance(context.Background(), &cfg.RabbitMQOption)
-	// if err != nil {zp
-	// 	log.Fatal(err)
-	// }
-
 	// Redis Configuration (if needed)
 	// redisDB := config.NewRedis(&cfg.RedisOption)
 
@@ -79,12 +108,47 @@ func main() {
 		log.Fatal(err)
 	}
 
-	// PostgreSQL Initialization
-	// gormLogger := config.NewGormLogPostgreConfig(&cfg.MysqlOption)
-	// postgreDB, err := config.NewPostgreSQL(cfg.AppEnv, &cfg.PostgreSqlOption, gormLogger)
-	// if err != nil {
-	// 	log.Fatal(err)
-	// }
+	// PostgreSQL Initialization, connected when POSTGRE_URI is set
+	var postgreDB *config.PostgreSQL
+	if cfg.PostgreSqlOption.URI != "" {
+		postgreDB, err = config.NewPostgreSQL(cfg.AppEnv, &cfg.PostgreSqlOption, config.NewGormLogPostgreConfig(&cfg.PostgreSqlOption))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// MongoDB stores the logs consumed by the worker, requests are served without it
+	mongoDB, err := config.ConnectMongodb(context.Background(), &cfg.MongodbOption)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer mongoDB.Client().Disconnect(context.Background())
+
+	// Dependencies checked by the readiness probe (/readyz), databases are critical
+	healthRegistry := health.NewRegistry(time.Duration(cfg.HealthOption.CheckTimeoutMs) * time.Millisecond)
+	healthRegistry.Register(health.NewGormChecker("mysql", mysqlDB.DB), true, 0)
+	if postgreDB != nil {
+		healthRegistry.Register(health.NewGormChecker("postgresql", postgreDB.DB), true, 0)
+	}
+	healthRegistry.Register(health.NewMongoDBChecker(mongoDB), false, 0)
+
+	// Idempotency-Key store (memory, redis or mysql), see IDEMPOTENCY_STORE
+	setupIdempotency(cfg, mysqlDB, healthRegistry)
+
+	// Rate limit store (none, memory or redis) and policies, see RATE_LIMIT_STORE
+	rateLimitStore, authRateLimit := setupRateLimit(cfg, healthRegistry)
+
+	// RabbitMQ publishes todo list events and jobs to the worker, requests are served without it but messages
+	// published meanwhile are lost
+	rabbit, err := config.NewRabbitMQInstance(context.Background(), &cfg.RabbitMQOption)
+	if err != nil {
+		log.Fatal(err)
+	}
+	healthRegistry.Register(health.NewRabbitMQChecker(rabbit), false, 0)
+
+	// Real-time stream broker, events of other instances are received through RabbitMQ when STREAM_FANOUT is enabled
+	broker := realtime.NewBroker()
+	setupStreamFanout(cfg, rabbit, broker)
 
 	// AUTH : Write authetincation mechanism method (JWT, Basic Auth, etc.)
 	jwtAuth := auth.NewJWTAuth()
@@ -92,42 +156,92 @@ func main() {
 	// REPOSITORY : Write repository code here (database, cache, etc.)
 	userRepo := mysql.NewUserRepository(mysqlDB)
 	todoListRepo := mysql.NewTodoListRepository(mysqlDB)
+	todoListHistoryRepo := mysql.NewTodoListHistoryRepository(mysqlDB)
+	webhookRepo := mysql.NewWebhookRepository(mysqlDB)
+	webhookDeliveryRepo := mysql.NewWebhookDeliveryRepository(mysqlDB)
+	todoListReminderRepo := mysql.NewTodoListReminderRepository(mysqlDB)
+	notificationRepo := mysql.NewNotificationRepository(mysqlDB)
 
 	// USECASE : Write bussines logic code here (validation, business logic, etc.)
 	// _ = usecase.NewLogUsecase(queue)  // LogUsecase is a sample usecase for sending log to queue (Mongodb, ElasticSearch, etc.)
 	userUsecase := usecase.NewUserUsecase(userRepo, jwtAuth)
-	crudTodoListUsecase := todo_list_usecase.NewCrudTodoListUsecase(todoListRepo)
-
-	api := app.Group("/api/v1")
+	// Statistics are cached in Redis when TODO_STATS_CACHE_TTL_SECONDS is set
+	todoListStatsCache := setupTodoListStatsCache(cfg, healthRegistry)
+	statsTodoListUsecase := todo_list_usecase.NewStatsTodoListUsecase(todoListRepo, todoListStatsCache)
+	// Todo list events (topic todo_list.event) are published to RabbitMQ, they are delivered to webhooks by the worker.
+	// Events also invalidate cached statistics of the user
+	crudTodoListUsecase := todo_list_usecase.NewCrudTodoListUsecase(todoListRepo, todoListHistoryRepo, rabbit, realtime.Publishers{
+		broker,
+		realtime.PublisherFunc(func(event entity.Event) {
+			statsTodoListUsecase.Invalidate(context.Background(), event.UserID)
+		}),
+	})
+	// Full-text search, use postgresql.NewTodoListSearchRepository(postgreDB) on PostgreSQL
+	searchTodoListUsecase := todo_list_usecase.NewSearchTodoListUsecase(todoListRepo)
+	calendarTodoListUsecase := todo_list_usecase.NewCalendarTodoListUsecase(todoListRepo)
+	exportTodoListUsecase := todo_list_usecase.NewExportTodoListUsecase(todoListRepo)
+	// Large imports are processed by the worker (topic todo_list.import), both invalidate cached statistics.
+	// Imported todo lists are published as created events like a single create
+	importTodoListUsecase := todo_list_usecase.NewImportTodoListUsecase(todoListRepo, todoListHistoryRepo, rabbit, broker, todoListStatsCache)
+	// Redeliveries are sent by the worker right away (topic webhook.delivery)
+	crudWebhookUsecase := webhook_usecase.NewCrudWebhookUsecase(webhookRepo, webhookDeliveryRepo, rabbit)
+	// Reminders are published by the scheduler and sent by the worker (topic todo.reminder)
+	crudReminderUsecase := reminder_usecase.NewCrudReminderUsecase(todoListRepo, todoListReminderRepo, cfg.ReminderOption.DueTimeOfDay())
+	// Notifications are sent by the worker with the NOTIFICATION_DRIVER (topic notification.send)
+	inboxNotificationUsecase := notification_usecase.NewInboxNotificationUsecase(notificationRepo, notification.NewQueueNotifier(rabbit))
+
+	// Reads and writes are limited per user, login and registration are also limited per IP by the auth policy
+	api := app.Group("/api/v1", middleware.RateLimitByMethod(middleware.RateLimitRead, middleware.RateLimitWrite))
 
 	handler.NewAuthHandler(parser, presenterJson, userUsecase).Register(api)
-	handler.NewTodoListHandler(parser, presenterJson, crudTodoListUsecase).Register(api)
+	handler.NewTodoListHandler(
+		parser,
+		presenterJson,
+		crudTodoListUsecase,
+		searchTodoListUsecase,
+		exportTodoListUsecase,
+		importTodoListUsecase,
+		statsTodoListUsecase,
+		calendarTodoListUsecase,
+	).Register(api)
+	handler.NewReminderHandler(parser, presenterJson, crudReminderUsecase).Register(api)
+	handler.NewWebhookHandler(parser, presenterJson, crudWebhookUsecase).Register(api)
+	handler.NewNotificationHandler(parser, presenterJson, inboxNotificationUsecase).Register(api)
+	handler.NewStreamHandler(
+		parser,
+		presenterJson,
+		broker,
+		time.Duration(cfg.StreamOption.HeartbeatSeconds)*time.Second,
+		cfg.StreamOption.AllowedOrigins,
+	).Register(api)
 
 	app.Get("/health-check", healthCheck)
-	app.Get("/metrics", monitor.New())
+	handler.NewHealthHandler(healthRegistry, cfg.HealthOption.ExposeErrors).Register(app)
+	// Prometheus metrics (RED metrics per route, DB pool, queue and Go runtime)
+	app.Get("/metrics", adaptor.HTTPHandler(metrics.Handler()))
 
 	// Handle Route not found
 	app.Use(routeNotFound)
 
-	runServerWithGracefulShutdown(app, cfg.ApiPort, 30)
+	// gRPC server of the auth and todo list services on API_RPC_PORT, it is disabled when the port is empty
+	grpcServer := newGRPCServer(cfg, rateLimitStore, authRateLimit, userUsecase, crudTodoListUsecase)
+
+	// Readiness fails first so the load balancer drains the instance, then open streams are closed before shutdown,
+	// otherwise the server waits for them until timeout
+	runServerWithGracefulShutdown(app, cfg.ApiPort, grpcServer, cfg.ApiRpcPort, 30, func() {
+		healthRegistry.Shutdown()
+		time.Sleep(time.Duration(cfg.HealthOption.ShutdownDelaySeconds) * time.Second)
+	}, broker.Close)
 }
 
 func setupMiddleware(app *fiber.App, cfg *config.Config) {
-	// Enable CORS if API shared in public
-	// if cfg.AppEnv == "production" {
-	// 	app.Use(
-	// 		cors.New(cors.Config{
-	// 			AllowCredentials: true,
-	// 			AllowOrigins:     cfg.AllowedCredentialOrigins,
-	// 			AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
-	// 			AllowMethods:     "GET,POST,PUT,DELETE,PATCH",
-	// 		}),
-	// 	)
-	// }
-
 	app.Use(
+		middleware.RequestID,
+		middleware.Language,
+		middleware.Tracing,
+		middleware.Metrics,
 		logger.New(logger.Config{
-			Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
+			Format:     "[${time}] ${status} - ${latency} ${method} ${path} ${locals:request_id}\n",
 			TimeFormat: "02-Jan-2006 15:04:05",
 			TimeZone:   "Asia/Jakarta",
 		}),
@@ -140,9 +254,140 @@ func setupMiddleware(app *fiber.App, cfg *config.Config) {
 			EnableStackTrace: true,
 		}),
 	)
+
+	// Security headers (HSTS, CSP, X-Content-Type-Options, X-Frame-Options), see SECURITY_HEADERS_ENABLED
+	if cfg.SecurityHeadersOption.Enabled {
+		app.Use(helmet.New(config.NewHelmetConfiguration(cfg)))
+	}
+
+	// CORS for browser clients of ALLOWED_CREDENTIAL_ORIGINS, see CORS_ENABLED
+	if cfg.CORSOption.Enabled {
+		app.Use(cors.New(config.NewCORSConfiguration(cfg)))
+	}
 }
 
-func runServerWithGracefulShutdown(app *fiber.App, apiPort string, shutdownTimeout int) {
+func setupIdempotency(cfg *config.Config, mysqlDB *config.Mysql, healthRegistry *health.Registry) {
+	ttl := time.Duration(cfg.IdempotencyOption.TTLSeconds) * time.Second
+
+	switch cfg.IdempotencyOption.Store {
+	case "redis":
+		redisDB := config.NewRedis(&cfg.RedisOption)
+		healthRegistry.Register(health.NewRedisChecker(redisDB), true, 0)
+		middleware.UseIdempotencyStore(redis.NewIdempotencyKeyRepository(redisDB), ttl)
+	case "mysql":
+		middleware.UseIdempotencyStore(mysql.NewIdempotencyKeyRepository(mysqlDB), ttl)
+	default:
+		middleware.UseIdempotencyStore(memory.NewIdempotencyKeyRepository(), ttl)
+	}
+}
+
+// setupRateLimit configures the rate limit policies, Redis is not critical since requests pass through
+// when the store fails. The store (nil when disabled) and the auth policy are returned for the gRPC AuthService
+func setupRateLimit(cfg *config.Config, healthRegistry *health.Registry) (middleware.RateLimitStore, entity.RateLimitPolicy) {
+	opt := cfg.RateLimitOption
+	authPolicy := entity.RateLimitPolicy{
+		Name:      middleware.RateLimitAuth,
+		Algorithm: opt.Algorithm,
+		KeyBy:     entity.RateLimitKeyByIP,
+		Limit:     opt.AuthLimit,
+		Window:    time.Duration(opt.AuthWindowSeconds) * time.Second,
+	}
+	policies := []entity.RateLimitPolicy{
+		authPolicy,
+		{
+			Name:      middleware.RateLimitRead,
+			Algorithm: opt.Algorithm,
+			KeyBy:     entity.RateLimitKeyByUser,
+			Limit:     opt.ReadLimit,
+			Window:    time.Duration(opt.ReadWindowSeconds) * time.Second,
+		},
+		{
+			Name:      middleware.RateLimitWrite,
+			Algorithm: opt.Algorithm,
+			KeyBy:     entity.RateLimitKeyByUser,
+			Limit:     opt.WriteLimit,
+			Window:    time.Duration(opt.WriteWindowSeconds) * time.Second,
+		},
+	}
+
+	var store middleware.RateLimitStore
+	switch opt.Store {
+	case "none":
+		return nil, authPolicy
+	case "redis":
+		redisDB := config.NewRedis(&cfg.RedisOption)
+		healthRegistry.Register(health.NewRedisChecker(redisDB), false, 0)
+		store = redis.NewRateLimitRepository(redisDB)
+	default:
+		store = memory.NewRateLimitRepository()
+	}
+	middleware.UseRateLimitStore(store, policies...)
+
+	return store, authPolicy
+}
+
+// setupTodoListStatsCache returns Redis statistics cache, nil when caching is disabled.
+// Redis is not critical for the cache, statistics are read from the database when it is down
+func setupTodoListStatsCache(cfg *config.Config, healthRegistry *health.Registry) redis.ITodoListStatsCache {
+	if cfg.TodoListStatsOption.CacheTTLSeconds <= 0 {
+		return nil
+	}
+
+	ttl := time.Duration(cfg.TodoListStatsOption.CacheTTLSeconds) * time.Second
+	redisDB := config.NewRedis(&cfg.RedisOption)
+	healthRegistry.Register(health.NewRedisChecker(redisDB), false, 0)
+
+	return redis.NewTodoListStatsCache(redisDB, ttl)
+}
+
+// setupStreamFanout consumes todo list events of every instance into the broker when STREAM_FANOUT is enabled,
+// otherwise the broker only receives events of this instance
+func setupStreamFanout(cfg *config.Config, rabbit *queue.RabbitMQ, broker *realtime.Broker) {
+	if !cfg.StreamOption.Fanout {
+		return
+	}
+
+	go rabbit.HandleBroadcastDeliveries(queue.ProcessTodoListEvent, consumer.NewStreamConsumer(broker).ProcessEvent)
+}
+
+// newGRPCServer returns the gRPC server of the auth and todo list services, nil when API_RPC_PORT is empty.
+// Calls are authenticated with the JWT of AuthService, reflection (ex. for grpcurl) is enabled outside production
+func newGRPCServer(cfg *config.Config, rateLimitStore middleware.RateLimitStore, authRateLimit entity.RateLimitPolicy, userUsecase usecase.UserUsecase, crudTodoListUsecase todo_list_usecase.ICrudTodoListUsecase) *grpc.Server {
+	if cfg.ApiRpcPort == "" {
+		return nil
+	}
+
+	publicMethods := []string{
+		"/" + pb.AuthService_ServiceDesc.ServiceName + "/",
+		"/grpc.reflection.v1.ServerReflection/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+	}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryRequestContext,
+			// Causes of internal errors are only sent to clients outside production
+			interceptor.UnaryError(cfg.AppEnv != "production"),
+			interceptor.UnaryRecovery,
+			// Login and Register share the limit of the REST auth routes
+			interceptor.UnaryRateLimit(rateLimitStore, authRateLimit, "/"+pb.AuthService_ServiceDesc.ServiceName+"/"),
+			interceptor.UnaryAuth(publicMethods...),
+		),
+		grpc.ChainStreamInterceptor(interceptor.StreamAuth(publicMethods...)),
+	)
+
+	pb.RegisterAuthServiceServer(server, grpc_handler.NewAuthHandler(userUsecase))
+	pb.RegisterTodoListServiceServer(server, grpc_handler.NewTodoListHandler(crudTodoListUsecase))
+
+	if cfg.AppEnv != "production" {
+		reflection.Register(server)
+	}
+
+	return server
+}
+
+// runServerWithGracefulShutdown serves REST on apiPort and gRPC on rpcPort (when grpcServer is not nil), on
+// SIGINT or SIGTERM both servers stop accepting requests and wait for running ones up to shutdownTimeout
+func runServerWithGracefulShutdown(app *fiber.App, apiPort string, grpcServer *grpc.Server, rpcPort string, shutdownTimeout int, beforeShutdown ...func()) {
 	var wg sync.WaitGroup
 	wg.Add(1)
 
@@ -155,28 +400,74 @@ func runServerWithGracefulShutdown(app *fiber.App, apiPort string, shutdownTimeo
 		}
 	}()
 
+	if grpcServer != nil {
+		listener, err := net.Listen("tcp", rpcPort)
+		if err != nil {
+			log.Fatalf("gRPC server failed: %v", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Printf("Starting gRPC server, listening at %s\n", rpcPort)
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Fatalf("gRPC server failed: %v", err)
+			}
+		}()
+	}
+
 	// Capture OS signals for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down REST server...")
+	log.Println("Shutting down servers...")
+	for _, fn := range beforeShutdown {
+		fn()
+	}
 
 	// Timeout context for shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(shutdownTimeout)*time.Second)
 	defer cancel()
 
+	// gRPC server is stopped alongside the REST server so both finish running requests within the timeout
+	grpcStopped := make(chan struct{})
+	go func() {
+		defer close(grpcStopped)
+		if grpcServer != nil {
+			stopGRPCServer(ctx, grpcServer)
+		}
+	}()
+
 	if err := app.ShutdownWithContext(ctx); err != nil {
 		log.Printf("Error during server shutdown: %v", err)
 	} else {
 		log.Println("REST server shut down gracefully")
 	}
+	<-grpcStopped
 
 	// Wait for goroutines to exit
 	wg.Wait()
 	log.Println("All tasks completed. Exiting application.")
 }
 
+// stopGRPCServer waits for running calls to finish, the remaining calls are cancelled when ctx is done
+func stopGRPCServer(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Println("gRPC server shut down gracefully")
+	case <-ctx.Done():
+		server.Stop()
+		log.Println("gRPC server shutdown timed out, running calls are cancelled")
+	}
+}
+
 var healthCheck = func(c *fiber.Ctx) error {
 	return c.JSON(entity.GeneralResponse{
 		Code:    200,
@@ -185,6 +476,10 @@ var healthCheck = func(c *fiber.Ctx) error {
 }
 
 var routeNotFound = func(c *fiber.Ctx) error {
+	if json.AcceptsProblem(c) {
+		return json.WriteError(c, apperr.CustomError("Route Not Found!", entity.BAD_REQUEST_CODE, fiber.StatusNotFound))
+	}
+
 	return c.Status(404).JSON(entity.GeneralResponse{
 		Code:    404,
 		Message: "Route Not Found!",
@@ -1,13 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
+	"github.com/rahmatrdn/go-skeleton/config"
 	"github.com/rahmatrdn/go-skeleton/entity"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
 	"github.com/subosito/gotenv"
 )
 
@@ -27,35 +37,119 @@ func main() {
 
 	fmt.Println("Starting scheduler...")
 
-	// cfg := config.NewConfig()
-	// queue, err := config.NewRabbitMQInstance(context.Background(), &cfg.RabbitMQOption)
-	// if err != nil {
-	// 	log.Fatal(err)
-	// }
+	cfg := config.NewConfig()
 
-	// add a job to the scheduler
+	// OpenTelemetry tracing (if needed), see TRACING_EXPORTER. Spans are exported in batches while the scheduler
+	// runs, the last batch is flushed on shutdown
+	shutdownTracing, err := config.NewTracerProvider(context.Background(), cfg, "scheduler")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// Prometheus metrics (queue, DB pool and Go runtime), see METRICS_SCHEDULER_ADDR
+	metrics.Serve(cfg.MetricsOption.SchedulerAddr)
+
+	queue, err := config.NewRabbitMQInstance(context.Background(), &cfg.RabbitMQOption)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gormLogger := config.NewGormLogMysqlConfig(&cfg.MysqlOption)
+	mysqlDB, err := config.NewMysql(cfg.AppEnv, &cfg.MysqlOption, gormLogger)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	deliveryWebhookUsecase := webhook_usecase.NewDeliveryWebhookUsecase(
+		mysql.NewWebhookRepository(mysqlDB),
+		mysql.NewWebhookDeliveryRepository(mysqlDB),
+		queue,
+		nil,
+	)
+
+	// Re-publish webhook deliveries that are due for retry
 	_, err = s.NewJob(
 		gocron.DurationJob(
-			4*time.Second,
+			time.Minute,
 		),
 		gocron.NewTask(
-			func(a string, b int) {
-				// do things
-				fmt.Println("uwu")
+			func() {
+				ctx := context.Background()
+				if err := deliveryWebhookUsecase.RetryDue(ctx); err != nil {
+					helper.LogErrorContext(ctx, "deliveryWebhookUsecase.RetryDue", "Scheduler.WebhookRetry", err, entity.CaptureFields{}, "")
+				}
+			},
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-				helper.LogInfo("Process", "func_name", entity.CaptureFields{}, "message")
+	deliveryReminderUsecase := reminder_usecase.NewDeliveryReminderUsecase(
+		mysql.NewTodoListReminderRepository(mysqlDB),
+		queue,
+		nil,
+		cfg.ReminderOption.DueTimeOfDay(),
+		time.Duration(cfg.ReminderOption.WindowMinutes)*time.Minute,
+	)
+
+	// Publish due todo list reminders, they are sent by the worker (topic todo.reminder)
+	_, err = s.NewJob(
+		gocron.DurationJob(
+			time.Duration(cfg.ReminderOption.IntervalSeconds)*time.Second,
+		),
+		gocron.NewTask(
+			func() {
+				ctx := context.Background()
+				if err := deliveryReminderUsecase.PublishDue(ctx); err != nil {
+					helper.LogErrorContext(ctx, "deliveryReminderUsecase.PublishDue", "Scheduler.TodoReminder", err, entity.CaptureFields{}, "")
+				}
 			},
-			"hello",
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	inboxNotificationUsecase := notification_usecase.NewInboxNotificationUsecase(mysql.NewNotificationRepository(mysqlDB), nil)
+
+	// Delete in-app notifications read more than NOTIFICATION_RETENTION_DAYS ago
+	_, err = s.NewJob(
+		gocron.DailyJob(
 			1,
+			gocron.NewAtTimes(gocron.NewAtTime(2, 0, 0)),
+		),
+		gocron.NewTask(
+			func() {
+				ctx := context.Background()
+				readBefore := time.Now().AddDate(0, 0, -cfg.NotificationOption.RetentionDays)
+				if _, err := inboxNotificationUsecase.Prune(ctx, readBefore); err != nil {
+					helper.LogErrorContext(ctx, "inboxNotificationUsecase.Prune", "Scheduler.NotificationPrune", err, entity.CaptureFields{}, "")
+				}
+			},
 		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
 	)
 	if err != nil {
-		// handle error
+		log.Fatal(err)
 	}
 
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+
 	s.Start()
 	fmt.Println("Scheduler started!")
 
-	// Keep the main program running indefinitely
-	select {} // Infinite loop
+	// Keep the main program running until it is stopped, running jobs are waited on shutdown
+	<-interrupt
+	log.Println("Shutting down the Scheduler...")
+
+	if err := s.Shutdown(); err != nil {
+		log.Printf("Fail shutting down Scheduler: %s\n", err.Error())
+	} else {
+		log.Println("Scheduler successfully shutdown")
+	}
 }
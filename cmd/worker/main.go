@@ -6,11 +6,21 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
 	"github.com/rahmatrdn/go-skeleton/internal/queue"
 	"github.com/rahmatrdn/go-skeleton/internal/queue/consumer"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mongodb"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/redis"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
 	"github.com/subosito/gotenv"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -38,6 +48,19 @@ func main() {
 	app.ctx = context.Background()
 	cfg := config.NewConfig()
 
+	// Language of validation messages of jobs published without one, see DEFAULT_LANGUAGE
+	i18n.SetDefault(cfg.LanguageOption.Default)
+
+	// OpenTelemetry tracing (if needed), see TRACING_EXPORTER
+	shutdownTracing, err := config.NewTracerProvider(app.ctx, cfg, "worker")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(app.ctx)
+
+	// Prometheus metrics (queue, DB pool and Go runtime), see METRICS_WORKER_ADDR
+	metrics.Serve(cfg.MetricsOption.WorkerAddr)
+
 	app.mongoDB, err = config.NewMongodb(app.ctx, &cfg.MongodbOption)
 	if err != nil {
 		log.Fatal(err)
@@ -72,6 +95,82 @@ func main() {
 	case queue.ProcessExample:
 		log.Printf("[Worker] Listening to %v", queue.ProcessExample)
 		go app.queue.HandleConsumedDeliveries(queue.ProcessExample, exampleConsumer.Process)
+	case queue.ProcessTodoListImport:
+		gormLogger := config.NewGormLogMysqlConfig(&cfg.MysqlOption)
+		mysqlDB, err := config.NewMysql(cfg.AppEnv, &cfg.MysqlOption, gormLogger)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Created events are published to the queue only, real-time clients are connected to the API
+		importTodoListUsecase := todo_list_usecase.NewImportTodoListUsecase(
+			mysql.NewTodoListRepository(mysqlDB),
+			mysql.NewTodoListHistoryRepository(mysqlDB),
+			app.queue,
+			nil,
+			newTodoListStatsCache(cfg),
+		)
+		todoListImportConsumer := consumer.NewTodoListImportConsumer(context.Background(), importTodoListUsecase)
+
+		log.Printf("[Worker] Listening to %v", queue.ProcessTodoListImport)
+		go app.queue.HandleConsumedDeliveries(queue.ProcessTodoListImport, todoListImportConsumer.ProcessImport)
+	case queue.ProcessTodoListEvent, queue.ProcessWebhookDelivery:
+		gormLogger := config.NewGormLogMysqlConfig(&cfg.MysqlOption)
+		mysqlDB, err := config.NewMysql(cfg.AppEnv, &cfg.MysqlOption, gormLogger)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		deliveryWebhookUsecase := webhook_usecase.NewDeliveryWebhookUsecase(
+			mysql.NewWebhookRepository(mysqlDB),
+			mysql.NewWebhookDeliveryRepository(mysqlDB),
+			app.queue,
+			nil,
+		)
+		webhookConsumer := consumer.NewWebhookConsumer(context.Background(), deliveryWebhookUsecase)
+
+		handle := webhookConsumer.ProcessEvent
+		if os.Args[1] == queue.ProcessWebhookDelivery {
+			handle = webhookConsumer.ProcessDelivery
+		}
+
+		log.Printf("[Worker] Listening to %v", os.Args[1])
+		go app.queue.HandleConsumedDeliveries(os.Args[1], handle)
+	case queue.ProcessTodoReminder:
+		gormLogger := config.NewGormLogMysqlConfig(&cfg.MysqlOption)
+		mysqlDB, err := config.NewMysql(cfg.AppEnv, &cfg.MysqlOption, gormLogger)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		deliveryReminderUsecase := reminder_usecase.NewDeliveryReminderUsecase(
+			mysql.NewTodoListReminderRepository(mysqlDB),
+			app.queue,
+			notification_usecase.NewInboxNotificationUsecase(mysql.NewNotificationRepository(mysqlDB), notification.NewQueueNotifier(app.queue)),
+			cfg.ReminderOption.DueTimeOfDay(),
+			time.Duration(cfg.ReminderOption.WindowMinutes)*time.Minute,
+		)
+		reminderConsumer := consumer.NewReminderConsumer(context.Background(), deliveryReminderUsecase)
+
+		log.Printf("[Worker] Listening to %v", queue.ProcessTodoReminder)
+		go app.queue.HandleConsumedDeliveries(queue.ProcessTodoReminder, reminderConsumer.ProcessReminder)
+	case queue.ProcessNotificationSend:
+		gormLogger := config.NewGormLogMysqlConfig(&cfg.MysqlOption)
+		mysqlDB, err := config.NewMysql(cfg.AppEnv, &cfg.MysqlOption, gormLogger)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		notifier, err := newNotifier(&cfg.NotificationOption)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		sendNotificationUsecase := notification_usecase.NewSendNotificationUsecase(mysql.NewUserRepository(mysqlDB), notifier)
+		notificationConsumer := consumer.NewNotificationConsumer(context.Background(), sendNotificationUsecase)
+
+		log.Printf("[Worker] Listening to %v", queue.ProcessNotificationSend)
+		go app.queue.HandleConsumedDeliveries(queue.ProcessNotificationSend, notificationConsumer.ProcessSend)
 	default:
 		log.Fatalf("[Worker] topic not found : %v", os.Args[1])
 	}
@@ -86,3 +185,40 @@ func main() {
 	}
 
 }
+
+// newNotifier returns the notification driver of NOTIFICATION_DRIVER, messages are logged when it is unknown
+func newNotifier(cfg *config.NotificationOption) (notification.Notifier, error) {
+	if cfg.Driver != "file" && cfg.Driver != "smtp" {
+		return notification.NewLogNotifier(), nil
+	}
+
+	renderer, err := notification.NewRenderer(cfg.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Driver == "file" {
+		return notification.NewFileNotifier(config.StorageDirectory+"notifications/", cfg.SMTPFrom, renderer), nil
+	}
+
+	return notification.NewSMTPNotifier(notification.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+		Timeout:  time.Duration(cfg.TimeoutSeconds) * time.Second,
+	}, renderer), nil
+}
+
+// newTodoListStatsCache returns the statistics cache of the API when TODO_STATS_CACHE_TTL_SECONDS is set so
+// queued imports invalidate it, nil otherwise
+func newTodoListStatsCache(cfg *config.Config) redis.ITodoListStatsCache {
+	if cfg.TodoListStatsOption.CacheTTLSeconds <= 0 {
+		return nil
+	}
+
+	ttl := time.Duration(cfg.TodoListStatsOption.CacheTTLSeconds) * time.Second
+
+	return redis.NewTodoListStatsCache(config.NewRedis(&cfg.RedisOption), ttl)
+}
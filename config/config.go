@@ -1,26 +1,43 @@
 package config
 
-import "github.com/joeshaw/envdecode"
+import (
+	"time"
+
+	"github.com/joeshaw/envdecode"
+)
 
 var StorageDirectory = "./storage/app/"
 
 type Config struct {
-	AppName                  string   `env:"APP_NAME"`
-	AppVersion               string   `env:"APP_VERSION"`
-	AppEnv                   string   `env:"APP_ENV,default=development"`
-	ApiHost                  string   `env:"API_HOST"`
-	ApiRpcPort               string   `env:"API_RPC_PORT"`
-	ApiPort                  string   `env:"API_PORT,default=8760"`
-	ApiDocPort               uint16   `env:"API_DOC_PORT,default=8761"`
-	ShutdownTimeout          uint     `env:"API_SHUTDOWN_TIMEOUT_SECONDS,default=30"`
-	AllowedCredentialOrigins []string `env:"ALLOWED_CREDENTIAL_ORIGINS"`
-	MiddlewareAddress        string   `env:"MIDDLEWARE_ADDR"`
-	JwtExpireDaysCount       int      `env:"JWT_EXPIRE_DAYS_COUNT"`
+	AppName            string `env:"APP_NAME"`
+	AppVersion         string `env:"APP_VERSION"`
+	AppEnv             string `env:"APP_ENV,default=development"`
+	ApiHost            string `env:"API_HOST"`
+	ApiRpcPort         string `env:"API_RPC_PORT"`
+	ApiPort            string `env:"API_PORT,default=8760"`
+	ApiDocPort         uint16 `env:"API_DOC_PORT,default=8761"`
+	ShutdownTimeout    uint   `env:"API_SHUTDOWN_TIMEOUT_SECONDS,default=30"`
+	MiddlewareAddress  string `env:"MIDDLEWARE_ADDR"`
+	JwtExpireDaysCount int    `env:"JWT_EXPIRE_DAYS_COUNT"`
 	MysqlOption
 	RabbitMQOption
 	MongodbOption
 	RedisOption
 	PostgreSqlOption
+	IdempotencyOption
+	StreamOption
+	TodoListStatsOption
+	ReminderOption
+	NotificationOption
+	TracingOption
+	MetricsOption
+	HealthOption
+	RateLimitOption
+	CORSOption
+	SecurityHeadersOption
+	TrustedProxyOption
+	ProblemDetailsOption
+	LanguageOption
 }
 
 // MysqlOption contains mySQL connection options
@@ -56,6 +73,144 @@ type RedisOption struct {
 	WriteTimeoutMs int16  `env:"REDIS_WRITE_TIMEOUT,required"`
 }
 
+// IdempotencyOption contains Idempotency-Key store options, Store is one of memory, redis or mysql
+type IdempotencyOption struct {
+	Store      string `env:"IDEMPOTENCY_STORE,default=memory"`
+	TTLSeconds int    `env:"IDEMPOTENCY_TTL_SECONDS,default=86400"`
+}
+
+// StreamOption contains real-time stream options, enable Fanout when API runs more than one instance
+// so events are shared through RabbitMQ. WebSocket connections of browsers are accepted from AllowedOrigins
+// (separated by ;), from the origin of the API when it is empty and from every origin with *
+type StreamOption struct {
+	HeartbeatSeconds int      `env:"STREAM_HEARTBEAT_SECONDS,default=25"`
+	Fanout           bool     `env:"STREAM_FANOUT,default=false"`
+	AllowedOrigins   []string `env:"STREAM_ALLOWED_ORIGINS"`
+}
+
+// TodoListStatsOption contains statistics options, statistics are cached in Redis for CacheTTLSeconds
+// when it is greater than 0. Cache is invalidated on todo list writes and imports (also by the worker)
+type TodoListStatsOption struct {
+	CacheTTLSeconds int `env:"TODO_STATS_CACHE_TTL_SECONDS,default=0"`
+}
+
+// ReminderOption contains todo list reminder options. A todo list is due at DueTime (HH:MM, Asia/Jakarta) of its
+// doing date, the scheduler publishes reminders every IntervalSeconds and skips the ones older than WindowMinutes
+type ReminderOption struct {
+	DueTime         string `env:"REMINDER_DUE_TIME,default=09:00"`
+	IntervalSeconds int    `env:"REMINDER_INTERVAL_SECONDS,default=60"`
+	WindowMinutes   int    `env:"REMINDER_WINDOW_MINUTES,default=60"`
+}
+
+// DueTimeOfDay returns DueTime as duration since midnight, 09:00 is used when it is invalid
+func (o ReminderOption) DueTimeOfDay() time.Duration {
+	dueTime, err := time.Parse("15:04", o.DueTime)
+	if err != nil {
+		return 9 * time.Hour
+	}
+
+	return time.Duration(dueTime.Hour())*time.Hour + time.Duration(dueTime.Minute())*time.Minute
+}
+
+// NotificationOption contains notification options, Driver is one of log, file (.eml files in the storage
+// directory) or smtp. Locale is the default template language (en or id). Read in-app notifications are
+// deleted after RetentionDays
+type NotificationOption struct {
+	Driver         string `env:"NOTIFICATION_DRIVER,default=log"`
+	Locale         string `env:"NOTIFICATION_LOCALE,default=en"`
+	RetentionDays  int    `env:"NOTIFICATION_RETENTION_DAYS,default=30"`
+	SMTPHost       string `env:"SMTP_HOST,default=localhost"`
+	SMTPPort       int    `env:"SMTP_PORT,default=587"`
+	SMTPUsername   string `env:"SMTP_USERNAME"`
+	SMTPPassword   string `env:"SMTP_PASSWORD"`
+	SMTPFrom       string `env:"SMTP_FROM,default=no-reply@localhost"`
+	TimeoutSeconds int    `env:"SMTP_TIMEOUT_SECONDS,default=10"`
+}
+
+// TracingOption contains OpenTelemetry tracing options, Exporter is one of none (tracing disabled), stdout,
+// file (spans written as JSON lines to FilePath) or otlp (OTLP over HTTP to OTLPEndpoint). SampleRatio is the
+// ratio of new traces recorded, traces started by callers follow their sampling decision
+type TracingOption struct {
+	Exporter     string  `env:"TRACING_EXPORTER,default=none"`
+	FilePath     string  `env:"TRACING_FILE_PATH,default=./storage/log/trace.log"`
+	OTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT,default=localhost:4318"`
+	OTLPInsecure bool    `env:"TRACING_OTLP_INSECURE,default=true"`
+	SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO,default=1"`
+}
+
+// MetricsOption contains the address of the Prometheus metrics listener (GET /metrics) of the worker and
+// scheduler, the API serves metrics on its own port. Empty address disables the listener, give each worker
+// process its own address when several run on the same host
+type MetricsOption struct {
+	WorkerAddr    string `env:"METRICS_WORKER_ADDR,default=:9101"`
+	SchedulerAddr string `env:"METRICS_SCHEDULER_ADDR,default=:9102"`
+}
+
+// HealthOption contains readiness probe options, each dependency check times out after CheckTimeoutMs.
+// On shutdown /readyz fails for ShutdownDelaySeconds before the server stops so the load balancer drains first.
+// Errors of failing components reveal hosts and ports, /readyz only returns them when ExposeErrors is set
+type HealthOption struct {
+	CheckTimeoutMs       int  `env:"HEALTH_CHECK_TIMEOUT_MS,default=2000"`
+	ShutdownDelaySeconds int  `env:"HEALTH_SHUTDOWN_DELAY_SECONDS,default=5"`
+	ExposeErrors         bool `env:"HEALTH_EXPOSE_ERRORS,default=false"`
+}
+
+// RateLimitOption contains rate limit options, Store is one of none (rate limit disabled), memory or redis (shared
+// by every instance). Algorithm is sliding_window or token_bucket. Auth limits login and registration per IP,
+// read and write limit the other API requests per user (per IP for guests). Limit 0 disables the policy
+type RateLimitOption struct {
+	Store              string `env:"RATE_LIMIT_STORE,default=memory"`
+	Algorithm          string `env:"RATE_LIMIT_ALGORITHM,default=sliding_window"`
+	AuthLimit          int    `env:"RATE_LIMIT_AUTH_LIMIT,default=10"`
+	AuthWindowSeconds  int    `env:"RATE_LIMIT_AUTH_WINDOW_SECONDS,default=60"`
+	ReadLimit          int    `env:"RATE_LIMIT_READ_LIMIT,default=300"`
+	ReadWindowSeconds  int    `env:"RATE_LIMIT_READ_WINDOW_SECONDS,default=60"`
+	WriteLimit         int    `env:"RATE_LIMIT_WRITE_LIMIT,default=60"`
+	WriteWindowSeconds int    `env:"RATE_LIMIT_WRITE_WINDOW_SECONDS,default=60"`
+}
+
+// CORSOption contains CORS options of the API, origins of AllowedCredentialOrigins (separated by ;) may send
+// credentials and https://*.example.com matches every subdomain of example.com. * allows every origin without credentials
+type CORSOption struct {
+	Enabled                  bool     `env:"CORS_ENABLED,default=false"`
+	AllowedCredentialOrigins []string `env:"ALLOWED_CREDENTIAL_ORIGINS"`
+	AllowHeaders             []string `env:"CORS_ALLOW_HEADERS,default=Origin;Content-Type;Accept;Accept-Language;Authorization;Idempotency-Key;If-Match;If-None-Match;X-Request-ID;X-API-Key"`
+	ExposeHeaders            []string `env:"CORS_EXPOSE_HEADERS,default=ETag;X-Request-ID;Idempotent-Replayed;RateLimit-Limit;RateLimit-Remaining;RateLimit-Reset;RateLimit-Policy;Retry-After"`
+	MaxAgeSeconds            int      `env:"CORS_MAX_AGE_SECONDS,default=600"`
+}
+
+// SecurityHeadersOption contains security response headers options, HSTS is only sent on HTTPS requests
+// (X-Forwarded-Proto is read from trusted proxies) and is disabled when HSTSMaxAgeSeconds is 0
+type SecurityHeadersOption struct {
+	Enabled               bool   `env:"SECURITY_HEADERS_ENABLED,default=true"`
+	HSTSMaxAgeSeconds     int    `env:"SECURITY_HSTS_MAX_AGE_SECONDS,default=31536000"`
+	ContentSecurityPolicy string `env:"SECURITY_CONTENT_SECURITY_POLICY,default=default-src 'none'; frame-ancestors 'none'"`
+	FrameOptions          string `env:"SECURITY_FRAME_OPTIONS,default=DENY"`
+}
+
+// TrustedProxyOption contains the load balancers (IPs or CIDRs separated by ;) allowed to set the client IP in
+// ProxyHeader, the client IP is the address of the connection when TrustedProxies is empty. middleware.ClientIP
+// reads X-Forwarded-For from right to left and returns the first address that is not a trusted proxy, the
+// addresses on its left are sent by clients and can be spoofed
+type TrustedProxyOption struct {
+	TrustedProxies []string `env:"TRUSTED_PROXIES"`
+	ProxyHeader    string   `env:"PROXY_HEADER,default=X-Forwarded-For"`
+}
+
+// ProblemDetailsOption contains error format options, errors are written as RFC 7807 application/problem+json to
+// requests accepting it, or to every request when Default is true. TypeBaseURL followed by the error code is the
+// type of the problem, it is about:blank when empty
+type ProblemDetailsOption struct {
+	Default     bool   `env:"PROBLEM_DETAILS_DEFAULT,default=false"`
+	TypeBaseURL string `env:"PROBLEM_DETAILS_TYPE_BASE_URL"`
+}
+
+// LanguageOption contains the language of validation messages (en or id), clients choose it with Accept-Language
+// and Default is used for clients that accept none of them
+type LanguageOption struct {
+	Default string `env:"DEFAULT_LANGUAGE,default=id"`
+}
+
 func NewConfig() *Config {
 	var cfg Config
 	if err := envdecode.Decode(&cfg); err != nil {
@@ -2,12 +2,16 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/helmet"
 )
 
 func NewFiberConfiguration(cfg *Config) fiber.Config {
-	return fiber.Config{
+	fiberCfg := fiber.Config{
 		CaseSensitive: true,
 		ColorScheme: fiber.Colors{
 			Black: "\u001b[39m",
@@ -15,4 +19,45 @@ func NewFiberConfiguration(cfg *Config) fiber.Config {
 		StrictRouting: true,
 		AppName:       fmt.Sprintf("%s - %s", cfg.AppName, cfg.AppVersion),
 	}
+
+	// Proxy header is only read when the request comes from a trusted proxy, otherwise clients could set their IP
+	if len(cfg.TrustedProxyOption.TrustedProxies) > 0 {
+		fiberCfg.EnableTrustedProxyCheck = true
+		fiberCfg.TrustedProxies = cfg.TrustedProxyOption.TrustedProxies
+		fiberCfg.ProxyHeader = cfg.TrustedProxyOption.ProxyHeader
+		fiberCfg.EnableIPValidation = true
+	}
+
+	return fiberCfg
+}
+
+// NewCORSConfiguration returns CORS middleware config from cfg.CORSOption, credentials are not allowed when
+// every origin (*) is allowed
+func NewCORSConfiguration(cfg *Config) cors.Config {
+	origins := strings.Join(cfg.CORSOption.AllowedCredentialOrigins, ",")
+	allowAll := origins == "" || slices.Contains(cfg.CORSOption.AllowedCredentialOrigins, "*")
+	if allowAll {
+		origins = "*"
+	}
+
+	return cors.Config{
+		AllowOrigins:     origins,
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,HEAD",
+		AllowHeaders:     strings.Join(cfg.CORSOption.AllowHeaders, ","),
+		ExposeHeaders:    strings.Join(cfg.CORSOption.ExposeHeaders, ","),
+		AllowCredentials: !allowAll,
+		MaxAge:           cfg.CORSOption.MaxAgeSeconds,
+	}
+}
+
+// NewHelmetConfiguration returns security headers middleware config from cfg.SecurityHeadersOption
+func NewHelmetConfiguration(cfg *Config) helmet.Config {
+	return helmet.Config{
+		XSSProtection:         "0",
+		ContentTypeNosniff:    "nosniff",
+		XFrameOptions:         cfg.SecurityHeadersOption.FrameOptions,
+		HSTSMaxAge:            cfg.SecurityHeadersOption.HSTSMaxAgeSeconds,
+		ContentSecurityPolicy: cfg.SecurityHeadersOption.ContentSecurityPolicy,
+		ReferrerPolicy:        "no-referrer",
+	}
 }
@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/helmet"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConfiguration(t *testing.T) {
+	cfg := &config.Config{CORSOption: config.CORSOption{
+		AllowedCredentialOrigins: []string{"https://example.com", "https://*.example.com"},
+	}}
+
+	app := fiber.New()
+	app.Use(cors.New(config.NewCORSConfiguration(cfg)))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://example.com", true},
+		{"https://app.example.com", true},
+		{"https://a.b.example.com", true},
+		{"http://app.example.com", false},
+		{"https://example.com.evil.com", false},
+		{"https://evil.com", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set(fiber.HeaderOrigin, tt.origin)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+
+		if tt.allowed {
+			assert.Equal(t, tt.origin, resp.Header.Get(fiber.HeaderAccessControlAllowOrigin), tt.origin)
+			assert.Equal(t, "true", resp.Header.Get(fiber.HeaderAccessControlAllowCredentials), tt.origin)
+		} else {
+			assert.Empty(t, resp.Header.Get(fiber.HeaderAccessControlAllowOrigin), tt.origin)
+		}
+	}
+}
+
+func TestCORSConfigurationAllowAll(t *testing.T) {
+	corsCfg := config.NewCORSConfiguration(&config.Config{CORSOption: config.CORSOption{
+		AllowedCredentialOrigins: []string{"*", "https://example.com"},
+	}})
+
+	assert.Equal(t, "*", corsCfg.AllowOrigins)
+	assert.False(t, corsCfg.AllowCredentials)
+}
+
+func TestHelmetConfiguration(t *testing.T) {
+	cfg := &config.Config{SecurityHeadersOption: config.SecurityHeadersOption{
+		HSTSMaxAgeSeconds:     100,
+		ContentSecurityPolicy: "default-src 'none'",
+		FrameOptions:          "DENY",
+	}}
+
+	app := fiber.New()
+	app.Use(helmet.New(config.NewHelmetConfiguration(cfg)))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	// HSTS is only sent on HTTPS, TLS is terminated by the load balancer
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderXForwardedProto, "https")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "nosniff", resp.Header.Get(fiber.HeaderXContentTypeOptions))
+	assert.Equal(t, "DENY", resp.Header.Get(fiber.HeaderXFrameOptions))
+	assert.Equal(t, "default-src 'none'", resp.Header.Get(fiber.HeaderContentSecurityPolicy))
+	assert.Equal(t, "max-age=100; includeSubDomains", resp.Header.Get(fiber.HeaderStrictTransportSecurity))
+}
+
+func TestFiberConfigurationTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name     string
+		proxies  []string
+		expected string
+	}{
+		{"trusted proxy", []string{"0.0.0.0"}, "10.2.2.2"},
+		{"trusted proxy chain", []string{"0.0.0.0", "10.2.2.2"}, "10.1.1.1"},
+		{"untrusted proxy", []string{"192.168.100.0/24"}, "0.0.0.0"},
+		{"no trusted proxy", nil, "0.0.0.0"},
+	}
+	for _, tt := range tests {
+		cfg := &config.Config{TrustedProxyOption: config.TrustedProxyOption{
+			TrustedProxies: tt.proxies,
+			ProxyHeader:    fiber.HeaderXForwardedFor,
+		}}
+
+		app := fiber.New(config.NewFiberConfiguration(cfg))
+		app.Get("/", func(c *fiber.Ctx) error { return c.SendString(middleware.ClientIP(c)) })
+
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		req.Header.Set(fiber.HeaderXForwardedFor, "10.1.1.1, 10.2.2.2")
+		resp, err := app.Test(req)
+		assert.NoError(t, err, tt.name)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, tt.expected, string(body), tt.name)
+	}
+}
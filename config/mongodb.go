@@ -29,6 +29,17 @@ func NewMongodb(ctx context.Context, cfg *MongodbOption) (*mongo.Database, error
 	return client.Database(cfg.DatabaseName), nil
 }
 
+// ConnectMongodb returns the database without checking the connection, the client connects in background so
+// the app starts while MongoDB is down (ex. when it is only checked by the readiness probe)
+func ConnectMongodb(ctx context.Context, cfg *MongodbOption) (*mongo.Database, error) {
+	client, err := mongo.Connect(ctx, mongoOptions(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(cfg.DatabaseName), nil
+}
+
 func mongoOptions(cfg *MongodbOption) *options.ClientOptions {
 	return options.Client().ApplyURI(cfg.Uri)
 }
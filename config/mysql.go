@@ -1,6 +1,8 @@
 package config
 
 import (
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
 	gmysql "gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	glogger "gorm.io/gorm/logger"
@@ -22,8 +24,19 @@ func NewMysql(env string, cfg *MysqlOption, dbLogger glogger.Interface) (*Mysql,
 	if err != nil {
 		return nil, err
 	}
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		return nil, err
+	}
 
 	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
 	sqlDB.SetMaxOpenConns(cfg.Pool)
-	return &Mysql{DB: db}, err
+	if err := metrics.RegisterDB(sqlDB, "mysql"); err != nil {
+		return nil, err
+	}
+
+	return &Mysql{DB: db}, nil
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
 	gpostgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	glogger "gorm.io/gorm/logger"
@@ -22,6 +24,9 @@ func NewPostgreSQL(env string, cfg *PostgreSqlOption, dbLogger glogger.Interface
 	if err != nil {
 		return nil, err
 	}
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		return nil, err
+	}
 
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -29,6 +34,9 @@ func NewPostgreSQL(env string, cfg *PostgreSqlOption, dbLogger glogger.Interface
 	}
 
 	sqlDB.SetMaxOpenConns(cfg.Pool)
+	if err := metrics.RegisterDB(sqlDB, "postgresql"); err != nil {
+		return nil, err
+	}
 
 	return &PostgreSQL{DB: db}, nil
 }
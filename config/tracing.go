@@ -0,0 +1,93 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// NewTracerProvider sets up the global OpenTelemetry tracer provider and W3C trace context propagator from
+// cfg.TracingOption, component is the binary (api, worker or scheduler) appended to the service name.
+// The returned function flushes pending spans, call it before the app exits.
+// Nothing is set up when the exporter is none, spans are no-op then
+func NewTracerProvider(ctx context.Context, cfg *Config, component string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, closer, err := newSpanExporter(ctx, &cfg.TracingOption)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	serviceName := component
+	if cfg.AppName != "" {
+		serviceName = cfg.AppName + "-" + component
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(cfg.AppVersion),
+		semconv.DeploymentEnvironment(cfg.AppEnv),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingOption.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			closer.Close()
+		}
+
+		return err
+	}, nil
+}
+
+// newSpanExporter returns nil exporter when tracing is disabled, closer is the file of the file exporter
+func newSpanExporter(ctx context.Context, cfg *TracingOption) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.Exporter {
+	case "", "none":
+		return nil, nil, nil
+	case "stdout":
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		return exporter, nil, err
+	case "file":
+		if err := os.MkdirAll(filepath.Dir(cfg.FilePath), 0o755); err != nil {
+			return nil, nil, err
+		}
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		return exporter, file, err
+	case "otlp":
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		return exporter, nil, err
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
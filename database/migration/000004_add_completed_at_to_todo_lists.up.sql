@@ -0,0 +1,2 @@
+ALTER TABLE `todo_lists`
+	ADD COLUMN `completed_at` TIMESTAMP NULL DEFAULT NULL AFTER `doing_at`;
@@ -0,0 +1 @@
+ALTER TABLE `todo_lists` DROP INDEX `ft_todo_lists_title_description`;
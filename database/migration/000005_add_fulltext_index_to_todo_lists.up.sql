@@ -0,0 +1,2 @@
+ALTER TABLE `todo_lists`
+	ADD FULLTEXT INDEX `ft_todo_lists_title_description` (`title`, `description`);
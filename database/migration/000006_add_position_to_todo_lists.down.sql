@@ -0,0 +1,3 @@
+ALTER TABLE `todo_lists`
+	DROP INDEX `idx_todo_lists_user_id_position`,
+	DROP COLUMN `position`;
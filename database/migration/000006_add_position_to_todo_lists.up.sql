@@ -0,0 +1,19 @@
+ALTER TABLE `todo_lists`
+	ADD COLUMN `position` VARCHAR(255) NOT NULL DEFAULT '' COLLATE 'utf8mb4_bin' AFTER `completed_at`,
+	ADD INDEX `idx_todo_lists_user_id_position` (`user_id`, `position`);
+
+-- Keep current order (by id) of every user with the ranks helper.RankBetween generates when appending: U00000 then
+-- steps of 62^2 (U00100, U00200, ...), so the 4 leading base62 digits are the row number added to U000 (30 * 62^3)
+UPDATE `todo_lists` t
+	JOIN (
+		SELECT `id`, 30 * 238328 + ROW_NUMBER() OVER (PARTITION BY `user_id` ORDER BY `id`) - 1 AS `rank_value`
+		FROM `todo_lists`
+	) r ON r.`id` = t.`id`
+	JOIN (SELECT '0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz' AS `rank_digits`) d
+SET t.`position` = CONCAT(
+	SUBSTRING(d.`rank_digits`, FLOOR(r.`rank_value` / 238328) MOD 62 + 1, 1),
+	SUBSTRING(d.`rank_digits`, FLOOR(r.`rank_value` / 3844) MOD 62 + 1, 1),
+	SUBSTRING(d.`rank_digits`, FLOOR(r.`rank_value` / 62) MOD 62 + 1, 1),
+	SUBSTRING(d.`rank_digits`, r.`rank_value` MOD 62 + 1, 1),
+	'00'
+);
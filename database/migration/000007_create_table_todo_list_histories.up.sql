@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `todo_list_histories` (
+	`id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	`todo_list_id` INT(11) NOT NULL,
+	`user_id` BIGINT(20) UNSIGNED NULL DEFAULT NULL COMMENT 'Owner of the todo list',
+	`actor_id` BIGINT(20) UNSIGNED NULL DEFAULT NULL COMMENT 'User who made the change',
+	`action` VARCHAR(20) NOT NULL COMMENT 'create, update or delete' COLLATE 'utf8mb4_general_ci',
+	`changes` JSON NULL DEFAULT NULL COMMENT 'Field level diff {"field": {"old": ..., "new": ...}}',
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	PRIMARY KEY (`id`) USING BTREE,
+	INDEX `idx_todo_list_histories_todo_list_id` (`todo_list_id`, `user_id`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
@@ -0,0 +1,2 @@
+ALTER TABLE `todo_lists`
+	ADD COLUMN `version` INT(11) UNSIGNED NOT NULL DEFAULT 1 AFTER `position`;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `idempotency_keys` (
+	`idempotency_key` CHAR(64) NOT NULL COMMENT 'SHA-256 of user and Idempotency-Key header' COLLATE 'utf8mb4_general_ci',
+	`fingerprint` CHAR(64) NOT NULL COMMENT 'SHA-256 of method, path and body' COLLATE 'utf8mb4_general_ci',
+	`status_code` SMALLINT(5) UNSIGNED NOT NULL DEFAULT 0 COMMENT '0 while the request is in progress',
+	`content_type` VARCHAR(100) NOT NULL DEFAULT '' COLLATE 'utf8mb4_general_ci',
+	`body` MEDIUMBLOB NULL DEFAULT NULL,
+	`expires_at` TIMESTAMP NOT NULL,
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	PRIMARY KEY (`idempotency_key`) USING BTREE,
+	INDEX `idx_idempotency_keys_expires_at` (`expires_at`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
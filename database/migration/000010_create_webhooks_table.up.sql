@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS `webhooks` (
+	`id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	`user_id` BIGINT(20) UNSIGNED NOT NULL,
+	`url` VARCHAR(500) NOT NULL COLLATE 'utf8mb4_general_ci',
+	`secret` VARCHAR(100) NOT NULL COMMENT 'HMAC-SHA256 key of X-Webhook-Signature' COLLATE 'utf8mb4_general_ci',
+	`event_types` VARCHAR(255) NOT NULL COMMENT 'Comma separated event types' COLLATE 'utf8mb4_general_ci',
+	`is_active` TINYINT(1) NOT NULL DEFAULT 1,
+	`failure_count` INT(11) UNSIGNED NOT NULL DEFAULT 0 COMMENT 'Consecutive failed delivery attempts',
+	`disabled_at` TIMESTAMP NULL DEFAULT NULL COMMENT 'Set when disabled after too many failures',
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	`updated_at` TIMESTAMP NULL DEFAULT NULL,
+	PRIMARY KEY (`id`) USING BTREE,
+	INDEX `idx_webhooks_user_id` (`user_id`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
+
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+	`id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	`webhook_id` BIGINT(20) UNSIGNED NOT NULL,
+	`event_id` CHAR(36) NOT NULL COLLATE 'utf8mb4_general_ci',
+	`event_type` VARCHAR(50) NOT NULL COLLATE 'utf8mb4_general_ci',
+	`payload` JSON NOT NULL,
+	`status` VARCHAR(20) NOT NULL COMMENT 'pending, retrying, success or failed' COLLATE 'utf8mb4_general_ci',
+	`attempts` INT(11) UNSIGNED NOT NULL DEFAULT 0,
+	`response_status` SMALLINT(5) UNSIGNED NOT NULL DEFAULT 0,
+	`response_body` TEXT NULL DEFAULT NULL COLLATE 'utf8mb4_general_ci',
+	`error` VARCHAR(500) NULL DEFAULT NULL COLLATE 'utf8mb4_general_ci',
+	`next_retry_at` TIMESTAMP NULL DEFAULT NULL,
+	`delivered_at` TIMESTAMP NULL DEFAULT NULL,
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	`updated_at` TIMESTAMP NULL DEFAULT NULL,
+	PRIMARY KEY (`id`) USING BTREE,
+	INDEX `idx_webhook_deliveries_webhook_id` (`webhook_id`) USING BTREE,
+	INDEX `idx_webhook_deliveries_retry` (`status`, `next_retry_at`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `todo_list_reminders` (
+	`id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	`todo_list_id` BIGINT(20) UNSIGNED NOT NULL,
+	`user_id` BIGINT(20) UNSIGNED NOT NULL,
+	`offset_minutes` INT(11) UNSIGNED NOT NULL COMMENT 'Minutes before the due time of doing_at',
+	`sent_for` DATE NULL DEFAULT NULL COMMENT 'doing_at the reminder was sent for, it is sent again when doing_at changes',
+	`sent_at` TIMESTAMP NULL DEFAULT NULL,
+	`queued_at` TIMESTAMP NULL DEFAULT NULL COMMENT 'Last time the scheduler published the reminder',
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	`updated_at` TIMESTAMP NULL DEFAULT NULL,
+	PRIMARY KEY (`id`) USING BTREE,
+	UNIQUE INDEX `uq_todo_list_reminders_offset` (`todo_list_id`, `offset_minutes`) USING BTREE,
+	INDEX `idx_todo_list_reminders_user_id` (`user_id`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
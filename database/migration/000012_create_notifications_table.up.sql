@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `notifications` (
+	`id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	`user_id` BIGINT(20) UNSIGNED NOT NULL,
+	`type` VARCHAR(50) NOT NULL COMMENT 'ex. todo.reminder' COLLATE 'utf8mb4_general_ci',
+	`title` VARCHAR(255) NOT NULL COLLATE 'utf8mb4_general_ci',
+	`body` TEXT NOT NULL COLLATE 'utf8mb4_general_ci',
+	`data` JSON NOT NULL COMMENT 'Values of the notification type, ex. todo_list_id',
+	`read_at` TIMESTAMP NULL DEFAULT NULL,
+	`created_at` TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+	PRIMARY KEY (`id`) USING BTREE,
+	INDEX `idx_notifications_user_id` (`user_id`, `read_at`) USING BTREE,
+	INDEX `idx_notifications_read_at` (`read_at`) USING BTREE
+)
+COLLATE='utf8mb4_general_ci'
+ENGINE=InnoDB
+;
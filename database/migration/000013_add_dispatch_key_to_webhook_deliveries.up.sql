@@ -0,0 +1,3 @@
+ALTER TABLE `webhook_deliveries`
+	ADD COLUMN `dispatch_key` CHAR(36) NULL DEFAULT NULL COMMENT 'Event ID of deliveries created by dispatch, NULL for redeliveries' COLLATE 'utf8mb4_general_ci' AFTER `event_type`,
+	ADD UNIQUE INDEX `uq_webhook_deliveries_dispatch_key` (`webhook_id`, `dispatch_key`);
@@ -0,0 +1,3 @@
+DROP INDEX idx_todo_lists_search_vector;
+
+ALTER TABLE todo_lists DROP COLUMN search_vector;
@@ -0,0 +1,5 @@
+ALTER TABLE todo_lists
+	ADD COLUMN search_vector tsvector
+	GENERATED ALWAYS AS (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(description, ''))) STORED;
+
+CREATE INDEX idx_todo_lists_search_vector ON todo_lists USING GIN (search_vector);
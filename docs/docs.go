@@ -120,6 +120,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Too many requests, retry after Retry-After seconds",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server Error",
                         "schema": {
@@ -184,6 +190,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Too many requests, retry after Retry-After seconds",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server Error",
                         "schema": {
@@ -193,14 +205,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/todo-list": {
+        "/api/v1/me/notifications": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of Todo Lists belonging to a user by their User ID",
+                "description": "Retrieve in-app notifications of the user ordered from the newest, with the unread count. Read notifications are deleted after the configured retention (default 30 days)",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,11 +220,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Todo List"
+                    "Notification"
+                ],
+                "summary": "Retrieve Notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Data per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Retrieve Todo Lists by User ID",
                 "responses": {
-                    "201": {
+                    "200": {
                         "description": "Success",
                         "schema": {
                             "allOf": [
@@ -223,10 +249,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/entity.TodoListResponse"
-                                            }
+                                            "$ref": "#/definitions/entity.NotificationListResponse"
                                         }
                                     }
                                 }
@@ -252,14 +275,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/v1/me/notifications/read-all": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing Todo List",
+                "description": "Mark every unread notification of the user as read",
                 "consumes": [
                     "application/json"
                 ],
@@ -267,32 +292,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Todo List"
-                ],
-                "summary": "Update an existing Todo List by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID of the todo list",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Payload Request Body",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.TodoListReq"
-                        }
-                    }
+                    "Notification"
                 ],
+                "summary": "Mark all Notifications as read",
                 "responses": {
-                    "201": {
+                    "200": {
                         "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/entity.GeneralResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.MarkAllReadResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -301,12 +320,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Invalid Request Body",
-                        "schema": {
-                            "$ref": "#/definitions/entity.CustomErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server Error",
                         "schema": {
@@ -314,14 +327,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/me/notifications/{id}/read": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new Todo List",
+                "description": "Mark a notification of the user as read, marking a read notification again keeps its read time",
                 "consumes": [
                     "application/json"
                 ],
@@ -329,22 +344,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Todo List"
+                    "Notification"
                 ],
-                "summary": "Create a new Todo List",
+                "summary": "Mark Notification as read",
                 "parameters": [
                     {
-                        "description": "Payload Request Body",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.TodoListReq"
-                        }
+                        "type": "integer",
+                        "description": "ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
+                    "200": {
                         "description": "Success",
                         "schema": {
                             "allOf": [
@@ -355,7 +368,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entity.TodoListReq"
+                                            "$ref": "#/definitions/entity.NotificationResponse"
                                         }
                                     }
                                 }
@@ -368,8 +381,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Invalid Request Body",
+                    "404": {
+                        "description": "Notification not found",
                         "schema": {
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
@@ -383,14 +396,101 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/todo-lists/{id}": {
+        "/api/v1/stream": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get a Todo List by its ID",
+                "description": "Server-Sent Events stream of the user Todo List changes. Each message has id (event ID), event (todo_list.created, todo_list.updated, todo_list.completed or todo_list.deleted) and data (JSON event with the Todo List as data). Browser EventSource can pass the token as access_token query",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Stream"
+                ],
+                "summary": "Stream Todo List events (SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT token, used when Authorization header can not be sent",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of events",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream/ws": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "WebSocket stream of the user Todo List changes, each text message is a JSON event (same as data of SSE stream). Messages sent by the client are ignored. Browser WebSocket can pass the token as subprotocol: new WebSocket(url, [\"access_token\", token]). Connections from origins other than STREAM_ALLOWED_ORIGINS are rejected",
+                "tags": [
+                    "Stream"
+                ],
+                "summary": "Stream Todo List events (WebSocket)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "access_token, followed by the JWT token when Authorization header can not be sent",
+                        "name": "Sec-WebSocket-Protocol",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Origin not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Not a WebSocket upgrade request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-list": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of Todo Lists belonging to a user by their User ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -400,16 +500,7 @@ const docTemplate = `{
                 "tags": [
                     "Todo List"
                 ],
-                "summary": "Get Todo List by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID of the Todo List",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Retrieve Todo Lists by User ID",
                 "responses": {
                     "201": {
                         "description": "Success",
@@ -422,7 +513,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entity.TodoListResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.TodoListResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -449,13 +543,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete an existing Todo List by its ID",
+                "description": "Update an existing Todo List",
                 "consumes": [
                     "application/json"
                 ],
@@ -465,7 +559,7 @@ const docTemplate = `{
                 "tags": [
                     "Todo List"
                 ],
-                "summary": "Delete Todo List by ID",
+                "summary": "Update an existing Todo List by ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -473,6 +567,21 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag from Get By ID, update is rejected when Todo List has been modified",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TodoListReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -480,6 +589,12 @@ const docTemplate = `{
                         "description": "Success",
                         "schema": {
                             "$ref": "#/definitions/entity.GeneralResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the Todo List"
+                            }
                         }
                     },
                     "401": {
@@ -488,6 +603,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/entity.CustomErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Todo List not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Todo List has been modified (If-Match mismatch)",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
                     "422": {
                         "description": "Invalid Request Body",
                         "schema": {
@@ -501,121 +628,2283 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "entity.CreateUserReq": {
-            "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password",
-                "phone",
-                "reenter_password",
-                "role_access"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string"
-                },
-                "phone": {
-                    "type": "string"
-                },
-                "reenter_password": {
-                    "type": "string"
-                },
-                "role_access": {
-                    "type": "integer"
-                }
-            }
-        },
-        "entity.CreateUserResponse": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "phone": {
-                    "type": "string"
-                },
-                "role_access": {
-                    "type": "string"
-                },
-                "user_id": {
-                    "type": "integer"
-                }
-            }
-        },
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new Todo List",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Create a new Todo List",
+                "parameters": [
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TodoListReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListReq"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency-Key reused with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Apply create/update/delete/complete operations in one request. Mode \"atomic\" (default) rolls back the whole batch when one operation fails, mode \"best_effort\" applies every operation independently.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Bulk Todo List operations",
+                "parameters": [
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.BulkTodoListReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.BulkTodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency-Key reused with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "User Todo Lists grouped by doing date within the range (default the current month) with per-day counts, days without Todo Lists are omitted unless include_empty is true. The timezone decides today (overdue) and the timestamps, doing date has no time so it is the same in every timezone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Todo List calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD), default the first day of the month of to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD), default the last day of the month of from. Range is max. 92 days",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone, ex. America/New_York (default Asia/Jakarta)",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include days without Todo Lists (default false)",
+                        "name": "include_empty",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListCalendarResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/export": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download all user Todo Lists as CSV, JSON or iCalendar file. On iCalendar, doing_at is exported as all-day DTSTART of a VTODO (default) or VEVENT component. CSV cells starting with =, +, - or @ are prefixed with ' so spreadsheets do not evaluate them as formula",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Export Todo Lists",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "json",
+                            "ics"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "vtodo",
+                            "vevent"
+                        ],
+                        "type": "string",
+                        "description": "iCalendar component (default vtodo)",
+                        "name": "component",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import Todo Lists from CSV (header: title,description,doing_at), JSON (array of Todo List) or iCalendar (VTODO/VEVENT) file, sent as multipart \"file\" field (required in multipart requests) or raw request body. The ' prefix added to CSV cells on export is removed. Valid rows are imported (with history and todo_list.created events, like a single create) and invalid rows are reported per row. Use dry_run to only validate the file. Large files are processed in background by the worker (queued = true)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Import Todo Lists",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to import",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json",
+                            "ics"
+                        ],
+                        "type": "string",
+                        "description": "File format, detected from file extension when empty",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate only, nothing is imported",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.ImportTodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Multipart request without file field",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency-Key reused with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/search": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Full-text search on title and description of user Todo Lists, ordered by relevance. Matched terms in highlight are wrapped with \u003cmark\u003e\u003c/mark\u003e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Search Todo Lists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (min. 3 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Data per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.TodoListSearchResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/stats": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Statistics of user Todo Lists by doing date within the range (default the last 30 days): total, count by status, scheduled and completed count per day or week (Monday), completion rate, all-time overdue count and completion streaks in days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Todo List statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD), default 29 days before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD), default today. Range is max. 366 days",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week"
+                        ],
+                        "type": "string",
+                        "description": "Series period (default day)",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a Todo List by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Get Todo List by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the Todo List",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag from previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the Todo List, send it as If-Match when updating"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete an existing Todo List by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Delete Todo List by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the todo list",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/entity.GeneralResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update only the supplied fields with JSON Merge Patch (RFC 7396), a field set to null is cleared (ex. description). JSON Patch (RFC 6902) add/replace/remove operations are accepted with Content-Type application/json-patch+json, use remove to clear a field since replace with null is rejected",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Partially update Todo List by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the todo list",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag from Get By ID, patch is rejected when Todo List has been modified",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TodoListPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the Todo List"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Patch has no field to update",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Todo List not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Todo List has been modified (If-Match mismatch)",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve change history (create, update, delete) of a Todo List ordered from the newest, every entry contains the actor and old/new value of changed fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Retrieve Todo List history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the todo list",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.TodoListHistoryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Reorder a Todo List by placing it right before \"before_id\" or right after \"after_id\" (fill only one of them). Todo Lists are returned ordered by position",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Move Todo List",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the todo list",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.MoveTodoListReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.TodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the Todo List"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Todo List not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Position conflict or Idempotency-Key reused with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo-lists/{id}/reminders": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve reminders of a Todo List ordered by remind time. A Todo List is due at the configured time of day (default 09:00 Asia/Jakarta) of its doing date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Retrieve Todo List reminders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the Todo List",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.ReminderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Todo List not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace reminders of a Todo List with the given offsets in minutes before the due time (max. 5 offsets, each max. 10080 or 7 days). Each reminder is sent once per doing date, it is sent again when the doing date changes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo List"
+                ],
+                "summary": "Set Todo List reminders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the Todo List",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SetReminderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.ReminderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Todo List not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve webhook subscriptions of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Retrieve Webhooks",
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.WebhookResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Subscribe an URL to Todo List events (todo_list.created, todo_list.updated, todo_list.completed, todo_list.deleted). Deliveries are signed with header X-Webhook-Signature: sha256=HMAC-SHA256(secret, X-Webhook-Timestamp + \".\" + body), the secret is only returned in this response",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Create a new Webhook",
+                "parameters": [
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.WebhookResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency-Key reused with a different request",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a webhook subscription of the user by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get Webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.WebhookResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update URL and event types of a webhook, set is_active to true to enable a webhook disabled after repeated failures",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Update Webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payload Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.WebhookResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid Request Body",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a webhook subscription together with its delivery log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Delete Webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/entity.GeneralResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve the latest 100 deliveries of a webhook ordered from the newest, with attempts, response and next retry time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Retrieve Webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.WebhookDeliveryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Send the payload of a previous delivery again as a new delivery",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Redeliver Webhook delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the delivery",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request, retry with the same key replays the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.GeneralResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entity.WebhookDeliveryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook or delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server Error",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CustomErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/livez": {
+            "get": {
+                "description": "Returns 200 while the process is able to serve requests, dependencies are not checked (see /readyz)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness Probe",
+                "responses": {
+                    "200": {
+                        "description": "Alive",
+                        "schema": {
+                            "$ref": "#/definitions/entity.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the dependencies of the app. Returns 503 when a critical component is down or the app is shutting down, a down non-critical component only degrades the status. Errors of failing components are only returned when HEALTH_EXPOSE_ERRORS is set",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness Probe",
+                "responses": {
+                    "200": {
+                        "description": "Ready (up or degraded)",
+                        "schema": {
+                            "$ref": "#/definitions/entity.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Not ready",
+                        "schema": {
+                            "$ref": "#/definitions/entity.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.BulkMode": {
+            "type": "string",
+            "enum": [
+                "atomic",
+                "best_effort"
+            ],
+            "x-enum-varnames": [
+                "BulkModeAtomic",
+                "BulkModeBestEffort"
+            ]
+        },
+        "entity.BulkOperationType": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete",
+                "complete"
+            ],
+            "x-enum-varnames": [
+                "BulkOperationCreate",
+                "BulkOperationUpdate",
+                "BulkOperationDelete",
+                "BulkOperationComplete"
+            ]
+        },
+        "entity.BulkTodoListOperation": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "doing_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "op": {
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete",
+                        "complete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BulkOperationType"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BulkTodoListReq": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "mode": {
+                    "enum": [
+                        "atomic",
+                        "best_effort"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BulkMode"
+                        }
+                    ]
+                },
+                "operations": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.BulkTodoListOperation"
+                    }
+                }
+            }
+        },
+        "entity.BulkTodoListResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "$ref": "#/definitions/entity.BulkMode"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.BulkTodoListResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.BulkTodoListResult": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/entity.TodoListResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/error.CustomErrorResponseWithMeta"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "op": {
+                    "$ref": "#/definitions/entity.BulkOperationType"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.CreateUserReq": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "phone",
+                "reenter_password",
+                "role_access"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "reenter_password": {
+                    "type": "string"
+                },
+                "role_access": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CreateUserResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "role_access": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "entity.CustomErrorResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "code": {
+                    "type": "string"
+                },
+                "http_code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "failed_field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Event": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "id": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.FieldDiff": {
+            "type": "object",
+            "properties": {
+                "new": {},
+                "old": {}
+            }
+        },
+        "entity.GeneralResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.HealthComponent": {
+            "type": "object",
+            "properties": {
+                "critical": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "error": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "entity.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.HealthComponent"
+                    }
+                },
+                "shutting_down": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "entity.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ErrorResponse"
+                    }
+                },
+                "row": {
+                    "description": "Row is the position of the record in the file starting from 1 (CSV header excluded)",
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ImportTodoListResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "format": {
+                    "$ref": "#/definitions/entity.TransferFormat"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "queued": {
+                    "type": "boolean"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.LoginReq": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role_access": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.MarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.MoveTodoListReq": {
+            "type": "object",
+            "properties": {
+                "after_id": {
+                    "type": "integer"
+                },
+                "before_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NotificationListResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.NotificationResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NotificationResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "http_code": {
+                "created_at": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "message": {
+                "is_read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "entity.GeneralResponse": {
+        "entity.ReminderResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "id": {
                     "type": "integer"
                 },
-                "data": {},
-                "message": {
+                "offset_minutes": {
+                    "type": "integer"
+                },
+                "remind_at": {
+                    "type": "string"
+                },
+                "sent_at": {
                     "type": "string"
+                },
+                "todo_list_id": {
+                    "type": "integer"
                 }
             }
         },
-        "entity.LoginReq": {
+        "entity.SetReminderReq": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "offsets_minutes": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entity.TodoListCalendarDay": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "date": {
                     "type": "string"
                 },
-                "password": {
+                "is_today": {
+                    "type": "boolean"
+                },
+                "overdue": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "todo_lists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TodoListResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TodoListCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TodoListCalendarDay"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "today": {
                     "type": "string"
                 }
             }
         },
-        "entity.LoginResponse": {
+        "entity.TodoListHighlight": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "description": {
                     "type": "string"
                 },
-                "email": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TodoListHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string"
                 },
-                "name": {
+                "actor_id": {
+                    "type": "integer"
+                },
+                "changes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.FieldDiff"
+                    }
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "role_access": {
+                "id": {
                     "type": "integer"
                 },
-                "user_id": {
+                "todo_list_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TodoListPatch": {
+            "type": "object",
+            "required": [
+                "doing_at",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "doing_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "entity.TodoListPeriodStats": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "scheduled": {
                     "type": "integer"
                 }
             }
@@ -646,6 +2935,41 @@ const docTemplate = `{
         "entity.TodoListResponse": {
             "type": "object",
             "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "doing_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TodoListSearchResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -655,14 +2979,215 @@ const docTemplate = `{
                 "doing_at": {
                     "type": "string"
                 },
+                "highlight": {
+                    "$ref": "#/definitions/entity.TodoListHighlight"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "position": {
+                    "type": "string"
+                },
+                "relevance": {
+                    "type": "number"
+                },
                 "title": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TodoListStatsResponse": {
+            "type": "object",
+            "properties": {
+                "by_status": {
+                    "$ref": "#/definitions/entity.TodoListStatusStats"
+                },
+                "completion_rate": {
+                    "type": "number"
+                },
+                "current_streak": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "longest_streak": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "type": "integer"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TodoListPeriodStats"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TodoListStatusStats": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TransferFormat": {
+            "type": "string",
+            "enum": [
+                "csv",
+                "json",
+                "ics"
+            ],
+            "x-enum-varnames": [
+                "TransferFormatCSV",
+                "TransferFormatJSON",
+                "TransferFormatICS"
+            ]
+        },
+        "entity.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "next_retry_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "response_body": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.WebhookReq": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "description": "IsActive is used on update, activating a disabled webhook resets its failure count",
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "entity.WebhookResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "disabled_at": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failure_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "Secret is only returned when the webhook is created",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "error.CustomErrorResponseWithMeta": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "http_code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ErrorResponse"
+                    }
                 }
             }
         }
@@ -33,12 +33,12 @@ type LoginResponse struct {
 }
 
 type CreateUserReq struct {
-	Name            string `json:"name" validate:"required" name:"Nama"`
+	Name            string `json:"name" validate:"required" name:"Nama" name_en:"Name"`
 	Email           string `json:"email" validate:"required"`
 	Password        string `json:"password" validate:"required"`
 	ReenterPassword string `json:"reenter_password" validate:"required"`
-	Phone           string `json:"phone" validate:"required" name:"Nomor Telepon"`
-	RoleAccess      int8   `json:"role_access" validate:"required" name:"Hak Akses"`
+	Phone           string `json:"phone" validate:"required" name:"Nomor Telepon" name_en:"Phone Number"`
+	RoleAccess      int8   `json:"role_access" validate:"required" name:"Hak Akses" name_en:"Role Access"`
 }
 type CreateUserResponse struct {
 	UserID     int64  `json:"user_id"`
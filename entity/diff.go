@@ -0,0 +1,7 @@
+package entity
+
+// FieldDiff is the old and new value of a changed field
+type FieldDiff struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	EventTodoListCreated   = "todo_list.created"
+	EventTodoListUpdated   = "todo_list.updated"
+	EventTodoListCompleted = "todo_list.completed"
+	EventTodoListDeleted   = "todo_list.deleted"
+)
+
+// EventTypes is the list of event types that can be subscribed
+var EventTypes = []string{EventTodoListCreated, EventTodoListUpdated, EventTodoListCompleted, EventTodoListDeleted}
+
+// Event is a domain event published to the queue after data is changed
+type Event struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	UserID     int64       `json:"user_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+func (e *Event) LoadFromMap(m map[string]interface{}) error {
+	data, err := json.Marshal(m)
+	if err == nil {
+		err = json.Unmarshal(data, e)
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+package entity
+
+// Health statuses, a component is down when its check fails or times out. The app is degraded when only
+// non-critical components are down, it is still ready to serve requests
+const (
+	HealthUp       = "up"
+	HealthDegraded = "degraded"
+	HealthDown     = "down"
+)
+
+type HealthResponse struct {
+	Status       string                     `json:"status" example:"up"`
+	ShuttingDown bool                       `json:"shutting_down,omitempty"`
+	Components   map[string]HealthComponent `json:"components,omitempty"`
+}
+
+type HealthComponent struct {
+	Status    string `json:"status" example:"up"`
+	Critical  bool   `json:"critical" example:"true"`
+	LatencyMs int64  `json:"latency_ms" example:"2"`
+	Error     string `json:"error,omitempty"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord is the stored result of a request sent with Idempotency-Key header.
+// Record with zero StatusCode is reserved by a request that is still in progress
+type IdempotencyRecord struct {
+	Key         string    `json:"key"`
+	Fingerprint string    `json:"fingerprint"`
+	StatusCode  int       `json:"status_code"`
+	ContentType string    `json:"content_type"`
+	Body        []byte    `json:"body"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// Completed reports whether the response of the request has been stored
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
@@ -14,6 +14,7 @@ type Log struct {
 	Process      string        `json:"process"`
 	Status       LogType       `json:"status"`
 	LogFields    CaptureFields `json:"capture_fields"`
+	RequestID    string        `json:"request_id,omitempty"`
 }
 
 type LogType string
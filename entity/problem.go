@@ -0,0 +1,14 @@
+package entity
+
+// ProblemDetails is the RFC 7807 (application/problem+json) error body, Code is the error code of the legacy
+// format and Errors lists the field violations of invalid payload
+type ProblemDetails struct {
+	Type      string          `json:"type"`
+	Title     string          `json:"title"`
+	Status    int             `json:"status"`
+	Detail    string          `json:"detail,omitempty"`
+	Instance  string          `json:"instance,omitempty"`
+	Code      string          `json:"code,omitempty"`
+	RequestID string          `json:"request_id,omitempty"`
+	Errors    []ErrorResponse `json:"errors,omitempty"`
+}
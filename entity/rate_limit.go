@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// Rate limit algorithms, token bucket allows bursts up to Limit and refills Limit tokens every Window,
+// sliding window counts requests of the last Window weighting the previous fixed window by its overlap
+const (
+	RateLimitTokenBucket   = "token_bucket"
+	RateLimitSlidingWindow = "sliding_window"
+)
+
+// Rate limit keys, requests without the key (ex. no verified API key) are counted by IP
+const (
+	RateLimitKeyByIP     = "ip"
+	RateLimitKeyByUser   = "user"
+	RateLimitKeyByAPIKey = "api_key"
+)
+
+// RateLimitPolicy allows Limit requests per Window for each key
+type RateLimitPolicy struct {
+	Name      string
+	Algorithm string
+	KeyBy     string
+	Limit     int
+	Window    time.Duration
+}
+
+// RateLimitResult is the state of a key after a request is counted, Reset is the time until the limit is fully
+// restored and RetryAfter the time until the next request is allowed (zero when Allowed)
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
@@ -1,18 +1,36 @@
 package entity
 
 const (
-	SUCCESS_CODE         = "00"
-	SUCCESS_MSG          = "Success"
-	INVALID_AUTH_CODE    = "01"
-	INVALID_AUTH_MSG     = "Invalid Email or Password"
-	INVALID_PAYLOAD_CODE = "02"
-	INVALID_PAYLOAD_MSG  = "Invalid Payload Request Data"
-	INVALID_TOKEN_CODE   = "05"
-	INVALID_TOKEN_MSG    = "Invalid Access Token"
-	BAD_REQUEST_CODE     = "30"
-	BAD_REQUEST_MSG      = "Bad Request"
-	DATA_NOT_FOUND_MSG   = "Data not found"
-	USER_NOT_FOUND_MSG   = "User not found"
+	SUCCESS_CODE                = "00"
+	SUCCESS_MSG                 = "Success"
+	INVALID_AUTH_CODE           = "01"
+	INVALID_AUTH_MSG            = "Invalid Email or Password"
+	INVALID_PAYLOAD_CODE        = "02"
+	INVALID_PAYLOAD_MSG         = "Invalid Payload Request Data"
+	INVALID_TOKEN_CODE          = "05"
+	INVALID_TOKEN_MSG           = "Invalid Access Token"
+	BAD_REQUEST_CODE            = "30"
+	BAD_REQUEST_MSG             = "Bad Request"
+	BULK_ROLLBACK_CODE          = "06"
+	BULK_ROLLBACK_MSG           = "Operation rolled back because another operation in the batch failed"
+	INVALID_IMPORT_CODE         = "07"
+	INVALID_IMPORT_MSG          = "Invalid import file"
+	MOVE_CONFLICT_CODE          = "08"
+	MOVE_CONFLICT_MSG           = "Todo List cannot be moved to the requested position, please try again"
+	PRECONDITION_CODE           = "09"
+	PRECONDITION_MSG            = "Data has been modified by another request, please reload and try again"
+	IDEMPOTENCY_CODE            = "10"
+	IDEMPOTENCY_MISMATCH_MSG    = "Idempotency-Key has already been used with a different request"
+	IDEMPOTENCY_IN_PROGRESS_MSG = "A request with the same Idempotency-Key is still being processed"
+	TOO_MANY_REQUESTS_CODE      = "11"
+	TOO_MANY_REQUESTS_MSG       = "Too many requests, please try again later"
+	FORBIDDEN_CODE              = "12"
+	FORBIDDEN_MSG               = "You are not allowed to access this resource"
+	CONFLICT_CODE               = "13"
+	CONFLICT_MSG                = "Data conflicts with the current state, please reload and try again"
+	INTERNAL_ERROR_CODE         = "99"
+	DATA_NOT_FOUND_MSG          = "Data not found"
+	USER_NOT_FOUND_MSG          = "User not found"
 
 	GENERAL_ERROR_MESSAGE = "Something went wrong. Please try again later."
 )
@@ -1 +1,21 @@
 package entity
+
+import "time"
+
+// TodoListSearchResult is a todo list found by full-text search with its relevance score and highlighted
+// snippets (matched terms are wrapped in <mark></mark>), it is returned by every search implementation
+type TodoListSearchResult struct {
+	ID                 int64      `gorm:"column:id"`
+	UserID             int64      `gorm:"column:user_id"`
+	Title              string     `gorm:"column:title"`
+	Description        string     `gorm:"column:description"`
+	DoingAt            time.Time  `gorm:"column:doing_at"`
+	CompletedAt        *time.Time `gorm:"column:completed_at"`
+	Position           string     `gorm:"column:position"`
+	Version            int64      `gorm:"column:version"`
+	CreatedAt          time.Time  `gorm:"column:created_at"`
+	UpdatedAt          time.Time  `gorm:"column:updated_at"`
+	Relevance          float64    `gorm:"column:relevance"`
+	TitleSnippet       string     `gorm:"column:title_snippet"`
+	DescriptionSnippet string     `gorm:"column:description_snippet"`
+}
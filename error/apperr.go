@@ -0,0 +1,212 @@
+package error
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+// Kind is the category of an application error, it decides the HTTP status of the response
+type Kind uint8
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindUnauthorized
+	KindForbidden
+)
+
+// Sentinels of every kind, ex. errors.Is(err, ErrNotFound) reports whether err (or an error it wraps) is a
+// not found Error. CustomErrorResponse matches the sentinel of its HTTP status
+var (
+	ErrInternal     = &Error{Kind: KindInternal}
+	ErrValidation   = &Error{Kind: KindValidation}
+	ErrNotFound     = &Error{Kind: KindNotFound}
+	ErrConflict     = &Error{Kind: KindConflict}
+	ErrUnauthorized = &Error{Kind: KindUnauthorized}
+	ErrForbidden    = &Error{Kind: KindForbidden}
+)
+
+var sentinels = map[Kind]*Error{
+	KindInternal:     ErrInternal,
+	KindValidation:   ErrValidation,
+	KindNotFound:     ErrNotFound,
+	KindConflict:     ErrConflict,
+	KindUnauthorized: ErrUnauthorized,
+	KindForbidden:    ErrForbidden,
+}
+
+var kindStatuses = map[Kind]int{
+	KindInternal:     http.StatusInternalServerError,
+	KindValidation:   http.StatusUnprocessableEntity,
+	KindNotFound:     http.StatusNotFound,
+	KindConflict:     http.StatusConflict,
+	KindUnauthorized: http.StatusUnauthorized,
+	KindForbidden:    http.StatusForbidden,
+}
+
+// Error is an application error, Code and Message are sent to clients while Err is the cause kept for logs and
+// errors.Is/As. Fields are the field violations of a validation error
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []entity.ErrorResponse
+	Err     error
+}
+
+// New returns an error of kind with the code and message sent to clients
+func New(kind Kind, code string, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Validation returns an invalid payload error with the failed fields
+func Validation(fields []entity.ErrorResponse) *Error {
+	return &Error{
+		Kind:    KindValidation,
+		Code:    entity.INVALID_PAYLOAD_CODE,
+		Message: entity.INVALID_PAYLOAD_MSG,
+		Fields:  fields,
+	}
+}
+
+// InvalidField returns a validation error of a single field, ex. for rules that struct tags can not express
+func InvalidField(field string, tag string, value string, message string) *Error {
+	return Validation([]entity.ErrorResponse{{
+		FailedField: field,
+		Tag:         tag,
+		Value:       value,
+		Message:     message,
+	}})
+}
+
+func NotFound(message string) *Error {
+	return New(KindNotFound, entity.BAD_REQUEST_CODE, message)
+}
+
+func Conflict(message string) *Error {
+	return New(KindConflict, entity.CONFLICT_CODE, message)
+}
+
+func Unauthorized(message string) *Error {
+	return New(KindUnauthorized, entity.INVALID_TOKEN_CODE, message)
+}
+
+func Forbidden(message string) *Error {
+	return New(KindForbidden, entity.FORBIDDEN_CODE, message)
+}
+
+// Internal wraps an unexpected error, clients get a general message while err is kept as the cause
+func Internal(err error) *Error {
+	return New(KindInternal, entity.INTERNAL_ERROR_CODE, entity.GENERAL_ERROR_MESSAGE).Wrap(err)
+}
+
+// Wrap returns a copy of e caused by err
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+
+	return &wrapped
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the sentinel of the kind of e
+func (e *Error) Is(target error) bool {
+	return target == sentinels[e.Kind]
+}
+
+func (e *Error) HTTPStatus() int {
+	return kindStatuses[e.Kind]
+}
+
+// Response returns the body sent to clients, the cause of internal errors is only included when exposeCause
+// is true (ex. outside production) since it may reveal implementation details
+func (e *Error) Response(exposeCause bool) CustomErrorResponseWithMeta {
+	message := e.Message
+	if e.Kind == KindInternal && exposeCause && e.Err != nil {
+		message = e.Err.Error()
+	}
+
+	return CustomErrorResponseWithMeta{
+		Message:  message,
+		ErrCode:  e.Code,
+		HTTPCode: e.HTTPStatus(),
+		Meta:     e.Fields,
+	}
+}
+
+// Is matches the sentinel of the kind of the HTTP status, ex. ErrRecordNotFound() is ErrNotFound
+func (c CustomErrorResponse) Is(target error) bool {
+	return isStatusOf(target, c.HTTPCode)
+}
+
+func (c CustomErrorResponseWithMeta) Is(target error) bool {
+	return isStatusOf(target, c.HTTPCode)
+}
+
+// ResponseOf returns the body sent to clients for err, errors that are not Error nor CustomErrorResponse are
+// internal errors
+func ResponseOf(err error, exposeCause bool) CustomErrorResponseWithMeta {
+	var appErr *Error
+	var customMetaErr CustomErrorResponseWithMeta
+	var customErr CustomErrorResponse
+
+	switch {
+	case errors.As(err, &appErr):
+		return appErr.Response(exposeCause)
+	case errors.As(err, &customMetaErr):
+		return customMetaErr
+	case errors.As(err, &customErr):
+		return CustomErrorResponseWithMeta{
+			Message:  customErr.Message,
+			ErrCode:  customErr.ErrCode,
+			HTTPCode: customErr.HTTPCode,
+		}
+	default:
+		return Internal(err).Response(exposeCause)
+	}
+}
+
+// KindOf returns the kind of err, errors that are not Error nor CustomErrorResponse are internal
+func KindOf(err error) Kind {
+	for kind, sentinel := range sentinels {
+		if kind != KindInternal && errors.Is(err, sentinel) {
+			return kind
+		}
+	}
+
+	return KindInternal
+}
+
+func isStatusOf(target error, status int) bool {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return target == ErrValidation
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return target == ErrConflict
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusInternalServerError:
+		return target == ErrInternal
+	default:
+		return false
+	}
+}
@@ -0,0 +1,59 @@
+package error_test
+
+import (
+	"errors"
+	"testing"
+
+	errwrap "github.com/pkg/errors"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorIsAs(t *testing.T) {
+	cause := errors.New("record not found")
+	err := errwrap.Wrap(apperr.NotFound("Data not found").Wrap(cause), "Usecase.GetByID")
+
+	assert.ErrorIs(t, err, apperr.ErrNotFound)
+	assert.ErrorIs(t, err, cause)
+	assert.NotErrorIs(t, err, apperr.ErrConflict)
+
+	var appErr *apperr.Error
+	if assert.ErrorAs(t, err, &appErr) {
+		assert.Equal(t, apperr.KindNotFound, appErr.Kind)
+		assert.Equal(t, 404, appErr.HTTPStatus())
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	testcases := []struct {
+		name string
+		err  error
+		want apperr.Kind
+	}{
+		{"Validation", apperr.InvalidField("title", "required", "", "title is required"), apperr.KindValidation},
+		{"Custom Not Found", errwrap.Wrap(apperr.ErrRecordNotFound(), "Usecase.GetByID"), apperr.KindNotFound},
+		{"Custom Precondition", apperr.ErrPreconditionFailed(), apperr.KindConflict},
+		{"Custom Unauthorized", apperr.ErrInvalidToken(), apperr.KindUnauthorized},
+		{"Custom With Meta", apperr.ErrInvalidPayload(nil), apperr.KindValidation},
+		{"Forbidden", apperr.Forbidden("forbidden"), apperr.KindForbidden},
+		{"Unknown", errors.New("connection refused"), apperr.KindInternal},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, apperr.KindOf(tt.err))
+		})
+	}
+}
+
+func TestResponse(t *testing.T) {
+	err := apperr.Internal(errors.New("connection refused"))
+
+	assert.Equal(t, "Something went wrong. Please try again later.", err.Response(false).Message)
+	assert.Equal(t, "connection refused", err.Response(true).Message)
+	assert.Equal(t, 500, err.Response(false).HTTPCode)
+
+	// Cause of other kinds is never sent
+	notFound := apperr.NotFound("Data not found").Wrap(errors.New("record not found"))
+	assert.Equal(t, "Data not found", notFound.Response(true).Message)
+}
@@ -1,6 +1,7 @@
 package error
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/rahmatrdn/go-skeleton/entity"
@@ -47,6 +48,90 @@ func ErrInvalidPayload(meta []entity.ErrorResponse) CustomErrorResponseWithMeta
 	}
 }
 
+func ErrBulkRolledBack() CustomErrorResponseWithMeta {
+	return CustomErrorResponseWithMeta{
+		Message:  entity.BULK_ROLLBACK_MSG,
+		ErrCode:  entity.BULK_ROLLBACK_CODE,
+		HTTPCode: http.StatusConflict,
+	}
+}
+
+func ErrInvalidImportFile(reason string) CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  fmt.Sprintf("%s: %s", entity.INVALID_IMPORT_MSG, reason),
+		ErrCode:  entity.INVALID_IMPORT_CODE,
+		HTTPCode: http.StatusUnprocessableEntity,
+	}
+}
+
+// ErrMissingImportFile is returned when a multipart import request has no "file" field
+func ErrMissingImportFile() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  fmt.Sprintf("%s: %s", entity.INVALID_IMPORT_MSG, `multipart field "file" is required`),
+		ErrCode:  entity.INVALID_IMPORT_CODE,
+		HTTPCode: http.StatusBadRequest,
+	}
+}
+
+// ErrEmptyPatch is returned when a PATCH request has no field to update
+func ErrEmptyPatch() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  fmt.Sprintf("%s: %s", entity.INVALID_PAYLOAD_MSG, "patch must contain at least one field"),
+		ErrCode:  entity.INVALID_PAYLOAD_CODE,
+		HTTPCode: http.StatusBadRequest,
+	}
+}
+
+func ErrMoveConflict() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.MOVE_CONFLICT_MSG,
+		ErrCode:  entity.MOVE_CONFLICT_CODE,
+		HTTPCode: http.StatusConflict,
+	}
+}
+
+func ErrPreconditionFailed() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.PRECONDITION_MSG,
+		ErrCode:  entity.PRECONDITION_CODE,
+		HTTPCode: http.StatusPreconditionFailed,
+	}
+}
+
+func ErrIdempotencyKeyMismatch() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.IDEMPOTENCY_MISMATCH_MSG,
+		ErrCode:  entity.IDEMPOTENCY_CODE,
+		HTTPCode: http.StatusConflict,
+	}
+}
+
+func ErrIdempotencyKeyInProgress() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.IDEMPOTENCY_IN_PROGRESS_MSG,
+		ErrCode:  entity.IDEMPOTENCY_CODE,
+		HTTPCode: http.StatusConflict,
+	}
+}
+
+// ErrIdempotencyStoreUnavailable is returned when Idempotency-Key can not be checked, the request is not processed
+// so retrying it with the same key is safe
+func ErrIdempotencyStoreUnavailable() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.GENERAL_ERROR_MESSAGE,
+		ErrCode:  entity.INTERNAL_ERROR_CODE,
+		HTTPCode: http.StatusServiceUnavailable,
+	}
+}
+
+func ErrTooManyRequests() CustomErrorResponse {
+	return CustomErrorResponse{
+		Message:  entity.TOO_MANY_REQUESTS_MSG,
+		ErrCode:  entity.TOO_MANY_REQUESTS_CODE,
+		HTTPCode: http.StatusTooManyRequests,
+	}
+}
+
 type CustomErrorResponse struct {
 	Message  string `json:"message,omitempty"`
 	ErrCode  string `json:"code,omitempty"`
@@ -65,6 +150,10 @@ func (c CustomErrorResponse) Error() string {
 	return c.Message
 }
 
+func (c CustomErrorResponseWithMeta) Error() string {
+	return c.Message
+}
+
 func ErrGeneralInvalid() CustomErrorResponse {
 	return CustomErrorResponse{
 		Message:  entity.GENERAL_ERROR_MESSAGE,
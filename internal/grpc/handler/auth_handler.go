@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/proto/pb"
+)
+
+// AuthHandler serves pb.AuthServiceServer with the usecase of the REST auth routes
+type AuthHandler struct {
+	pb.UnimplementedAuthServiceServer
+	userUsecase usecase.UserUsecase
+}
+
+func NewAuthHandler(userUsecase usecase.UserUsecase) *AuthHandler {
+	return &AuthHandler{userUsecase: userUsecase}
+}
+
+func (h *AuthHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	data, err := h.userUsecase.VerifyByEmailAndPassword(ctx, &entity.LoginReq{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{
+		UserId:      data.UserID,
+		Name:        data.Name,
+		Email:       data.Email,
+		RoleAccess:  int32(data.RoleAccess),
+		AccessToken: data.Token,
+	}, nil
+}
+
+func (h *AuthHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	data, err := h.userUsecase.CreateAsGuest(ctx, &entity.CreateUserReq{
+		Name:            req.GetName(),
+		Email:           req.GetEmail(),
+		Password:        req.GetPassword(),
+		ReenterPassword: req.GetReenterPassword(),
+		Phone:           req.GetPhone(),
+		RoleAccess:      int8(req.GetRoleAccess()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterResponse{
+		UserId:      data.UserID,
+		Name:        data.Name,
+		Email:       data.Email,
+		RoleAccess:  data.RoleAccess,
+		Phone:       data.Phone,
+		AccessToken: data.Token,
+	}, nil
+}
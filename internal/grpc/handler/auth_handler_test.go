@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/handler"
+	"github.com/rahmatrdn/go-skeleton/proto/pb"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthHandlerTestSuite struct {
+	suite.Suite
+	userUsecase *mocks.UserUsecase
+	handler     *handler.AuthHandler
+}
+
+func (s *AuthHandlerTestSuite) SetupTest() {
+	s.userUsecase = &mocks.UserUsecase{}
+	s.handler = handler.NewAuthHandler(s.userUsecase)
+}
+
+func TestAuthHandler(t *testing.T) {
+	suite.Run(t, new(AuthHandlerTestSuite))
+}
+
+func (s *AuthHandlerTestSuite) TestLogin() {
+	s.Run("success", func() {
+		s.userUsecase.On("VerifyByEmailAndPassword", mock.Anything, &entity.LoginReq{
+			Email:    "user@example.com",
+			Password: "secret",
+		}).Return(&entity.LoginResponse{UserID: 1, Name: "User", Email: "user@example.com", RoleAccess: 2, Token: "token"}, nil).Once()
+
+		res, err := s.handler.Login(context.Background(), &pb.LoginRequest{Email: "user@example.com", Password: "secret"})
+
+		s.NoError(err)
+		s.Equal(int64(1), res.GetUserId())
+		s.Equal(int32(2), res.GetRoleAccess())
+		s.Equal("token", res.GetAccessToken())
+	})
+
+	s.Run("invalid email or password", func() {
+		s.userUsecase.On("VerifyByEmailAndPassword", mock.Anything, mock.Anything).Return(nil, apperr.ErrInvalidEmailOrPassword()).Once()
+
+		res, err := s.handler.Login(context.Background(), &pb.LoginRequest{})
+
+		s.Nil(res)
+		s.Equal(apperr.ErrInvalidEmailOrPassword(), err)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestRegister() {
+	s.Run("success", func() {
+		s.userUsecase.On("CreateAsGuest", mock.Anything, &entity.CreateUserReq{
+			Name:            "User",
+			Email:           "user@example.com",
+			Password:        "secret",
+			ReenterPassword: "secret",
+			Phone:           "0812",
+			RoleAccess:      2,
+		}).Return(&entity.CreateUserResponse{UserID: 1, Name: "User", RoleAccess: "Guest", Token: "token"}, nil).Once()
+
+		res, err := s.handler.Register(context.Background(), &pb.RegisterRequest{
+			Name:            "User",
+			Email:           "user@example.com",
+			Password:        "secret",
+			ReenterPassword: "secret",
+			Phone:           "0812",
+			RoleAccess:      2,
+		})
+
+		s.NoError(err)
+		s.Equal(int64(1), res.GetUserId())
+		s.Equal("Guest", res.GetRoleAccess())
+	})
+
+	s.Run("invalid payload", func() {
+		s.userUsecase.On("CreateAsGuest", mock.Anything, mock.Anything).Return(nil, apperr.Validation(nil)).Once()
+
+		res, err := s.handler.Register(context.Background(), &pb.RegisterRequest{})
+
+		s.Nil(res)
+		s.ErrorIs(err, apperr.ErrValidation)
+	})
+}
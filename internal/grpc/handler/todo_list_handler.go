@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/proto/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TodoListHandler serves pb.TodoListServiceServer with the usecase of the REST todo list routes, every call is
+// authenticated by interceptor.UnaryAuth
+type TodoListHandler struct {
+	pb.UnimplementedTodoListServiceServer
+	todoListCrudUsecase todo_list_usecase.ICrudTodoListUsecase
+}
+
+func NewTodoListHandler(todoListCrudUsecase todo_list_usecase.ICrudTodoListUsecase) *TodoListHandler {
+	return &TodoListHandler{todoListCrudUsecase: todoListCrudUsecase}
+}
+
+func (h *TodoListHandler) ListTodoLists(ctx context.Context, req *pb.ListTodoListsRequest) (*pb.ListTodoListsResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.todoListCrudUsecase.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListTodoListsResponse{TodoLists: make([]*pb.TodoList, 0, len(data))}
+	for _, v := range data {
+		res.TodoLists = append(res.TodoLists, toTodoList(v))
+	}
+
+	return res, nil
+}
+
+func (h *TodoListHandler) GetTodoList(ctx context.Context, req *pb.GetTodoListRequest) (*pb.TodoList, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// lists of other users are reported as not found
+	data, err := h.todoListCrudUsecase.GetByID(ctx, userID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, apperr.ErrRecordNotFound()
+	}
+
+	return toTodoList(data), nil
+}
+
+func (h *TodoListHandler) CreateTodoList(ctx context.Context, req *pb.CreateTodoListRequest) (*pb.TodoList, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.todoListCrudUsecase.Create(ctx, entity.TodoListReq{
+		UserID:      userID,
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		DoingAt:     req.GetDoingAt(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toTodoList(data), nil
+}
+
+func (h *TodoListHandler) UpdateTodoList(ctx context.Context, req *pb.UpdateTodoListRequest) (*emptypb.Empty, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todoListReq := entity.TodoListReq{
+		ID:          req.GetId(),
+		UserID:      userID,
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		DoingAt:     req.GetDoingAt(),
+	}
+	// Version is checked like the If-Match header of the REST API
+	if req.GetVersion() > 0 {
+		todoListReq.IfMatch = helper.VersionETag(req.GetVersion())
+	}
+
+	// lists of other users are reported as not found by the usecase
+	if _, err := h.todoListCrudUsecase.UpdateByID(ctx, todoListReq); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TodoListHandler) DeleteTodoList(ctx context.Context, req *pb.DeleteTodoListRequest) (*emptypb.Empty, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.todoListCrudUsecase.DeleteByID(ctx, userID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// userIDFromContext returns the user of the verified JWT, see interceptor.UnaryAuth
+func userIDFromContext(ctx context.Context) (int64, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.UserID == 0 {
+		return 0, apperr.ErrInvalidToken()
+	}
+
+	return claims.UserID, nil
+}
+
+func toTodoList(v *entity.TodoListResponse) *pb.TodoList {
+	return &pb.TodoList{
+		Id:          v.ID,
+		Title:       v.Title,
+		Description: v.Description,
+		DoingAt:     v.DoingAt,
+		CompletedAt: v.CompletedAt,
+		Position:    v.Position,
+		Version:     v.Version,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+	}
+}
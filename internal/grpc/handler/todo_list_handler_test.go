@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/proto/pb"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TodoListHandlerTestSuite struct {
+	suite.Suite
+	todoListCrudUsecase *mocks.ICrudTodoListUsecase
+	handler             *handler.TodoListHandler
+	ctx                 context.Context
+}
+
+func (s *TodoListHandlerTestSuite) SetupTest() {
+	s.todoListCrudUsecase = &mocks.ICrudTodoListUsecase{}
+	s.handler = handler.NewTodoListHandler(s.todoListCrudUsecase)
+	s.ctx = auth.NewContext(context.Background(), &generalEntity.Claims{UserID: 7})
+}
+
+func TestTodoListHandler(t *testing.T) {
+	suite.Run(t, new(TodoListHandlerTestSuite))
+}
+
+func (s *TodoListHandlerTestSuite) TestListTodoLists() {
+	s.Run("success", func() {
+		s.todoListCrudUsecase.On("GetByUserID", mock.Anything, int64(7)).Return([]*entity.TodoListResponse{
+			{ID: 1, Title: "Title", DoingAt: "2024-01-02", Version: 3},
+		}, nil).Once()
+
+		res, err := s.handler.ListTodoLists(s.ctx, &pb.ListTodoListsRequest{})
+
+		s.NoError(err)
+		s.Require().Len(res.GetTodoLists(), 1)
+		s.Equal(int64(1), res.GetTodoLists()[0].GetId())
+		s.Equal("2024-01-02", res.GetTodoLists()[0].GetDoingAt())
+		s.Equal(int64(3), res.GetTodoLists()[0].GetVersion())
+	})
+
+	s.Run("unauthenticated", func() {
+		res, err := s.handler.ListTodoLists(context.Background(), &pb.ListTodoListsRequest{})
+
+		s.Nil(res)
+		s.ErrorIs(err, apperr.ErrUnauthorized)
+	})
+}
+
+func (s *TodoListHandlerTestSuite) TestGetTodoList() {
+	s.Run("success", func() {
+		s.todoListCrudUsecase.On("GetByID", mock.Anything, int64(7), int64(1)).Return(&entity.TodoListResponse{ID: 1, Title: "Title"}, nil).Once()
+
+		res, err := s.handler.GetTodoList(s.ctx, &pb.GetTodoListRequest{Id: 1})
+
+		s.NoError(err)
+		s.Equal("Title", res.GetTitle())
+	})
+
+	s.Run("not found", func() {
+		s.todoListCrudUsecase.On("GetByID", mock.Anything, int64(7), int64(2)).Return(nil, nil).Once()
+
+		res, err := s.handler.GetTodoList(s.ctx, &pb.GetTodoListRequest{Id: 2})
+
+		s.Nil(res)
+		s.ErrorIs(err, apperr.ErrNotFound)
+	})
+
+	s.Run("unauthenticated", func() {
+		res, err := s.handler.GetTodoList(context.Background(), &pb.GetTodoListRequest{Id: 1})
+
+		s.Nil(res)
+		s.ErrorIs(err, apperr.ErrUnauthorized)
+	})
+}
+
+func (s *TodoListHandlerTestSuite) TestCreateTodoList() {
+	s.todoListCrudUsecase.On("Create", mock.Anything, entity.TodoListReq{
+		UserID:      7,
+		Title:       "Title",
+		Description: "Description",
+		DoingAt:     "2024-01-02",
+	}).Return(&entity.TodoListResponse{ID: 1, Title: "Title"}, nil).Once()
+
+	res, err := s.handler.CreateTodoList(s.ctx, &pb.CreateTodoListRequest{
+		Title:       "Title",
+		Description: "Description",
+		DoingAt:     "2024-01-02",
+	})
+
+	s.NoError(err)
+	s.Equal(int64(1), res.GetId())
+}
+
+func (s *TodoListHandlerTestSuite) TestUpdateTodoList() {
+	s.Run("with version", func() {
+		s.todoListCrudUsecase.On("UpdateByID", mock.Anything, entity.TodoListReq{
+			ID:      1,
+			UserID:  7,
+			Title:   "Title",
+			DoingAt: "2024-01-02",
+			IfMatch: `"3"`,
+		}).Return(&entity.TodoListResponse{ID: 1, Version: 4}, nil).Once()
+
+		_, err := s.handler.UpdateTodoList(s.ctx, &pb.UpdateTodoListRequest{Id: 1, Title: "Title", DoingAt: "2024-01-02", Version: 3})
+
+		s.NoError(err)
+	})
+
+	s.Run("modified", func() {
+		s.todoListCrudUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(nil, apperr.ErrPreconditionFailed()).Once()
+
+		_, err := s.handler.UpdateTodoList(s.ctx, &pb.UpdateTodoListRequest{Id: 1, Version: 2})
+
+		s.Equal(apperr.ErrPreconditionFailed(), err)
+	})
+
+	s.Run("other user", func() {
+		s.todoListCrudUsecase.On("UpdateByID", mock.Anything, mock.MatchedBy(func(req entity.TodoListReq) bool {
+			return req.ID == 2 && req.UserID == 7
+		})).Return(nil, apperr.ErrRecordNotFound()).Once()
+
+		unary := interceptor.UnaryError(false)
+		info := &grpc.UnaryServerInfo{FullMethod: pb.TodoListService_UpdateTodoList_FullMethodName}
+		_, err := unary(s.ctx, &pb.UpdateTodoListRequest{Id: 2, Title: "Title"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.handler.UpdateTodoList(ctx, req.(*pb.UpdateTodoListRequest))
+		})
+
+		s.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func (s *TodoListHandlerTestSuite) TestDeleteTodoList() {
+	s.todoListCrudUsecase.On("DeleteByID", mock.Anything, int64(7), int64(1)).Return(nil).Once()
+
+	_, err := s.handler.DeleteTodoList(s.ctx, &pb.DeleteTodoListRequest{Id: 1})
+
+	s.NoError(err)
+	s.todoListCrudUsecase.AssertExpectations(s.T())
+}
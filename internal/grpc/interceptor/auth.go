@@ -0,0 +1,78 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	"google.golang.org/grpc"
+)
+
+// MetadataAuthorization is the metadata key of the JWT, its value is "Bearer <token>" like the REST API
+const MetadataAuthorization = "authorization"
+
+// UnaryAuth verifies the JWT of every call and stores its claims in the context (see auth.ClaimsFromContext),
+// calls of publicMethods are served without token. A public method is a full method name (ex.
+// pb.AuthService_Login_FullMethodName) or a service name ending with / to make every method of it public
+func UnaryAuth(publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublic(info.FullMethod, publicMethods) {
+			return handler(ctx, req)
+		}
+
+		claims, err := verifyToken(ctx)
+		if err != nil {
+			return nil, apperr.ErrInvalidToken()
+		}
+
+		return handler(auth.NewContext(ctx, claims), req)
+	}
+}
+
+// StreamAuth is UnaryAuth for streaming calls, invalid tokens are rejected with Unauthenticated status
+func StreamAuth(publicMethods ...string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublic(info.FullMethod, publicMethods) {
+			return handler(srv, ss)
+		}
+
+		claims, err := verifyToken(ss.Context())
+		if err != nil {
+			return Status(apperr.ErrInvalidToken(), false).Err()
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: auth.NewContext(ss.Context(), claims)})
+	}
+}
+
+func verifyToken(ctx context.Context) (*entity.Claims, error) {
+	authorization := firstMetadata(ctx, MetadataAuthorization)
+	if len(authorization) <= 7 {
+		return nil, fmt.Errorf("EMPTY TOKEN")
+	}
+
+	return auth.ParseTokenString(authorization[7:])
+}
+
+func isPublic(fullMethod string, publicMethods []string) bool {
+	for _, v := range publicMethods {
+		if fullMethod == v || (strings.HasSuffix(v, "/") && strings.HasPrefix(fullMethod, v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serverStream overrides the context of a stream, ex. with the claims of the verified JWT
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,98 @@
+package interceptor_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// testKey is shared by every test since auth.ParseTokenString caches the first public key it reads
+var testKey = sync.OnceValues(func() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, 2048)
+})
+
+// signToken writes public_key.pem read by auth.ParseTokenString to a temporary working directory and returns a
+// token of userID signed with its private key
+func signToken(t *testing.T, userID int64) string {
+	key, err := testKey()
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "public_key.pem"), pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKey,
+	}), 0o600))
+	t.Chdir(dir)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS512, &entity.Claims{UserID: userID}).SignedString(key)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestUnaryAuth(t *testing.T) {
+	token := signToken(t, 7)
+	unary := interceptor.UnaryAuth("/test.Public/")
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, ok := auth.ClaimsFromContext(ctx)
+		if !ok {
+			return int64(0), nil
+		}
+
+		return claims.UserID, nil
+	}
+	call := func(method string, authorization string) (interface{}, error) {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptor.MetadataAuthorization, authorization))
+		}
+
+		return unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	t.Run("Public method", func(t *testing.T) {
+		userID, err := call("/test.Public/Login", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), userID)
+	})
+
+	t.Run("Valid token", func(t *testing.T) {
+		userID, err := call("/test.Private/Get", "Bearer "+token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), userID)
+	})
+
+	t.Run("Missing token", func(t *testing.T) {
+		_, err := call("/test.Private/Get", "")
+
+		assert.ErrorIs(t, err, apperr.ErrUnauthorized)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		_, err := call("/test.Private/Get", "Bearer "+token+"x")
+
+		assert.ErrorIs(t, err, apperr.ErrUnauthorized)
+	})
+}
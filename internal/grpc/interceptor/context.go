@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataRequestID is the metadata key of the request ID, it is echoed in the response header
+	MetadataRequestID = "x-request-id"
+	// MetadataAcceptLanguage is the metadata key of the preferred languages of validation messages
+	MetadataAcceptLanguage = "accept-language"
+)
+
+// UnaryRequestContext stores the request ID and the language of the call in the context like the RequestID and
+// Language middlewares of the REST API, the request ID is generated when the client sends none or an invalid one
+func UnaryRequestContext(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := firstMetadata(ctx, MetadataRequestID)
+	if !requestid.Valid(id) {
+		id = requestid.New()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataRequestID, id))
+
+	ctx = requestid.NewContext(ctx, id)
+	ctx = i18n.NewContext(ctx, i18n.Negotiate(firstMetadata(ctx, MetadataAcceptLanguage)))
+
+	return handler(ctx, req)
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,40 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryRequestContext(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return []string{requestid.FromContext(ctx), i18n.FromContext(ctx)}, nil
+	}
+
+	t.Run("From metadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			interceptor.MetadataRequestID, "req-123",
+			interceptor.MetadataAcceptLanguage, "en-US,en;q=0.9",
+		))
+
+		resp, err := interceptor.UnaryRequestContext(ctx, nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"req-123", i18n.English}, resp)
+	})
+
+	t.Run("Without metadata", func(t *testing.T) {
+		resp, err := interceptor.UnaryRequestContext(context.Background(), nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.([]string)[0], 36)
+		assert.Equal(t, i18n.Default(), resp.([]string)[1])
+	})
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the domain of the errdetails.ErrorInfo of errors, its reason is the error code of the REST API
+const ErrorDomain = "go-skeleton"
+
+// statusCodes maps the HTTP status of application errors to gRPC codes, other statuses are Internal
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.Aborted,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+}
+
+// UnaryError converts errors returned by handlers to gRPC status (see Status), the cause of internal errors is
+// only sent when exposeCause is true (ex. outside production)
+func UnaryError(exposeCause bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, Status(err, exposeCause).Err()
+		}
+
+		return resp, nil
+	}
+}
+
+// Status returns the gRPC status of err. Application errors are resolved like the REST API (see apperr.ResponseOf)
+// and carry their error code in errdetails.ErrorInfo, field violations of validation errors are sent in
+// errdetails.BadRequest. gRPC status and context errors are kept as is
+func Status(err error, exposeCause bool) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err)
+	}
+
+	res := apperr.ResponseOf(err, exposeCause)
+	code, ok := statusCodes[res.HTTPCode]
+	if !ok {
+		code = codes.Internal
+	}
+
+	var details []protoadapt.MessageV1
+	if res.ErrCode != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: res.ErrCode, Domain: ErrorDomain})
+	}
+	if len(res.Meta) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range res.Meta {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.FailedField,
+				Description: v.Message,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	st := status.New(code, res.Message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+
+	return st
+}
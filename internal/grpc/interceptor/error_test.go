@@ -0,0 +1,99 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"validation", apperr.InvalidField("Title", "required", "", "Title is a required field"), codes.InvalidArgument, entity.INVALID_PAYLOAD_MSG},
+		{"not found", apperr.ErrRecordNotFound(), codes.NotFound, entity.DATA_NOT_FOUND_MSG},
+		{"unauthorized", apperr.ErrInvalidToken(), codes.Unauthenticated, entity.INVALID_TOKEN_MSG},
+		{"forbidden", apperr.Forbidden("forbidden"), codes.PermissionDenied, "forbidden"},
+		{"conflict", apperr.ErrMoveConflict(), codes.Aborted, entity.MOVE_CONFLICT_MSG},
+		{"precondition", apperr.ErrPreconditionFailed(), codes.FailedPrecondition, entity.PRECONDITION_MSG},
+		{"too many requests", apperr.ErrTooManyRequests(), codes.ResourceExhausted, entity.TOO_MANY_REQUESTS_MSG},
+		{"wrapped", fmt.Errorf("update: %w", apperr.ErrRecordNotFound()), codes.NotFound, entity.DATA_NOT_FOUND_MSG},
+		{"internal", errors.New("connection refused"), codes.Internal, entity.GENERAL_ERROR_MESSAGE},
+		{"status", status.Error(codes.Unavailable, "unavailable"), codes.Unavailable, "unavailable"},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, ""},
+	}
+	for _, tt := range tests {
+		st := interceptor.Status(tt.err, false)
+
+		assert.Equal(t, tt.code, st.Code(), tt.name)
+		if tt.message != "" {
+			assert.Equal(t, tt.message, st.Message(), tt.name)
+		}
+	}
+}
+
+func TestStatusExposeCause(t *testing.T) {
+	assert.Equal(t, "connection refused", interceptor.Status(errors.New("connection refused"), true).Message())
+}
+
+func TestStatusDetails(t *testing.T) {
+	st := interceptor.Status(apperr.InvalidField("Title", "required", "", "Title is a required field"), false)
+
+	var errorInfo *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, v := range st.Details() {
+		switch detail := v.(type) {
+		case *errdetails.ErrorInfo:
+			errorInfo = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+
+	require.NotNil(t, errorInfo)
+	assert.Equal(t, entity.INVALID_PAYLOAD_CODE, errorInfo.Reason)
+	assert.Equal(t, interceptor.ErrorDomain, errorInfo.Domain)
+	require.NotNil(t, badRequest)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "Title", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "Title is a required field", badRequest.FieldViolations[0].Description)
+}
+
+func TestUnaryError(t *testing.T) {
+	unary := interceptor.UnaryError(false)
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, apperr.ErrRecordNotFound()
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := interceptor.UnaryRecovery(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("nil map")
+	})
+	assert.ErrorIs(t, err, apperr.ErrInternal)
+}
@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// MetadataRetryAfter is the header metadata key of the seconds until a rate limited call is allowed
+const MetadataRetryAfter = "retry-after"
+
+// UnaryRateLimit limits calls of methods (see UnaryAuth for the format) with policy like the RateLimit middleware
+// of the REST API, calls are counted per peer IP. Rejected calls return ResourceExhausted status and calls pass
+// through when the store fails. Every call passes through when store is nil or policy is disabled
+func UnaryRateLimit(store middleware.RateLimitStore, policy entity.RateLimitPolicy, methods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if store == nil || policy.Limit < 1 || !isPublic(info.FullMethod, methods) {
+			return handler(ctx, req)
+		}
+
+		funcName := "UnaryRateLimit"
+
+		key := policy.Name + ":ip:" + peerIP(ctx)
+		result, err := store.Take(ctx, key, policy)
+		if err != nil {
+			helper.LogErrorContext(ctx, "store.Take", funcName, err, entity.CaptureFields{
+				"policy": policy.Name,
+				"key":    key,
+			}, "")
+			return handler(ctx, req)
+		}
+
+		if !result.Allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataRetryAfter, strconv.Itoa(max(int(math.Ceil(result.RetryAfter.Seconds())), 1))))
+			return nil, apperr.ErrTooManyRequests()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// peerIP returns the IP of the client connection, empty when it is unknown
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
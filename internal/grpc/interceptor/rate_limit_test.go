@@ -0,0 +1,72 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/grpc/interceptor"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) Take(ctx context.Context, key string, policy entity.RateLimitPolicy) (*entity.RateLimitResult, error) {
+	return nil, errors.New("store down")
+}
+
+func TestUnaryRateLimit(t *testing.T) {
+	policy := entity.RateLimitPolicy{
+		Name:      "auth",
+		Algorithm: entity.RateLimitSlidingWindow,
+		KeyBy:     entity.RateLimitKeyByIP,
+		Limit:     2,
+		Window:    time.Minute,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(unary grpc.UnaryServerInterceptor, method string, ip string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("Limited per peer IP", func(t *testing.T) {
+		unary := interceptor.UnaryRateLimit(memory.NewRateLimitRepository(), policy, "/test.Auth/")
+
+		assert.NoError(t, call(unary, "/test.Auth/Login", "10.0.0.1"))
+		assert.NoError(t, call(unary, "/test.Auth/Register", "10.0.0.1"))
+		assert.ErrorIs(t, call(unary, "/test.Auth/Login", "10.0.0.1"), apperr.ErrTooManyRequests())
+		assert.NoError(t, call(unary, "/test.Auth/Login", "10.0.0.2"))
+	})
+
+	t.Run("Other methods are not limited", func(t *testing.T) {
+		unary := interceptor.UnaryRateLimit(memory.NewRateLimitRepository(), policy, "/test.Auth/")
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, call(unary, "/test.Todo/List", "10.0.0.1"))
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		unary := interceptor.UnaryRateLimit(nil, policy, "/test.Auth/")
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, call(unary, "/test.Auth/Login", "10.0.0.1"))
+		}
+	})
+
+	t.Run("Store failure passes through", func(t *testing.T) {
+		unary := interceptor.UnaryRateLimit(failingRateLimitStore{}, policy, "/test.Auth/")
+
+		assert.NoError(t, call(unary, "/test.Auth/Login", "10.0.0.1"))
+	})
+}
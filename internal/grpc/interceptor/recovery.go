@@ -0,0 +1,24 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"google.golang.org/grpc"
+)
+
+// UnaryRecovery turns a panic of a handler into an internal error instead of stopping the server, the stack
+// trace is logged like the recover middleware of the REST API
+func UnaryRecovery(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Printf("panic: %v\n%s\n", e, debug.Stack())
+			err = apperr.Internal(fmt.Errorf("panic in %s: %v", info.FullMethod, e))
+		}
+	}()
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"gorm.io/gorm"
+)
+
+type checkerFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// NewChecker returns a HealthChecker named name running check
+func NewChecker(name string, check func(ctx context.Context) error) HealthChecker {
+	return checkerFunc{name, check}
+}
+
+func (c checkerFunc) Name() string {
+	return c.name
+}
+
+func (c checkerFunc) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// NewGormChecker pings the connection pool of db, name is the component name (ex. mysql or postgresql)
+func NewGormChecker(name string, db *gorm.DB) HealthChecker {
+	return NewChecker(name, func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		return sqlDB.PingContext(ctx)
+	})
+}
+
+func NewRedisChecker(client *redis.Client) HealthChecker {
+	return NewChecker("redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+}
+
+func NewMongoDBChecker(db *mongo.Database) HealthChecker {
+	return NewChecker("mongodb", func(ctx context.Context) error {
+		return db.Client().Ping(ctx, readpref.Primary())
+	})
+}
+
+// Connectivity is implemented by queue.RabbitMQ
+type Connectivity interface {
+	IsConnected() bool
+}
+
+func NewRabbitMQChecker(queue Connectivity) HealthChecker {
+	return NewChecker("rabbitmq", func(ctx context.Context) error {
+		if !queue.IsConnected() {
+			return errors.New("connection is closed")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,151 @@
+// Package health checks the dependencies of the app (database, cache, message broker) for the readiness probe
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+// HealthChecker checks a dependency, Check returns an error when the dependency cannot be used
+type HealthChecker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// Reporter reports the status of the app components, it is implemented by Registry
+type Reporter interface {
+	Check(ctx context.Context) entity.HealthResponse
+}
+
+type registration struct {
+	checker  HealthChecker
+	critical bool
+	timeout  time.Duration
+}
+
+// Registry runs the registered checks concurrently. The app is ready when every critical check passes and
+// it is not shutting down
+type Registry struct {
+	mu             sync.RWMutex
+	registrations  []registration
+	defaultTimeout time.Duration
+	shuttingDown   atomic.Bool
+}
+
+// NewRegistry returns a registry whose checks time out after defaultTimeout unless registered with their own
+func NewRegistry(defaultTimeout time.Duration) *Registry {
+	return &Registry{defaultTimeout: defaultTimeout}
+}
+
+// Register adds checker, a failing critical checker makes the app not ready while a failing non-critical
+// checker only degrades it. The default timeout of the registry is used when timeout is 0.
+// A component registered again (same name, ex. a client shared by several features) is checked once, it is
+// critical when any of its registrations is
+func (r *Registry) Register(checker HealthChecker, critical bool, timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = r.defaultTimeout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, reg := range r.registrations {
+		if reg.checker.Name() == checker.Name() {
+			r.registrations[i].critical = reg.critical || critical
+			return
+		}
+	}
+
+	r.registrations = append(r.registrations, registration{checker, critical, timeout})
+}
+
+// Shutdown makes the app not ready, it is called when graceful shutdown starts so the load balancer stops
+// sending requests before the server stops accepting them
+func (r *Registry) Shutdown() {
+	r.shuttingDown.Store(true)
+}
+
+// Check runs every check and returns the status of each component
+func (r *Registry) Check(ctx context.Context) entity.HealthResponse {
+	if r.shuttingDown.Load() {
+		return entity.HealthResponse{Status: entity.HealthDown, ShuttingDown: true}
+	}
+
+	r.mu.RLock()
+	registrations := append([]registration(nil), r.registrations...)
+	r.mu.RUnlock()
+
+	components := make([]entity.HealthComponent, len(registrations))
+
+	var wg sync.WaitGroup
+	for i, reg := range registrations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = check(ctx, reg)
+		}()
+	}
+	wg.Wait()
+
+	res := entity.HealthResponse{
+		Status:     entity.HealthUp,
+		Components: make(map[string]entity.HealthComponent, len(registrations)),
+	}
+	for i, reg := range registrations {
+		component := components[i]
+		res.Components[reg.checker.Name()] = component
+
+		if component.Status == entity.HealthUp {
+			continue
+		}
+		if component.Critical {
+			res.Status = entity.HealthDown
+		} else if res.Status == entity.HealthUp {
+			res.Status = entity.HealthDegraded
+		}
+	}
+
+	return res
+}
+
+func check(ctx context.Context, reg registration) entity.HealthComponent {
+	ctx, cancel := context.WithTimeout(ctx, reg.timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	// The check runs in its own goroutine so a check ignoring ctx still times out
+	result := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				result <- fmt.Errorf("check panicked: %v", r)
+			}
+		}()
+		result <- reg.checker.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timed out after %s", reg.timeout)
+	}
+
+	component := entity.HealthComponent{
+		Status:    entity.HealthUp,
+		Critical:  reg.critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		component.Status = entity.HealthDown
+		component.Error = err.Error()
+	}
+
+	return component
+}
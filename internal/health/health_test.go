@@ -0,0 +1,106 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func up(ctx context.Context) error {
+	return nil
+}
+
+func down(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestRegistry(t *testing.T) {
+	testcases := []struct {
+		name       string
+		register   func(registry *health.Registry)
+		wantStatus string
+	}{
+		{
+			name: "Up",
+			register: func(registry *health.Registry) {
+				registry.Register(health.NewChecker("mysql", up), true, 0)
+				registry.Register(health.NewChecker("redis", up), false, 0)
+			},
+			wantStatus: entity.HealthUp,
+		},
+		{
+			name: "Degraded",
+			register: func(registry *health.Registry) {
+				registry.Register(health.NewChecker("mysql", up), true, 0)
+				registry.Register(health.NewChecker("redis", down), false, 0)
+			},
+			wantStatus: entity.HealthDegraded,
+		},
+		{
+			name: "Down",
+			register: func(registry *health.Registry) {
+				registry.Register(health.NewChecker("mysql", down), true, 0)
+				registry.Register(health.NewChecker("redis", up), false, 0)
+			},
+			wantStatus: entity.HealthDown,
+		},
+		{
+			name: "Critical Registered Again",
+			register: func(registry *health.Registry) {
+				registry.Register(health.NewChecker("redis", down), false, 0)
+				registry.Register(health.NewChecker("redis", down), true, 0)
+			},
+			wantStatus: entity.HealthDown,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := health.NewRegistry(time.Second)
+			tt.register(registry)
+
+			res := registry.Check(context.Background())
+
+			assert.Equal(t, tt.wantStatus, res.Status)
+			for _, component := range res.Components {
+				if component.Status == entity.HealthDown {
+					assert.Equal(t, "connection refused", component.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistryTimeout(t *testing.T) {
+	registry := health.NewRegistry(time.Second)
+	// The check ignores ctx, it must still time out
+	registry.Register(health.NewChecker("mongodb", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	}), true, 10*time.Millisecond)
+
+	start := time.Now()
+	res := registry.Check(context.Background())
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, entity.HealthDown, res.Status)
+	assert.Equal(t, "check timed out after 10ms", res.Components["mongodb"].Error)
+	assert.True(t, res.Components["mongodb"].Critical)
+}
+
+func TestRegistryShutdown(t *testing.T) {
+	registry := health.NewRegistry(time.Second)
+	registry.Register(health.NewChecker("mysql", up), true, 0)
+
+	registry.Shutdown()
+	res := registry.Check(context.Background())
+
+	assert.Equal(t, entity.HealthDown, res.Status)
+	assert.True(t, res.ShuttingDown)
+	assert.Empty(t, res.Components)
+}
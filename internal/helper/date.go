@@ -35,3 +35,20 @@ func ParseDate(dateStr string) (time.Time, error) {
 	const layout = "2006-01-02"
 	return time.Parse(layout, dateStr)
 }
+
+// LoadLocation returns the location of an IANA timezone name (ex. America/New_York), Asia/Jakarta when name is empty
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		name = "Asia/Jakarta"
+	}
+
+	return time.LoadLocation(name)
+}
+
+func ConvertToLocationTime(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("2006-01-02 15:04:05")
+}
+
+func ConvertToLocationDate(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("2006-01-02")
+}
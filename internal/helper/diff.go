@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+// StructDiff returns field level diff between before and after (struct or pointer of the same type),
+// keyed by snake_case field name. Use nil before for created data and nil after for deleted data.
+// When onlyNonZeroAfter is true, zero fields of after are treated as not changed, it matches
+// changes struct passed to gorm Updates. Fields in ignoreFields (Go field name) are skipped
+func StructDiff(before any, after any, onlyNonZeroAfter bool, ignoreFields ...string) map[string]entity.FieldDiff {
+	beforeMap := map[string]any{}
+	if !isNilValue(before) {
+		beforeMap = StructToMap(before, false)
+	}
+	afterMap := map[string]any{}
+	if !isNilValue(after) {
+		afterMap = StructToMap(after, onlyNonZeroAfter)
+	}
+
+	diff := make(map[string]entity.FieldDiff)
+	for name, newVal := range afterMap {
+		oldVal, ok := beforeMap[name]
+		if ok && reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+		if !ok && isZeroValue(newVal) {
+			continue
+		}
+
+		diff[toSnakeCase(name)] = entity.FieldDiff{Old: diffValue(oldVal), New: diffValue(newVal)}
+	}
+
+	if isNilValue(after) {
+		for name, oldVal := range beforeMap {
+			if isZeroValue(oldVal) {
+				continue
+			}
+
+			diff[toSnakeCase(name)] = entity.FieldDiff{Old: diffValue(oldVal), New: nil}
+		}
+	}
+
+	for _, name := range ignoreFields {
+		delete(diff, toSnakeCase(name))
+	}
+
+	return diff
+}
+
+// diffValue dereferences pointer so diff is stored as plain value
+func diffValue(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+
+	return rv.Interface()
+}
+
+func isNilValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func isZeroValue(v any) bool {
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+// toSnakeCase converts Go field name into snake_case (ex. DoingAt -> doing_at, UserID -> user_id)
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (prevLower || (nextLower && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
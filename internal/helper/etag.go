@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VersionETag returns strong entity tag of a data version (ex. "3")
+func VersionETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// MatchETag reports whether If-Match header value matches etag using strong comparison (RFC 7232),
+// "*" matches any current representation and weak tags never match
+func MatchETag(ifMatch string, etag string) bool {
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,12 +1,14 @@
 package helper
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/rahmatrdn/go-skeleton/config"
 	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
 	"go.uber.org/zap"
 )
 
@@ -33,8 +35,18 @@ func WriteLogToFile(data string, channel string) error {
 // If the app environment is set to production, the log will be written to a file.
 // If the app environment is set to development, the log will be written to the terminal.
 func Log(status entity.LogType, message string, funcName string, err error, logFields entity.CaptureFields, processName string) {
+	writeLog(context.Background(), status, message, funcName, err, logFields, processName)
+}
+
+// LogContext is Log with the request ID of ctx (see requestid) added to the fields
+func LogContext(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields entity.CaptureFields, processName string) {
+	writeLog(ctx, status, message, funcName, err, logFields, processName)
+}
+
+func writeLog(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields entity.CaptureFields, processName string) {
 	logger, _ := config.NewZapLog(GetAppEnv())
-	logger = logger.WithOptions(zap.AddCallerSkip(2))
+	// Skips writeLog, Log/LogContext and LogError/LogInfo/LogWarn so the caller of the latter is logged
+	logger = logger.WithOptions(zap.AddCallerSkip(3))
 	defer logger.Sync()
 
 	fields := []zap.Field{
@@ -44,6 +56,9 @@ func Log(status entity.LogType, message string, funcName string, err error, logF
 		zap.String("errorMessage", err.Error()),
 		zap.Any("logFields", logFields),
 	}
+	if requestID := requestid.FromContext(ctx); requestID != "" {
+		fields = append(fields, zap.String("requestId", requestID))
+	}
 
 	switch status {
 	case entity.LogError:
@@ -85,3 +100,18 @@ func LogInfo(processName string, funcName string, logFields entity.CaptureFields
 func LogWarn(processName string, funcName string, err error, logFields entity.CaptureFields, message string) {
 	Log(entity.LogWarning, message, funcName, err, logFields, processName)
 }
+
+// LogErrorContext is LogError with the request ID of ctx
+func LogErrorContext(ctx context.Context, process string, funcName string, err error, logFields entity.CaptureFields, message string) {
+	LogContext(ctx, entity.LogError, process, funcName, err, logFields, process)
+}
+
+// LogInfoContext is LogInfo with the request ID of ctx
+func LogInfoContext(ctx context.Context, processName string, funcName string, logFields entity.CaptureFields, message string) {
+	LogContext(ctx, entity.LogInfo, message, funcName, fmt.Errorf(""), logFields, processName)
+}
+
+// LogWarnContext is LogWarn with the request ID of ctx
+func LogWarnContext(ctx context.Context, processName string, funcName string, err error, logFields entity.CaptureFields, message string) {
+	LogContext(ctx, entity.LogWarning, message, funcName, err, logFields, processName)
+}
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+// nonPublicPrefixes are ranges that net.IP methods do not cover, ex. carrier-grade NAT which hosts the metadata
+// service of some clouds (100.100.100.200)
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// IsPublicIP reports whether ip is reachable on the internet. Loopback, private, link-local (including the cloud
+// metadata address 169.254.169.254), multicast and reserved addresses are not public
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckPublicHost returns an error when host is or resolves to an address that is not public (see IsPublicIP).
+// Hosts that can not be resolved are not rejected since they may resolve later, use PublicDialControl to check
+// the address that is actually dialed
+func CheckPublicHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("address %s is not public", ip)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("host %s resolves to address %s which is not public", host, addr.IP)
+		}
+	}
+
+	return nil
+}
+
+// PublicDialControl is net.Dialer Control that refuses connections to addresses that are not public, it runs
+// after DNS resolution so hosts resolving to internal addresses (ex. DNS rebinding) are rejected too
+func PublicDialControl(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("dial %s %s: address is not public", network, address)
+	}
+
+	return nil
+}
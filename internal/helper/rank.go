@@ -0,0 +1,152 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rank is a base62 string that is sorted lexicographically (column must use binary collation),
+// a new rank can always be generated between two ranks so moving an item only updates one row
+const (
+	rankDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	rankBase   = len(rankDigits)
+	rankWidth  = 6
+	rankStep   = 62 * 62
+
+	// RankInitial is the rank of the first item of an empty list
+	RankInitial = "U00000"
+)
+
+// RankBetween returns a rank that sorts after prev and before next.
+// Empty prev means the beginning of the list and empty next means the end of the list,
+// appending and prepending keep the rank length fixed while moving between two items
+// generates the shortest rank in between
+func RankBetween(prev string, next string) (string, error) {
+	if !isValidRank(prev) || !isValidRank(next) {
+		return "", fmt.Errorf("invalid rank %q or %q", prev, next)
+	}
+	if prev != "" && next != "" && prev >= next {
+		return "", fmt.Errorf("rank %q must be lower than %q", prev, next)
+	}
+
+	var rank string
+	switch {
+	case prev == "" && next == "":
+		return RankInitial, nil
+	case next == "":
+		rank = rankAfter(prev)
+	case prev == "":
+		rank = rankBefore(next)
+	default:
+		rank = rankMidpoint(prev, next)
+	}
+
+	if rank <= prev || (next != "" && rank >= next) {
+		return "", fmt.Errorf("no rank available between %q and %q", prev, next)
+	}
+
+	return rank, nil
+}
+
+func rankAfter(prev string) string {
+	value := rankValue(prev) + rankStep
+	if value >= rankMaxValue() {
+		return rankMidpoint(prev, "")
+	}
+
+	return rankString(value)
+}
+
+func rankBefore(next string) string {
+	value := rankValue(next) - rankStep
+	if value <= 0 {
+		return rankMidpoint("", next)
+	}
+
+	return rankString(value)
+}
+
+// rankMidpoint returns the shortest string between a and b (b empty means no upper bound),
+// generated ranks never end with the lowest digit so there is always room before them
+func rankMidpoint(a string, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == rankDigitIndex(b[n]) {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + rankMidpoint(rankTail(a, n), b[n:])
+		}
+	}
+
+	digitA := rankDigitAt(a, 0)
+	digitB := rankBase
+	if b != "" {
+		digitB = rankDigitIndex(b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+
+	return string(rankDigits[digitA]) + rankMidpoint(rankTail(a, 1), "")
+}
+
+// rankValue reads the first rankWidth digits (padded with the lowest digit) as a number
+func rankValue(rank string) int64 {
+	var value int64
+	for i := 0; i < rankWidth; i++ {
+		value = value*int64(rankBase) + int64(rankDigitAt(rank, i))
+	}
+
+	return value
+}
+
+func rankString(value int64) string {
+	rank := make([]byte, rankWidth)
+	for i := rankWidth - 1; i >= 0; i-- {
+		rank[i] = rankDigits[value%int64(rankBase)]
+		value /= int64(rankBase)
+	}
+
+	return string(rank)
+}
+
+func rankMaxValue() int64 {
+	value := int64(1)
+	for i := 0; i < rankWidth; i++ {
+		value *= int64(rankBase)
+	}
+
+	return value
+}
+
+func rankDigitAt(rank string, i int) int {
+	if i >= len(rank) {
+		return 0
+	}
+
+	return rankDigitIndex(rank[i])
+}
+
+func rankDigitIndex(c byte) int {
+	return strings.IndexByte(rankDigits, c)
+}
+
+func rankTail(rank string, n int) string {
+	if n >= len(rank) {
+		return ""
+	}
+
+	return rank[n:]
+}
+
+func isValidRank(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if rankDigitIndex(rank[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
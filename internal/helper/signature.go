@@ -0,0 +1,26 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// SignHMACSHA256 returns hex encoded HMAC-SHA256 of message using secret as the key
+func SignHMACSHA256(secret string, message []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RandomHex returns hex encoded n random bytes from crypto/rand, used for secrets and tokens
+func RandomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
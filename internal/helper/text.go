@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+type textMatch struct {
+	start int
+	end   int
+}
+
+// HighlightSnippet returns a fragment of text (around fragmentSize characters) near the first match
+// of any query term, with every matched term wrapped in <mark></mark>. The text is HTML escaped,
+// so the result is safe to be rendered by clients. Text without any match is only truncated.
+func HighlightSnippet(text string, query string, fragmentSize int) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var terms [][]rune
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		term = strings.TrimFunc(term, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsNumber(r) })
+		if term != "" {
+			terms = append(terms, []rune(term))
+		}
+	}
+
+	var matches []textMatch
+	for i := 0; i < len(lower); {
+		matched := false
+		for _, term := range terms {
+			if hasRunePrefix(lower[i:], term) {
+				matches = append(matches, textMatch{i, i + len(term)})
+				i += len(term)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+
+	start, end := 0, len(runes)
+	if fragmentSize > 0 && len(runes) > fragmentSize {
+		if len(matches) > 0 {
+			start = max(0, matches[0].start-fragmentSize/4)
+		}
+		end = min(len(runes), start+fragmentSize)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+
+	pos := start
+	for _, m := range matches {
+		if m.end <= start || m.start >= end {
+			continue
+		}
+		mStart, mEnd := max(m.start, pos), min(m.end, end)
+		b.WriteString(html.EscapeString(string(runes[pos:mStart])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[mStart:mEnd])))
+		b.WriteString("</mark>")
+		pos = mEnd
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+
+	if end < len(runes) {
+		b.WriteString("...")
+	}
+
+	return b.String()
+}
+
+func hasRunePrefix(s []rune, prefix []rune) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+type claimsKey struct{}
+
+// NewContext returns ctx with the claims of the verified JWT, handlers that are not served by Fiber (ex. gRPC)
+// read the user from it instead of c.Locals
+func NewContext(ctx context.Context, claims *entity.Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of ctx, false when the request is not authenticated
+func ClaimsFromContext(ctx context.Context) (*entity.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*entity.Claims)
+	return claims, ok && claims != nil
+}
@@ -1,8 +1,10 @@
 package auth
 
 import (
+	"crypto/rsa"
 	"fmt"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -17,6 +19,10 @@ const (
 	publicKeyPath  = "public_key.pem"
 )
 
+// publicKey is read from publicKeyPath by the first verified token instead of on every request, replacing the
+// key file requires a restart
+var publicKey atomic.Pointer[rsa.PublicKey]
+
 type JWT struct{}
 
 func NewJWTAuth() *JWT {
@@ -59,35 +65,47 @@ func (j *JWT) GenerateToken(user *mentity.User) (string, error) {
 }
 
 func VerifyToken(c *fiber.Ctx) error {
-	authHeader := c.Get("Authorization")
-	if authHeader == "" {
-		return fmt.Errorf("EMPTY TOKEN")
+	claims, err := ParseToken(c)
+	if err != nil {
+		return err
 	}
 
-	token := authHeader[7:]
+	// Set data in Local Context
+	c.Locals("user_id", claims.UserID)
 
-	publicKeyBytes, err := os.ReadFile(publicKeyPath)
-	if err != nil {
-		return err
+	return nil
+}
+
+// ParseToken returns the claims of the verified JWT of the Authorization header without setting them in the
+// context, ex. for middlewares running before VerifyJWTToken
+func ParseToken(c *fiber.Ctx) (*entity.Claims, error) {
+	authHeader := c.Get("Authorization")
+	if len(authHeader) <= 7 {
+		return nil, fmt.Errorf("EMPTY TOKEN")
 	}
 
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
+	return ParseTokenString(authHeader[7:])
+}
+
+// ParseTokenString returns the claims of a verified JWT, ex. the bearer token of gRPC metadata
+func ParseTokenString(token string) (*entity.Claims, error) {
+	publicKey, err := loadPublicKey()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	claims := &entity.Claims{}
 	tkn, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
 		return publicKey, nil
 	})
-	if err != nil || !tkn.Valid {
-		return err
+	if err != nil {
+		return nil, err
+	}
+	if !tkn.Valid {
+		return nil, fmt.Errorf("INVALID TOKEN")
 	}
 
-	// Set data in Local Context
-	c.Locals("user_id", claims.UserID)
-
-	return nil
+	return claims, nil
 }
 
 func RefreshToken(c *fiber.Ctx) (string, error) {
@@ -100,12 +118,7 @@ func RefreshToken(c *fiber.Ctx) (string, error) {
 
 	cfg := config.NewConfig()
 
-	publicKeyBytes, err := os.ReadFile(publicKeyPath)
-	if err != nil {
-		return "", err
-	}
-
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
+	publicKey, err := loadPublicKey()
 	if err != nil {
 		return "", err
 	}
@@ -139,3 +152,23 @@ func RefreshToken(c *fiber.Ctx) (string, error) {
 
 	return signedToken, nil
 }
+
+// loadPublicKey returns the cached public key, the file is read again until it is loaded successfully
+func loadPublicKey() (*rsa.PublicKey, error) {
+	if key := publicKey.Load(); key != nil {
+		return key, nil
+	}
+
+	publicKeyBytes, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	publicKey.Store(key)
+
+	return key, nil
+}
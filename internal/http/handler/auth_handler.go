@@ -27,8 +27,8 @@ func NewAuthHandler(
 }
 
 func (w *AuthHandler) Register(app fiber.Router) {
-	app.Post("/auth/register", w.CreateAsGuest)
-	app.Post("/auth/login", w.Login)
+	app.Post("/auth/register", middleware.RateLimit(middleware.RateLimitAuth), w.CreateAsGuest)
+	app.Post("/auth/login", middleware.RateLimit(middleware.RateLimitAuth), w.Login)
 	app.Get("/auth/check-token", middleware.VerifyJWTToken, w.CheckToken)
 }
 
@@ -41,6 +41,7 @@ func (w *AuthHandler) Register(app fiber.Router) {
 // @Success			201 {object} entity.GeneralResponse{data=entity.CreateUserResponse} "Success"
 // @Failure			401 {object} entity.CustomErrorResponse "Invalid Access Token"
 // @Failure			422 {object} entity.CustomErrorResponse "Invalid Payload Request Body"
+// @Failure			429 {object} entity.CustomErrorResponse "Too many requests, retry after Retry-After seconds"
 // @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
 // @Router			/api/v1/auth/register [post]
 func (w *AuthHandler) CreateAsGuest(c *fiber.Ctx) error {
@@ -68,6 +69,7 @@ func (w *AuthHandler) CreateAsGuest(c *fiber.Ctx) error {
 // @Success			201 {object} entity.GeneralResponse{data=entity.LoginResponse} "Success"
 // @Failure			401 {object} entity.CustomErrorResponse "Invalid Access Token"
 // @Failure			422 {object} entity.CustomErrorResponse "Invalid Payload Request Body"
+// @Failure			429 {object} entity.CustomErrorResponse "Too many requests, retry after Retry-After seconds"
 // @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
 // @Router			/api/v1/auth/login [post]
 func (w *AuthHandler) Login(c *fiber.Ctx) error {
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/health"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+type HealthHandler struct {
+	reporter     health.Reporter
+	exposeErrors bool
+}
+
+// NewHealthHandler creates health handler, errors of failing components (ex. dial tcp 10.0.0.5:3306: connection
+// refused) reveal the infrastructure so they are only returned when exposeErrors is set
+func NewHealthHandler(reporter health.Reporter, exposeErrors bool) *HealthHandler {
+	return &HealthHandler{reporter, exposeErrors}
+}
+
+func (h *HealthHandler) Register(app fiber.Router) {
+	app.Get("/livez", h.Livez)
+	app.Get("/readyz", h.Readyz)
+}
+
+// @Summary         Liveness Probe
+// @Description     Returns 200 while the process is able to serve requests, dependencies are not checked (see /readyz)
+// @Tags			Health
+// @Produce			json
+// @Success			200 {object} entity.HealthResponse "Alive"
+// @Router			/livez [get]
+func (h *HealthHandler) Livez(c *fiber.Ctx) error {
+	return c.JSON(entity.HealthResponse{Status: entity.HealthUp})
+}
+
+// @Summary         Readiness Probe
+// @Description     Checks the dependencies of the app. Returns 503 when a critical component is down or the app is shutting down, a down non-critical component only degrades the status. Errors of failing components are only returned when HEALTH_EXPOSE_ERRORS is set
+// @Tags			Health
+// @Produce			json
+// @Success			200 {object} entity.HealthResponse "Ready (up or degraded)"
+// @Failure			503 {object} entity.HealthResponse "Not ready"
+// @Router			/readyz [get]
+func (h *HealthHandler) Readyz(c *fiber.Ctx) error {
+	res := h.reporter.Check(c.UserContext())
+
+	if !h.exposeErrors {
+		for name, component := range res.Components {
+			component.Error = ""
+			res.Components[name] = component
+		}
+	}
+
+	status := fiber.StatusOK
+	if res.Status == entity.HealthDown {
+		status = fiber.StatusServiceUnavailable
+	}
+
+	return c.Status(status).JSON(res)
+}
@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/health"
+	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthHandlerTestSuite struct {
+	suite.Suite
+	registry *health.Registry
+	app      *fiber.App
+}
+
+func (s *HealthHandlerTestSuite) SetupTest() {
+	s.registry = health.NewRegistry(time.Second)
+	s.app = fiber.New()
+
+	handler.NewHealthHandler(s.registry, false).Register(s.app)
+}
+
+func TestHealthHandler(t *testing.T) {
+	suite.Run(t, new(HealthHandlerTestSuite))
+}
+
+func (s *HealthHandlerTestSuite) request(path string) (int, entity.HealthResponse) {
+	resp, err := s.app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+	s.Require().NoError(err)
+
+	var res entity.HealthResponse
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&res))
+
+	return resp.StatusCode, res
+}
+
+func (s *HealthHandlerTestSuite) TestLivez() {
+	s.registry.Register(health.NewChecker("mysql", func(ctx context.Context) error {
+		return errors.New("connection refused")
+	}), true, 0)
+
+	// Liveness does not depend on dependencies
+	status, res := s.request("/livez")
+
+	s.Equal(fiber.StatusOK, status)
+	s.Equal(entity.HealthUp, res.Status)
+}
+
+func (s *HealthHandlerTestSuite) TestReadyz() {
+	redisErr := errors.New("connection refused")
+	s.registry.Register(health.NewChecker("mysql", func(ctx context.Context) error {
+		return nil
+	}), true, 0)
+	s.registry.Register(health.NewChecker("redis", func(ctx context.Context) error {
+		return redisErr
+	}), false, 0)
+
+	s.Run("Degraded", func() {
+		status, res := s.request("/readyz")
+
+		s.Equal(fiber.StatusOK, status)
+		s.Equal(entity.HealthDegraded, res.Status)
+		s.Equal(entity.HealthUp, res.Components["mysql"].Status)
+		s.True(res.Components["mysql"].Critical)
+		s.Equal(entity.HealthDown, res.Components["redis"].Status)
+		s.False(res.Components["redis"].Critical)
+		s.Empty(res.Components["redis"].Error)
+	})
+
+	s.Run("Expose Errors", func() {
+		app := fiber.New()
+		handler.NewHealthHandler(s.registry, true).Register(app)
+
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+		s.Require().NoError(err)
+
+		var res entity.HealthResponse
+		s.Require().NoError(json.NewDecoder(resp.Body).Decode(&res))
+		s.Equal(redisErr.Error(), res.Components["redis"].Error)
+	})
+
+	s.Run("Shutting Down", func() {
+		s.registry.Shutdown()
+
+		status, res := s.request("/readyz")
+
+		s.Equal(fiber.StatusServiceUnavailable, status)
+		s.Equal(entity.HealthDown, res.Status)
+		s.True(res.ShuttingDown)
+	})
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/notification/entity"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+type NotificationHandler struct {
+	parser                   parser.Parser
+	presenter                json.JsonPresenter
+	inboxNotificationUsecase notification_usecase.IInboxNotificationUsecase
+}
+
+func NewNotificationHandler(
+	parser parser.Parser,
+	presenter json.JsonPresenter,
+	inboxNotificationUsecase notification_usecase.IInboxNotificationUsecase,
+) *NotificationHandler {
+	return &NotificationHandler{parser, presenter, inboxNotificationUsecase}
+}
+
+func (w *NotificationHandler) Register(app fiber.Router) {
+	app.Get("/me/notifications", middleware.VerifyJWTToken, w.GetByUserID)
+	app.Post("/me/notifications/read-all", middleware.VerifyJWTToken, w.MarkAllRead)
+	app.Post("/me/notifications/:id/read", middleware.VerifyJWTToken, w.MarkRead)
+}
+
+// @Summary         Retrieve Notifications
+// @Description     Retrieve in-app notifications of the user ordered from the newest, with the unread count. Read notifications are deleted after the configured retention (default 30 days)
+// @Tags			Notification
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           page query int false "Page number (default 1)"
+// @Param           limit query int false "Data per page (default 20, max 100)"
+// @Success			200 {object} entity.GeneralResponse{data=entity.NotificationListResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/me/notifications [get]
+func (w *NotificationHandler) GetByUserID(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.NotificationListReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+
+	data, err := w.inboxNotificationUsecase.GetByUserID(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Mark Notification as read
+// @Description     Mark a notification of the user as read, marking a read notification again keeps its read time
+// @Tags			Notification
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the notification"
+// @Success			200 {object} entity.GeneralResponse{data=entity.NotificationResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Notification not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/me/notifications/{id}/read [post]
+func (w *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.inboxNotificationUsecase.MarkRead(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Mark all Notifications as read
+// @Description     Mark every unread notification of the user as read
+// @Tags			Notification
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Success			200 {object} entity.GeneralResponse{data=entity.MarkAllReadResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/me/notifications/read-all [post]
+func (w *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.inboxNotificationUsecase.MarkAllRead(c.Context(), userID)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
@@ -0,0 +1,216 @@
+package handler_test
+
+import (
+	"fmt"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/notification/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/valyala/fasthttp"
+)
+
+type NotificationHandlerTestSuite struct {
+	suite.Suite
+	inboxUsecase *mocks.IInboxNotificationUsecase
+	presenter    *mocks.Presenter
+	parser       *mocks.Parser
+	handler      *handler.NotificationHandler
+}
+
+func (s *NotificationHandlerTestSuite) SetupTest() {
+	s.inboxUsecase = &mocks.IInboxNotificationUsecase{}
+	s.presenter = &mocks.Presenter{}
+	s.parser = &mocks.Parser{}
+
+	s.handler = handler.NewNotificationHandler(s.parser, s.presenter, s.inboxUsecase)
+}
+
+func TestNotificationHandler(t *testing.T) {
+	suite.Run(t, new(NotificationHandlerTestSuite))
+}
+
+func (s *NotificationHandlerTestSuite) TestRegister() {
+	app := fiber.New()
+
+	s.handler.Register(app)
+}
+
+func (s *NotificationHandlerTestSuite) TestGetByUserID() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	userID := int64(2)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.inboxUsecase.On("GetByUserID", mock.Anything, mock.MatchedBy(func(req entity.NotificationListReq) bool {
+					return req.UserID == userID
+				})).Return(&entity.NotificationListResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parse query params",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase GetByUserID",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.inboxUsecase.On("GetByUserID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.GetByUserID(c)
+
+			if err != nil {
+				t.Errorf("GetByUserID() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *NotificationHandlerTestSuite) TestMarkRead() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+	userID := int64(2)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.inboxUsecase.On("MarkRead", mock.Anything, userID, ID).Return(&entity.NotificationResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get id from parser param",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase MarkRead",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.inboxUsecase.On("MarkRead", mock.Anything, userID, ID).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.MarkRead(c)
+
+			if err != nil {
+				t.Errorf("MarkRead() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *NotificationHandlerTestSuite) TestMarkAllRead() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	userID := int64(2)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.inboxUsecase.On("MarkAllRead", mock.Anything, userID).Return(&entity.MarkAllReadResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase MarkAllRead",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.inboxUsecase.On("MarkAllRead", mock.Anything, userID).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.MarkAllRead(c)
+
+			if err != nil {
+				t.Errorf("MarkAllRead() error = %v", err)
+				return
+			}
+		})
+	}
+}
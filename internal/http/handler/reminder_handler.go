@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+type ReminderHandler struct {
+	parser              parser.Parser
+	presenter           json.JsonPresenter
+	reminderCrudUsecase reminder_usecase.ICrudReminderUsecase
+}
+
+func NewReminderHandler(
+	parser parser.Parser,
+	presenter json.JsonPresenter,
+	reminderCrudUsecase reminder_usecase.ICrudReminderUsecase,
+) *ReminderHandler {
+	return &ReminderHandler{parser, presenter, reminderCrudUsecase}
+}
+
+func (w *ReminderHandler) Register(app fiber.Router) {
+	app.Get("/todo-lists/:id/reminders", middleware.VerifyJWTToken, w.GetByTodoListID)
+	app.Put("/todo-lists/:id/reminders", middleware.VerifyJWTToken, w.Set)
+}
+
+// @Summary         Retrieve Todo List reminders
+// @Description     Retrieve reminders of a Todo List ordered by remind time. A Todo List is due at the configured time of day (default 09:00 Asia/Jakarta) of its doing date
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the Todo List"
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.ReminderResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Todo List not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/{id}/reminders [get]
+func (w *ReminderHandler) GetByTodoListID(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.reminderCrudUsecase.GetByTodoListID(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Set Todo List reminders
+// @Description     Replace reminders of a Todo List with the given offsets in minutes before the due time (max. 5 offsets, each max. 10080 or 7 days). Each reminder is sent once per doing date, it is sent again when the doing date changes
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the Todo List"
+// @Param			req body entity.SetReminderReq true "Payload Request Body"
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.ReminderResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Todo List not found"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/{id}/reminders [put]
+func (w *ReminderHandler) Set(c *fiber.Ctx) error {
+	var req entity.SetReminderReq
+
+	err := w.parser.ParserBodyWithIntIDPathParamsAndUserID(c, &req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.reminderCrudUsecase.Set(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
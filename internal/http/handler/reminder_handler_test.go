@@ -0,0 +1,151 @@
+package handler_test
+
+import (
+	"fmt"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/valyala/fasthttp"
+)
+
+type ReminderHandlerTestSuite struct {
+	suite.Suite
+	reminderUsecase *mocks.ICrudReminderUsecase
+	presenter       *mocks.Presenter
+	parser          *mocks.Parser
+	handler         *handler.ReminderHandler
+}
+
+func (s *ReminderHandlerTestSuite) SetupTest() {
+	s.reminderUsecase = &mocks.ICrudReminderUsecase{}
+	s.presenter = &mocks.Presenter{}
+	s.parser = &mocks.Parser{}
+
+	s.handler = handler.NewReminderHandler(s.parser, s.presenter, s.reminderUsecase)
+}
+
+func TestReminderHandler(t *testing.T) {
+	suite.Run(t, new(ReminderHandlerTestSuite))
+}
+
+func (s *ReminderHandlerTestSuite) TestRegister() {
+	app := fiber.New()
+
+	s.handler.Register(app)
+}
+
+func (s *ReminderHandlerTestSuite) TestGetByTodoListID() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+	userID := int64(2)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.reminderUsecase.On("GetByTodoListID", mock.Anything, userID, ID).Return([]*entity.ReminderResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get id from parser param",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase GetByTodoListID",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.reminderUsecase.On("GetByTodoListID", mock.Anything, userID, ID).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.GetByTodoListID(c)
+
+			if err != nil {
+				t.Errorf("GetByTodoListID() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *ReminderHandlerTestSuite) TestSet() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.reminderUsecase.On("Set", mock.Anything, mock.Anything).Return([]*entity.ReminderResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parse body",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Set",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.reminderUsecase.On("Set", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Set(c)
+
+			if err != nil {
+				t.Errorf("Set() error = %v", err)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"bufio"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+const (
+	// streamRetry is the reconnect delay suggested to SSE clients
+	streamRetry = 3 * time.Second
+	// webSocketReadLimit limits client messages, clients are not expected to send data
+	webSocketReadLimit = 64 * 1024
+	webSocketWriteWait = 10 * time.Second
+	// webSocketUserID is the local of the user ID passed to the upgraded connection
+	webSocketUserID = "stream_user_id"
+)
+
+type StreamHandler struct {
+	parser         parser.Parser
+	presenter      json.JsonPresenter
+	subscriber     realtime.Subscriber
+	heartbeat      time.Duration
+	allowedOrigins []string
+	upgrade        fiber.Handler
+}
+
+// NewStreamHandler creates real-time stream handler, heartbeat is the interval of SSE comment and WebSocket ping
+// that keep idle connections open behind proxies. Browsers may open WebSocket from allowedOrigins, from the
+// origin of the API when it is empty
+func NewStreamHandler(
+	parser parser.Parser,
+	presenter json.JsonPresenter,
+	subscriber realtime.Subscriber,
+	heartbeat time.Duration,
+	allowedOrigins []string,
+) *StreamHandler {
+	s := &StreamHandler{
+		parser:         parser,
+		presenter:      presenter,
+		subscriber:     subscriber,
+		heartbeat:      heartbeat,
+		allowedOrigins: allowedOrigins,
+	}
+	// Origin is checked by WebSocket before the upgrade
+	s.upgrade = websocket.New(s.serveWebSocket, websocket.Config{
+		Subprotocols: []string{middleware.WebSocketTokenProtocol},
+	})
+
+	return s
+}
+
+func (s *StreamHandler) Register(app fiber.Router) {
+	app.Get("/stream", middleware.VerifyStreamToken, s.Stream)
+	app.Get("/stream/ws", middleware.VerifyWebSocketToken, s.WebSocket)
+}
+
+// @Summary         Stream Todo List events (SSE)
+// @Description     Server-Sent Events stream of the user Todo List changes. Each message has id (event ID), event (todo_list.created, todo_list.updated, todo_list.completed or todo_list.deleted) and data (JSON event with the Todo List as data). Browser EventSource can pass the token as access_token query
+// @Tags			Stream
+// @Produce			text/event-stream
+// @Security 		Bearer
+// @Param           access_token query string false "JWT token, used when Authorization header can not be sent"
+// @Success			200 {object} entity.Event "Stream of events"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Router			/api/v1/stream [get]
+func (s *StreamHandler) Stream(c *fiber.Ctx) error {
+	userID, err := s.parser.ParserUserID(c)
+	if err != nil {
+		return s.presenter.BuildError(c, err)
+	}
+
+	sub := s.subscriber.Subscribe(userID)
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// Disable response buffering of nginx
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		ticker := time.NewTicker(s.heartbeat)
+		defer ticker.Stop()
+
+		_ = realtime.WriteSSERetry(w, streamRetry)
+		// Flush fails when the client is gone
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				if err := realtime.WriteSSEEvent(w, event); err != nil {
+					return
+				}
+			case <-ticker.C:
+				_ = realtime.WriteSSEHeartbeat(w)
+			}
+
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// @Summary         Stream Todo List events (WebSocket)
+// @Description     WebSocket stream of the user Todo List changes, each text message is a JSON event (same as data of SSE stream). Messages sent by the client are ignored. Browser WebSocket can pass the token as subprotocol: new WebSocket(url, ["access_token", token]). Connections from origins other than STREAM_ALLOWED_ORIGINS are rejected
+// @Tags			Stream
+// @Security 		Bearer
+// @Param           Sec-WebSocket-Protocol header string false "access_token, followed by the JWT token when Authorization header can not be sent"
+// @Success			101 {object} entity.Event "Switching Protocols"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			403 {object} entity.CustomErrorResponse "Origin not allowed"
+// @Failure			422 {object} entity.CustomErrorResponse "Not a WebSocket upgrade request"
+// @Router			/api/v1/stream/ws [get]
+func (s *StreamHandler) WebSocket(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return s.presenter.BuildError(c, apperr.ErrInvalidRequest())
+	}
+	// Browsers send the cookies and the origin of the page with the handshake, pages of other sites are
+	// rejected (Cross-Site WebSocket Hijacking)
+	if !s.allowedOrigin(c) {
+		return s.presenter.BuildError(c, apperr.Forbidden(entity.FORBIDDEN_MSG))
+	}
+
+	userID, err := s.parser.ParserUserID(c)
+	if err != nil {
+		return s.presenter.BuildError(c, err)
+	}
+	c.Locals(webSocketUserID, userID)
+
+	return s.upgrade(c)
+}
+
+func (s *StreamHandler) serveWebSocket(conn *websocket.Conn) {
+	userID, _ := conn.Locals(webSocketUserID).(int64)
+
+	sub := s.subscriber.Subscribe(userID)
+	defer sub.Close()
+
+	// Client messages are discarded, pings are answered by the connection. Client is gone when it stops
+	// answering pings
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		conn.SetReadLimit(webSocketReadLimit)
+		_ = conn.SetReadDeadline(time.Now().Add(2 * s.heartbeat))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * s.heartbeat))
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	// conn is released when serveWebSocket returns, closing it ends the read loop
+	defer func() {
+		_ = conn.Close()
+		<-done
+	}()
+
+	ticker := time.NewTicker(s.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+					time.Now().Add(webSocketWriteWait))
+				return
+			}
+			payload, _ := helper.Serialize(event)
+			_ = conn.SetWriteDeadline(time.Now().Add(webSocketWriteWait))
+			if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// allowedOrigin reports whether the Origin of the handshake is allowed, clients other than browsers may send
+// no Origin
+func (s *StreamHandler) allowedOrigin(c *fiber.Ctx) bool {
+	origin := c.Get(fiber.HeaderOrigin)
+	if origin == "" {
+		return true
+	}
+	if len(s.allowedOrigins) > 0 {
+		return slices.Contains(s.allowedOrigins, origin) || slices.Contains(s.allowedOrigins, "*")
+	}
+
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == c.Hostname()
+}
@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamHandlerTestSuite struct {
+	suite.Suite
+	broker    *realtime.Broker
+	presenter *mocks.Presenter
+	parser    *mocks.Parser
+	handler   *handler.StreamHandler
+}
+
+func (s *StreamHandlerTestSuite) SetupTest() {
+	s.broker = realtime.NewBroker()
+	s.presenter = &mocks.Presenter{}
+	s.parser = &mocks.Parser{}
+
+	s.handler = handler.NewStreamHandler(s.parser, s.presenter, s.broker, time.Minute, []string{"https://app.example.com"})
+}
+
+func TestStreamHandler(t *testing.T) {
+	suite.Run(t, new(StreamHandlerTestSuite))
+}
+
+func (s *StreamHandlerTestSuite) TestRegister() {
+	app := fiber.New()
+
+	s.handler.Register(app)
+}
+
+// publishWhenSubscribed publishes events once user subscribed then closes the broker so the stream ends
+func (s *StreamHandlerTestSuite) publishWhenSubscribed(userID int64, events ...entity.Event) {
+	go func() {
+		for s.broker.SubscriberCount(userID) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		for _, event := range events {
+			s.broker.Publish(event)
+		}
+		s.broker.Close()
+	}()
+}
+
+func (s *StreamHandlerTestSuite) TestStream() {
+	app := fiber.New()
+	app.Get("/stream", s.handler.Stream)
+
+	s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+	s.publishWhenSubscribed(1,
+		entity.Event{ID: "evt-1", Type: entity.EventTodoListCreated, UserID: 1, Data: map[string]any{"id": 10}},
+		entity.Event{ID: "evt-2", Type: entity.EventTodoListCreated, UserID: 2},
+		entity.Event{ID: "evt-3", Type: entity.EventTodoListDeleted, UserID: 1},
+	)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/stream", nil), -1)
+	s.Require().NoError(err)
+	body, _ := io.ReadAll(resp.Body)
+
+	s.Equal(fiber.StatusOK, resp.StatusCode)
+	s.Equal("text/event-stream", resp.Header.Get(fiber.HeaderContentType))
+	s.True(strings.HasPrefix(string(body), "retry: 3000\n\n"))
+	s.Contains(string(body), "id: evt-1\nevent: todo_list.created\ndata: {\"id\":\"evt-1\",\"type\":\"todo_list.created\",\"user_id\":1,")
+	s.Contains(string(body), "\"data\":{\"id\":10}}\n\n")
+	s.Contains(string(body), "id: evt-3\nevent: todo_list.deleted\n")
+	s.NotContains(string(body), "evt-2")
+}
+
+func (s *StreamHandlerTestSuite) TestStreamUnauthorized() {
+	app := fiber.New()
+	app.Get("/stream", s.handler.Stream)
+
+	s.parser.On("ParserUserID", mock.Anything).Return(int64(0), fmt.Errorf("EMPTY USER ID")).Once()
+	s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/stream", nil))
+	s.NoError(err)
+	s.Equal(0, s.broker.SubscriberCount(0))
+	s.presenter.AssertExpectations(s.T())
+}
+
+func (s *StreamHandlerTestSuite) TestWebSocketNotUpgrade() {
+	app := fiber.New()
+	app.Get("/stream/ws", s.handler.WebSocket)
+
+	s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/stream/ws", nil))
+	s.NoError(err)
+	s.presenter.AssertExpectations(s.T())
+	s.parser.AssertNotCalled(s.T(), "ParserUserID", mock.Anything)
+}
+
+func (s *StreamHandlerTestSuite) TestWebSocketOriginNotAllowed() {
+	app := fiber.New()
+	app.Get("/stream/ws", s.handler.WebSocket)
+
+	s.presenter.On("BuildError", mock.Anything, mock.MatchedBy(func(err error) bool {
+		return errors.Is(err, apperr.ErrForbidden)
+	})).Return(nil).Once()
+
+	req := httptest.NewRequest(fiber.MethodGet, "/stream/ws", nil)
+	req.Header.Set(fiber.HeaderConnection, "Upgrade")
+	req.Header.Set(fiber.HeaderUpgrade, "websocket")
+	req.Header.Set(fiber.HeaderOrigin, "https://evil.example.com")
+
+	_, err := app.Test(req)
+	s.NoError(err)
+	s.presenter.AssertExpectations(s.T())
+	s.parser.AssertNotCalled(s.T(), "ParserUserID", mock.Anything)
+}
+
+func (s *StreamHandlerTestSuite) TestWebSocket() {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/stream/ws", s.handler.WebSocket)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	go func() { _ = app.Listener(ln) }()
+	defer app.Shutdown()
+
+	s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+	s.publishWhenSubscribed(1, entity.Event{ID: "evt-1", Type: entity.EventTodoListUpdated, UserID: 1})
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	s.Require().NoError(err)
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	_, err = fmt.Fprint(conn, "GET /stream/ws HTTP/1.1\r\nHost: localhost\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n"+
+		"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n"+
+		"Origin: https://app.example.com\r\nSec-WebSocket-Protocol: access_token, token\r\n\r\n")
+	s.Require().NoError(err)
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusSwitchingProtocols, resp.StatusCode)
+	s.Equal("access_token", resp.Header.Get(fiber.HeaderSecWebSocketProtocol))
+
+	// Text frame with the event, then close frame when the broker is closed
+	header := make([]byte, 2)
+	_, err = io.ReadFull(reader, header)
+	s.Require().NoError(err)
+	s.Equal(byte(0x81), header[0])
+	payload := make([]byte, header[1]&0x7F)
+	_, err = io.ReadFull(reader, payload)
+	s.Require().NoError(err)
+
+	var event entity.Event
+	s.Require().NoError(json.Unmarshal(payload, &event))
+	s.Equal("evt-1", event.ID)
+	s.Equal(entity.EventTodoListUpdated, event.Type)
+
+	_, err = io.ReadFull(reader, header)
+	s.Require().NoError(err)
+	s.Equal(byte(0x88), header[0])
+}
@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"bufio"
+	"io"
 	"net/http"
+	"strings"
 
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
 	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
 	"github.com/rahmatrdn/go-skeleton/internal/parser"
 	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
@@ -13,24 +18,53 @@ import (
 )
 
 type TodoListHandler struct {
-	parser              parser.Parser
-	presenter           json.JsonPresenter
-	todoListCrudUsecase todo_list_usecase.ICrudTodoListUsecase
+	parser                  parser.Parser
+	presenter               json.JsonPresenter
+	todoListCrudUsecase     todo_list_usecase.ICrudTodoListUsecase
+	todoListSearchUsecase   todo_list_usecase.ISearchTodoListUsecase
+	todoListExportUsecase   todo_list_usecase.IExportTodoListUsecase
+	todoListImportUsecase   todo_list_usecase.IImportTodoListUsecase
+	todoListStatsUsecase    todo_list_usecase.IStatsTodoListUsecase
+	todoListCalendarUsecase todo_list_usecase.ICalendarTodoListUsecase
 }
 
 func NewTodoListHandler(
 	parser parser.Parser,
 	presenter json.JsonPresenter,
 	todoListCrudUsecase todo_list_usecase.ICrudTodoListUsecase,
+	todoListSearchUsecase todo_list_usecase.ISearchTodoListUsecase,
+	todoListExportUsecase todo_list_usecase.IExportTodoListUsecase,
+	todoListImportUsecase todo_list_usecase.IImportTodoListUsecase,
+	todoListStatsUsecase todo_list_usecase.IStatsTodoListUsecase,
+	todoListCalendarUsecase todo_list_usecase.ICalendarTodoListUsecase,
 ) *TodoListHandler {
-	return &TodoListHandler{parser, presenter, todoListCrudUsecase}
+	return &TodoListHandler{
+		parser,
+		presenter,
+		todoListCrudUsecase,
+		todoListSearchUsecase,
+		todoListExportUsecase,
+		todoListImportUsecase,
+		todoListStatsUsecase,
+		todoListCalendarUsecase,
+	}
 }
 
 func (w *TodoListHandler) Register(app fiber.Router) {
+	// Static paths must be registered before "/todo-lists/:id"
+	app.Get("/todo-lists/search", middleware.VerifyJWTToken, middleware.ETag, w.Search)
+	app.Get("/todo-lists/export", middleware.VerifyJWTToken, w.Export)
+	app.Get("/todo-lists/stats", middleware.VerifyJWTToken, middleware.ETag, w.Stats)
+	app.Get("/todo-lists/calendar", middleware.VerifyJWTToken, middleware.ETag, w.Calendar)
+	app.Post("/todo-lists/import", middleware.VerifyJWTToken, middleware.Idempotency, w.Import)
+	app.Post("/todo-lists/bulk", middleware.VerifyJWTToken, middleware.Idempotency, w.Bulk)
+	app.Get("/todo-lists/:id/history", middleware.VerifyJWTToken, middleware.ETag, w.GetHistory)
 	app.Get("/todo-lists/:id", middleware.VerifyJWTToken, w.GetByID)
-	app.Get("/todo-lists", middleware.VerifyJWTToken, w.GetByUserID)
-	app.Post("/todo-lists", middleware.VerifyJWTToken, w.Create)
+	app.Get("/todo-lists", middleware.VerifyJWTToken, middleware.ETag, w.GetByUserID)
+	app.Post("/todo-lists", middleware.VerifyJWTToken, middleware.Idempotency, w.Create)
+	app.Post("/todo-lists/:id/move", middleware.VerifyJWTToken, middleware.Idempotency, w.Move)
 	app.Put("/todo-lists/:id", middleware.VerifyJWTToken, w.Update)
+	app.Patch("/todo-lists/:id", middleware.VerifyJWTToken, w.Patch)
 	app.Delete("/todo-lists/:id", middleware.VerifyJWTToken, w.Delete)
 }
 
@@ -41,7 +75,10 @@ func (w *TodoListHandler) Register(app fiber.Router) {
 // @Produce         json
 // @Security        Bearer
 // @Param           id path int true "ID of the Todo List"
+// @Param           If-None-Match header string false "ETag from previous response"
 // @Success			201 {object} entity.GeneralResponse{data=entity.TodoListResponse} "Success"
+// @Header			201 {string} ETag "Version of the Todo List, send it as If-Match when updating"
+// @Success			304 "Not Modified"
 // @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
 // @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
 // @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
@@ -52,11 +89,23 @@ func (w *TodoListHandler) GetByID(c *fiber.Ctx) error {
 		return w.presenter.BuildError(c, err)
 	}
 
-	data, err := w.todoListCrudUsecase.GetByID(c.Context(), id)
+	userID, err := w.parser.ParserUserID(c)
 	if err != nil {
 		return w.presenter.BuildError(c, err)
 	}
 
+	data, err := w.todoListCrudUsecase.GetByID(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	if data != nil {
+		c.Set(fiber.HeaderETag, helper.VersionETag(data.Version))
+		if c.Fresh() {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+	}
+
 	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
 }
 
@@ -92,8 +141,10 @@ func (w *TodoListHandler) GetByUserID(c *fiber.Ctx) error {
 // @Produce			json
 // @Security 		Bearer
 // @Param			req body entity.TodoListReq true "Payload Request Body"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
 // @Success			201 {object} entity.GeneralResponse{data=entity.TodoListReq} "Success"
 // @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			409 {object} entity.CustomErrorResponse "Idempotency-Key reused with a different request"
 // @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
 // @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
 // @Router			/api/v1/todo-list [post]
@@ -120,9 +171,13 @@ func (w *TodoListHandler) Create(c *fiber.Ctx) error {
 // @Produce         json
 // @Security        Bearer
 // @Param           id path int true "ID of the todo list"
+// @Param           If-Match header string false "ETag from Get By ID, update is rejected when Todo List has been modified"
 // @Param			req body entity.TodoListReq true "Payload Request Body"
 // @Success			201 {object} entity.GeneralResponse "Success"
+// @Header			201 {string} ETag "New version of the Todo List"
 // @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Todo List not found"
+// @Failure			412 {object} entity.CustomErrorResponse "Todo List has been modified (If-Match mismatch)"
 // @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
 // @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
 // @Router			/api/v1/todo-list [put]
@@ -132,15 +187,68 @@ func (w *TodoListHandler) Update(c *fiber.Ctx) error {
 	if err != nil {
 		return w.presenter.BuildError(c, err)
 	}
+	req.IfMatch = c.Get(fiber.HeaderIfMatch)
 
-	err = w.todoListCrudUsecase.UpdateByID(c.Context(), req)
+	data, err := w.todoListCrudUsecase.UpdateByID(c.Context(), req)
 	if err != nil {
 		return w.presenter.BuildError(c, err)
 	}
 
+	c.Set(fiber.HeaderETag, helper.VersionETag(data.Version))
+
 	return w.presenter.BuildSuccess(c, nil, "Success", http.StatusOK)
 }
 
+// @Summary         Partially update Todo List by ID
+// @Description     Update only the supplied fields with JSON Merge Patch (RFC 7396), a field set to null is cleared (ex. description). JSON Patch (RFC 6902) add/replace/remove operations are accepted with Content-Type application/json-patch+json, use remove to clear a field since replace with null is rejected
+// @Tags			Todo List
+// @Accept			application/merge-patch+json
+// @Accept			application/json-patch+json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the todo list"
+// @Param           If-Match header string false "ETag from Get By ID, patch is rejected when Todo List has been modified"
+// @Param			req body entity.TodoListPatch true "Fields to update"
+// @Success			200 {object} entity.GeneralResponse{data=entity.TodoListResponse} "Success"
+// @Header			200 {string} ETag "New version of the Todo List"
+// @Failure			400 {object} entity.CustomErrorResponse "Patch has no field to update"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Todo List not found"
+// @Failure			412 {object} entity.CustomErrorResponse "Todo List has been modified (If-Match mismatch)"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/{id} [patch]
+func (w *TodoListHandler) Patch(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	patch, err := w.parser.ParserPatchRequest(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.todoListCrudUsecase.PatchByID(c.Context(), entity.PatchTodoListReq{
+		ID:      id,
+		UserID:  userID,
+		IfMatch: c.Get(fiber.HeaderIfMatch),
+		Patch:   patch,
+	})
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	c.Set(fiber.HeaderETag, helper.VersionETag(data.Version))
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
 // @Summary         Delete Todo List by ID
 // @Description     Delete an existing Todo List by its ID
 // @Tags			Todo List
@@ -159,10 +267,312 @@ func (w *TodoListHandler) Delete(c *fiber.Ctx) error {
 		return w.presenter.BuildError(c, err)
 	}
 
-	err = w.todoListCrudUsecase.DeleteByID(c.Context(), id)
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	err = w.todoListCrudUsecase.DeleteByID(c.Context(), userID, id)
 	if err != nil {
 		return w.presenter.BuildError(c, err)
 	}
 
 	return w.presenter.BuildSuccess(c, nil, "Success", http.StatusOK)
 }
+
+// @Summary         Retrieve Todo List history
+// @Description     Retrieve change history (create, update, delete) of a Todo List ordered from the newest, every entry contains the actor and old/new value of changed fields
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the todo list"
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.TodoListHistoryResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/{id}/history [get]
+func (w *TodoListHandler) GetHistory(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.todoListCrudUsecase.GetHistory(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Bulk Todo List operations
+// @Description     Apply create/update/delete/complete operations in one request. Mode "atomic" (default) rolls back the whole batch when one operation fails, mode "best_effort" applies every operation independently.
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param			req body entity.BulkTodoListReq true "Payload Request Body"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
+// @Success			200 {object} entity.GeneralResponse{data=entity.BulkTodoListResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			409 {object} entity.CustomErrorResponse "Idempotency-Key reused with a different request"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/bulk [post]
+func (w *TodoListHandler) Bulk(c *fiber.Ctx) error {
+	var req entity.BulkTodoListReq
+
+	err := w.parser.ParserBodyRequestWithUserID(c, &req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.todoListCrudUsecase.Bulk(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Move Todo List
+// @Description     Reorder a Todo List by placing it right before "before_id" or right after "after_id" (fill only one of them). Todo Lists are returned ordered by position
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the todo list"
+// @Param			req body entity.MoveTodoListReq true "Payload Request Body"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
+// @Success			200 {object} entity.GeneralResponse{data=entity.TodoListResponse} "Success"
+// @Header			200 {string} ETag "New version of the Todo List"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Todo List not found"
+// @Failure			409 {object} entity.CustomErrorResponse "Position conflict or Idempotency-Key reused with a different request"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/{id}/move [post]
+func (w *TodoListHandler) Move(c *fiber.Ctx) error {
+	var req entity.MoveTodoListReq
+
+	err := w.parser.ParserBodyWithIntIDPathParamsAndUserID(c, &req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.todoListCrudUsecase.Move(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	c.Set(fiber.HeaderETag, helper.VersionETag(data.Version))
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Search Todo Lists
+// @Description     Full-text search on title and description of user Todo Lists, ordered by relevance. Matched terms in highlight are wrapped with <mark></mark>
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           q query string true "Search keyword (min. 3 characters)"
+// @Param           page query int false "Page number (default 1)"
+// @Param           limit query int false "Data per page (default 20, max 100)"
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.TodoListSearchResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/search [get]
+func (w *TodoListHandler) Search(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.SearchTodoListReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+
+	data, err := w.todoListSearchUsecase.Search(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Todo List statistics
+// @Description     Statistics of user Todo Lists by doing date within the range (default the last 30 days): total, count by status, scheduled and completed count per day or week (Monday), completion rate, all-time overdue count and completion streaks in days
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           from query string false "Start date (YYYY-MM-DD), default 29 days before to"
+// @Param           to query string false "End date (YYYY-MM-DD), default today. Range is max. 366 days"
+// @Param           group_by query string false "Series period (default day)" Enums(day, week)
+// @Success			200 {object} entity.GeneralResponse{data=entity.TodoListStatsResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/stats [get]
+func (w *TodoListHandler) Stats(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.TodoListStatsReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+
+	data, err := w.todoListStatsUsecase.GetStats(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Todo List calendar
+// @Description     User Todo Lists grouped by doing date within the range (default the current month) with per-day counts, days without Todo Lists are omitted unless include_empty is true. The timezone decides today (overdue) and the timestamps, doing date has no time so it is the same in every timezone
+// @Tags			Todo List
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           from query string false "Start date (YYYY-MM-DD), default the first day of the month of to"
+// @Param           to query string false "End date (YYYY-MM-DD), default the last day of the month of from. Range is max. 92 days"
+// @Param           tz query string false "IANA timezone, ex. America/New_York (default Asia/Jakarta)"
+// @Param           include_empty query bool false "Include days without Todo Lists (default false)"
+// @Success			200 {object} entity.GeneralResponse{data=entity.TodoListCalendarResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/calendar [get]
+func (w *TodoListHandler) Calendar(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.CalendarTodoListReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+
+	data, err := w.todoListCalendarUsecase.GetCalendar(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Export Todo Lists
+// @Description     Download all user Todo Lists as CSV, JSON or iCalendar file. On iCalendar, doing_at is exported as all-day DTSTART of a VTODO (default) or VEVENT component. CSV cells starting with =, +, - or @ are prefixed with ' so spreadsheets do not evaluate them as formula
+// @Tags			Todo List
+// @Produce			json,text/csv,text/calendar
+// @Security 		Bearer
+// @Param           format query string true "File format" Enums(csv, json, ics)
+// @Param           component query string false "iCalendar component (default vtodo)" Enums(vtodo, vevent)
+// @Success			200 {file} file "Exported file"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/export [get]
+func (w *TodoListHandler) Export(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.ExportTodoListReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+
+	file, err := w.todoListExportUsecase.Export(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	c.Attachment(file.Filename)
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Context().SetBodyStreamWriter(func(sw *bufio.Writer) {
+		// Error is logged by usecase, response is already partially sent at this point
+		if err := file.Write(sw); err == nil {
+			sw.Flush()
+		}
+	})
+
+	return nil
+}
+
+// @Summary         Import Todo Lists
+// @Description     Import Todo Lists from CSV (header: title,description,doing_at), JSON (array of Todo List) or iCalendar (VTODO/VEVENT) file, sent as multipart "file" field (required in multipart requests) or raw request body. The ' prefix added to CSV cells on export is removed. Valid rows are imported (with history and todo_list.created events, like a single create) and invalid rows are reported per row. Use dry_run to only validate the file. Large files are processed in background by the worker (queued = true)
+// @Tags			Todo List
+// @Accept			mpfd
+// @Produce			json
+// @Security 		Bearer
+// @Param           file formData file false "File to import"
+// @Param           format query string false "File format, detected from file extension when empty" Enums(csv, json, ics)
+// @Param           dry_run query bool false "Validate only, nothing is imported"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
+// @Success			200 {object} entity.GeneralResponse{data=entity.ImportTodoListResponse} "Success"
+// @Failure			400 {object} entity.CustomErrorResponse "Multipart request without file field"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			409 {object} entity.CustomErrorResponse "Idempotency-Key reused with a different request"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/todo-lists/import [post]
+func (w *TodoListHandler) Import(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	var req entity.ImportTodoListReq
+	if err := w.parser.ParseQueryParams(c, &req); err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+	req.UserID = userID
+	req.Content = c.Body()
+
+	// The content of multipart requests is only read from the file field, their raw body is not a file
+	if strings.HasPrefix(string(c.Request().Header.ContentType()), fiber.MIMEMultipartForm) {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return w.presenter.BuildError(c, apperr.ErrMissingImportFile())
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			return w.presenter.BuildError(c, err)
+		}
+		defer file.Close()
+
+		if req.Content, err = io.ReadAll(file); err != nil {
+			return w.presenter.BuildError(c, err)
+		}
+		req.Filename = fileHeader.Filename
+	}
+
+	data, err := w.todoListImportUsecase.Import(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
@@ -1,11 +1,20 @@
 package handler_test
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	fiber "github.com/gofiber/fiber/v2"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
 	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	presenter "github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
 	"github.com/rahmatrdn/go-skeleton/tests/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -15,6 +24,11 @@ import (
 type TodoListHandlerTestSuite struct {
 	suite.Suite
 	todoListUsecase *mocks.ICrudTodoListUsecase
+	searchUsecase   *mocks.ISearchTodoListUsecase
+	exportUsecase   *mocks.IExportTodoListUsecase
+	importUsecase   *mocks.IImportTodoListUsecase
+	statsUsecase    *mocks.IStatsTodoListUsecase
+	calendarUsecase *mocks.ICalendarTodoListUsecase
 	presenter       *mocks.Presenter
 	parser          *mocks.Parser
 	handler         *handler.TodoListHandler
@@ -22,10 +36,24 @@ type TodoListHandlerTestSuite struct {
 
 func (s *TodoListHandlerTestSuite) SetupTest() {
 	s.todoListUsecase = &mocks.ICrudTodoListUsecase{}
+	s.searchUsecase = &mocks.ISearchTodoListUsecase{}
+	s.exportUsecase = &mocks.IExportTodoListUsecase{}
+	s.importUsecase = &mocks.IImportTodoListUsecase{}
+	s.statsUsecase = &mocks.IStatsTodoListUsecase{}
+	s.calendarUsecase = &mocks.ICalendarTodoListUsecase{}
 	s.presenter = &mocks.Presenter{}
 	s.parser = &mocks.Parser{}
 
-	s.handler = handler.NewTodoListHandler(s.parser, s.presenter, s.todoListUsecase)
+	s.handler = handler.NewTodoListHandler(
+		s.parser,
+		s.presenter,
+		s.todoListUsecase,
+		s.searchUsecase,
+		s.exportUsecase,
+		s.importUsecase,
+		s.statsUsecase,
+		s.calendarUsecase,
+	)
 }
 
 func TestTodoListHandler(t *testing.T) {
@@ -54,7 +82,8 @@ func (s *TodoListHandlerTestSuite) TestGetByID() {
 			name: "success",
 			mockFunc: func() {
 				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
-				s.todoListUsecase.On("GetByID", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+				s.todoListUsecase.On("GetByID", mock.Anything, int64(1), mock.Anything).Return(nil, nil).Once()
 				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -65,11 +94,20 @@ func (s *TodoListHandlerTestSuite) TestGetByID() {
 				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
+		{
+			name: "fail get user id from parser",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(0), fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
 		{
 			name: "fail usecase GetByID",
 			mockFunc: func() {
 				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
-				s.todoListUsecase.On("GetByID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+				s.todoListUsecase.On("GetByID", mock.Anything, int64(1), mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
 				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -89,6 +127,58 @@ func (s *TodoListHandlerTestSuite) TestGetByID() {
 	}
 }
 
+func (s *TodoListHandlerTestSuite) TestGetByIDConditional() {
+	app := fiber.New()
+
+	ID := int64(1)
+	data := &entity.TodoListResponse{ID: ID, Version: 2}
+
+	testCases := []struct {
+		name        string
+		ifNoneMatch string
+		mockFunc    func()
+		wantStatus  int
+	}{
+		{
+			name:        "not modified",
+			ifNoneMatch: `"2"`,
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+				s.todoListUsecase.On("GetByID", mock.Anything, int64(1), ID).Return(data, nil).Once()
+			},
+			wantStatus: fiber.StatusNotModified,
+		},
+		{
+			name:        "modified",
+			ifNoneMatch: `"1"`,
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+				s.todoListUsecase.On("GetByID", mock.Anything, int64(1), ID).Return(data, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, data, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			wantStatus: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			c := app.AcquireCtx(&fasthttp.RequestCtx{})
+			defer app.ReleaseCtx(c)
+
+			c.Request().Header.Set(fiber.HeaderIfNoneMatch, tt.ifNoneMatch)
+			tt.mockFunc()
+
+			err := s.handler.GetByID(c)
+
+			s.NoError(err)
+			s.Equal(tt.wantStatus, c.Response().StatusCode())
+			s.Equal(`"2"`, string(c.Response().Header.Peek(fiber.HeaderETag)))
+		})
+	}
+}
+
 func (s *TodoListHandlerTestSuite) TestGetByUserID() {
 	app := fiber.New()
 	c := app.AcquireCtx(&fasthttp.RequestCtx{})
@@ -203,7 +293,7 @@ func (s *TodoListHandlerTestSuite) TestUpdate() {
 			name: "success",
 			mockFunc: func() {
 				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
-				s.todoListUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(&entity.TodoListResponse{ID: 1, Version: 2}, nil).Once()
 				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -211,7 +301,7 @@ func (s *TodoListHandlerTestSuite) TestUpdate() {
 			name: "fail usecase UpdateByID",
 			mockFunc: func() {
 				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
-				s.todoListUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.todoListUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
 				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -238,6 +328,154 @@ func (s *TodoListHandlerTestSuite) TestUpdate() {
 	}
 }
 
+func (s *TodoListHandlerTestSuite) TestUpdateOtherUserData() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	h := handler.NewTodoListHandler(
+		s.parser,
+		presenter.NewJsonPresenter(),
+		s.todoListUsecase,
+		s.searchUsecase,
+		s.exportUsecase,
+		s.importUsecase,
+		s.statsUsecase,
+		s.calendarUsecase,
+	)
+
+	// Todo List 1 belongs to another user, the usecase treats it as not exist
+	s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*entity.TodoListReq)
+		req.ID, req.UserID = 1, 2
+	}).Return(nil).Once()
+	s.todoListUsecase.On("UpdateByID", mock.Anything, mock.MatchedBy(func(req entity.TodoListReq) bool {
+		return req.ID == 1 && req.UserID == 2
+	})).Return(nil, apperr.ErrRecordNotFound()).Once()
+
+	s.NoError(h.Update(c))
+	s.Equal(fiber.StatusNotFound, c.Response().StatusCode())
+	s.Empty(c.Response().Header.Peek(fiber.HeaderETag))
+}
+
+func (s *TodoListHandlerTestSuite) TestWriteETag() {
+	app := fiber.New()
+	data := &entity.TodoListResponse{ID: 1, Version: 2}
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+		handle   func(c *fiber.Ctx) error
+	}{
+		{
+			name: "update",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("UpdateByID", mock.Anything, mock.Anything).Return(data, nil).Once()
+			},
+			handle: s.handler.Update,
+		},
+		{
+			name: "patch",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(int64(1), nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+				s.parser.On("ParserPatchRequest", mock.Anything).Return(parser.MergePatch{"title": "Title"}, nil).Once()
+				s.todoListUsecase.On("PatchByID", mock.Anything, mock.Anything).Return(data, nil).Once()
+			},
+			handle: s.handler.Patch,
+		},
+		{
+			name: "move",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("Move", mock.Anything, mock.Anything).Return(data, nil).Once()
+			},
+			handle: s.handler.Move,
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			c := app.AcquireCtx(&fasthttp.RequestCtx{})
+			defer app.ReleaseCtx(c)
+
+			tt.mockFunc()
+			s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+			s.NoError(tt.handle(c))
+			s.Equal(`"2"`, string(c.Response().Header.Peek(fiber.HeaderETag)))
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestPatch() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+	mergePatch := parser.MergePatch{"description": nil}
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserPatchRequest", mock.Anything).Return(mergePatch, nil).Once()
+				s.todoListUsecase.On("PatchByID", mock.Anything, entity.PatchTodoListReq{ID: ID, UserID: ID, Patch: mergePatch}).
+					Return(&entity.TodoListResponse{ID: ID, Version: 2}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserPatchRequest", mock.Anything).Return(mergePatch, nil).Once()
+				s.todoListUsecase.On("PatchByID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParserPatchRequest",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserPatchRequest", mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parser",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Patch(c)
+
+			if err != nil {
+				t.Errorf("Patch() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
 func (s *TodoListHandlerTestSuite) TestDelete() {
 	app := fiber.New()
 	c := app.AcquireCtx(&fasthttp.RequestCtx{})
@@ -254,7 +492,8 @@ func (s *TodoListHandlerTestSuite) TestDelete() {
 			name: "success",
 			mockFunc: func() {
 				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
-				s.todoListUsecase.On("DeleteByID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.todoListUsecase.On("DeleteByID", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -262,7 +501,8 @@ func (s *TodoListHandlerTestSuite) TestDelete() {
 			name: "fail usecase",
 			mockFunc: func() {
 				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
-				s.todoListUsecase.On("DeleteByID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.todoListUsecase.On("DeleteByID", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
 				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
@@ -273,6 +513,14 @@ func (s *TodoListHandlerTestSuite) TestDelete() {
 				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
+		{
+			name: "fail ParserUserID",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(int64(0), fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -288,3 +536,506 @@ func (s *TodoListHandlerTestSuite) TestDelete() {
 		})
 	}
 }
+
+func (s *TodoListHandlerTestSuite) TestGetHistory() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.todoListUsecase.On("GetHistory", mock.Anything, ID, ID).Return([]*entity.TodoListHistoryResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.todoListUsecase.On("GetHistory", mock.Anything, ID, ID).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parser",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.GetHistory(c)
+
+			if err != nil {
+				t.Errorf("GetHistory() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestBulk() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("Bulk", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParserBodyRequestWithUserID",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Bulk",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("Bulk", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Bulk(c)
+
+			if err != nil {
+				t.Errorf("Bulk() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestSearch() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.searchUsecase.On("Search", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParseQueryParams",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Search",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.searchUsecase.On("Search", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Search(c)
+
+			if err != nil {
+				t.Errorf("Search() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestStats() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.statsUsecase.On("GetStats", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParseQueryParams",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase GetStats",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.statsUsecase.On("GetStats", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Stats(c)
+
+			if err != nil {
+				t.Errorf("Stats() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestCalendar() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.calendarUsecase.On("GetCalendar", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParseQueryParams",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase GetCalendar",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.calendarUsecase.On("GetCalendar", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Calendar(c)
+
+			if err != nil {
+				t.Errorf("Calendar() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestExport() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+	file := &entity.ExportTodoListFile{
+		Filename:    "todo-lists.csv",
+		ContentType: "text/csv; charset=utf-8",
+		Write: func(w io.Writer) error {
+			_, err := io.WriteString(w, "id,title\n")
+			return err
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.exportUsecase.On("Export", mock.Anything, mock.Anything).Return(file, nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParseQueryParams",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Export",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.exportUsecase.On("Export", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Export(c)
+
+			if err != nil {
+				t.Errorf("Export() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestImport() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.importUsecase.On("Import", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get user id",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail ParseQueryParams",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Import",
+			mockFunc: func() {
+				s.parser.On("ParserUserID", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+				s.importUsecase.On("Import", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Import(c)
+
+			if err != nil {
+				t.Errorf("Import() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *TodoListHandlerTestSuite) TestImportMultipart() {
+	test := func(field string) error {
+		app := fiber.New()
+		app.Post("/import", s.handler.Import)
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile(field, "todos.csv")
+		s.Require().NoError(err)
+		_, _ = part.Write([]byte("title,description,doing_at\nTitle,Description,2025-01-02\n"))
+		s.Require().NoError(writer.Close())
+
+		req := httptest.NewRequest(fiber.MethodPost, "/import", body)
+		req.Header.Set(fiber.HeaderContentType, writer.FormDataContentType())
+		_, err = app.Test(req)
+		return err
+	}
+
+	s.Run("file field", func() {
+		s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+		s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+		s.importUsecase.On("Import", mock.Anything, mock.MatchedBy(func(req entity.ImportTodoListReq) bool {
+			return req.Filename == "todos.csv" && strings.HasPrefix(string(req.Content), "title,description,doing_at")
+		})).Return(nil, nil).Once()
+		s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		s.NoError(test("file"))
+		s.importUsecase.AssertExpectations(s.T())
+	})
+
+	s.Run("missing file field", func() {
+		s.parser.On("ParserUserID", mock.Anything).Return(int64(1), nil).Once()
+		s.parser.On("ParseQueryParams", mock.Anything, mock.Anything).Return(nil).Once()
+		s.presenter.On("BuildError", mock.Anything, apperr.ErrMissingImportFile()).Return(nil).Once()
+
+		s.NoError(test("upload"))
+		s.presenter.AssertExpectations(s.T())
+	})
+}
+
+func (s *TodoListHandlerTestSuite) TestMove() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("Move", mock.Anything, mock.Anything).Return(&entity.TodoListResponse{ID: 1, Version: 2}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parse body",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Move",
+			mockFunc: func() {
+				s.parser.On("ParserBodyWithIntIDPathParamsAndUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.todoListUsecase.On("Move", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Move(c)
+
+			if err != nil {
+				t.Errorf("Move() error = %v", err)
+				return
+			}
+		})
+	}
+}
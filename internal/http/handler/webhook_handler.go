@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"net/http"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/webhook/entity"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+type WebhookHandler struct {
+	parser             parser.Parser
+	presenter          json.JsonPresenter
+	webhookCrudUsecase webhook_usecase.ICrudWebhookUsecase
+}
+
+func NewWebhookHandler(
+	parser parser.Parser,
+	presenter json.JsonPresenter,
+	webhookCrudUsecase webhook_usecase.ICrudWebhookUsecase,
+) *WebhookHandler {
+	return &WebhookHandler{parser, presenter, webhookCrudUsecase}
+}
+
+func (w *WebhookHandler) Register(app fiber.Router) {
+	app.Get("/webhooks", middleware.VerifyJWTToken, w.GetByUserID)
+	app.Post("/webhooks", middleware.VerifyJWTToken, middleware.Idempotency, w.Create)
+	app.Get("/webhooks/:id/deliveries", middleware.VerifyJWTToken, w.GetDeliveries)
+	app.Post("/webhooks/:id/deliveries/:delivery_id/redeliver", middleware.VerifyJWTToken, middleware.Idempotency, w.Redeliver)
+	app.Get("/webhooks/:id", middleware.VerifyJWTToken, w.GetByID)
+	app.Put("/webhooks/:id", middleware.VerifyJWTToken, w.Update)
+	app.Delete("/webhooks/:id", middleware.VerifyJWTToken, w.Delete)
+}
+
+// @Summary         Retrieve Webhooks
+// @Description     Retrieve webhook subscriptions of the user
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.WebhookResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks [get]
+func (w *WebhookHandler) GetByUserID(c *fiber.Ctx) error {
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.GetByUserID(c.Context(), userID)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Get Webhook by ID
+// @Description     Get a webhook subscription of the user by its ID
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the webhook"
+// @Success			200 {object} entity.GeneralResponse{data=entity.WebhookResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Webhook not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks/{id} [get]
+func (w *WebhookHandler) GetByID(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.GetByID(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary			Create a new Webhook
+// @Description		Subscribe an URL to Todo List events (todo_list.created, todo_list.updated, todo_list.completed, todo_list.deleted). Deliveries are signed with header X-Webhook-Signature: sha256=HMAC-SHA256(secret, X-Webhook-Timestamp + "." + body), the secret is only returned in this response
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param			req body entity.WebhookReq true "Payload Request Body"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
+// @Success			200 {object} entity.GeneralResponse{data=entity.WebhookResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			409 {object} entity.CustomErrorResponse "Idempotency-Key reused with a different request"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks [post]
+func (w *WebhookHandler) Create(c *fiber.Ctx) error {
+	var req entity.WebhookReq
+
+	err := w.parser.ParserBodyRequestWithUserID(c, &req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.Create(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Update Webhook by ID
+// @Description     Update URL and event types of a webhook, set is_active to true to enable a webhook disabled after repeated failures
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the webhook"
+// @Param			req body entity.WebhookReq true "Payload Request Body"
+// @Success			200 {object} entity.GeneralResponse{data=entity.WebhookResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Webhook not found"
+// @Failure			422 {object} entity.CustomErrorResponse "Invalid Request Body"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks/{id} [put]
+func (w *WebhookHandler) Update(c *fiber.Ctx) error {
+	var req entity.WebhookReq
+
+	err := w.parser.ParserBodyWithIntIDPathParamsAndUserID(c, &req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.UpdateByID(c.Context(), req)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Delete Webhook by ID
+// @Description     Delete a webhook subscription together with its delivery log
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the webhook"
+// @Success			200 {object} entity.GeneralResponse "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Webhook not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks/{id} [delete]
+func (w *WebhookHandler) Delete(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	err = w.webhookCrudUsecase.DeleteByID(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, nil, "Success", http.StatusOK)
+}
+
+// @Summary         Retrieve Webhook deliveries
+// @Description     Retrieve the latest 100 deliveries of a webhook ordered from the newest, with attempts, response and next retry time
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the webhook"
+// @Success			200 {object} entity.GeneralResponse{data=[]entity.WebhookDeliveryResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Webhook not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks/{id}/deliveries [get]
+func (w *WebhookHandler) GetDeliveries(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.GetDeliveries(c.Context(), userID, id)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
+
+// @Summary         Redeliver Webhook delivery
+// @Description     Send the payload of a previous delivery again as a new delivery
+// @Tags			Webhook
+// @Accept			json
+// @Produce			json
+// @Security 		Bearer
+// @Param           id path int true "ID of the webhook"
+// @Param           delivery_id path int true "ID of the delivery"
+// @Param			Idempotency-Key header string false "Unique key of the request, retry with the same key replays the first response"
+// @Success			200 {object} entity.GeneralResponse{data=entity.WebhookDeliveryResponse} "Success"
+// @Failure			401 {object} entity.CustomErrorResponse "Unauthorized"
+// @Failure			404 {object} entity.CustomErrorResponse "Webhook or delivery not found"
+// @Failure			500 {object} entity.CustomErrorResponse "Internal server Error"
+// @Router			/api/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (w *WebhookHandler) Redeliver(c *fiber.Ctx) error {
+	id, err := w.parser.ParserIntIDFromPathParams(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	deliveryID := helper.ToInt64(c.Params("delivery_id"))
+	if deliveryID == 0 {
+		return w.presenter.BuildError(c, apperr.ErrInvalidRequest())
+	}
+
+	userID, err := w.parser.ParserUserID(c)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	data, err := w.webhookCrudUsecase.Redeliver(c.Context(), userID, id, deliveryID)
+	if err != nil {
+		return w.presenter.BuildError(c, err)
+	}
+
+	return w.presenter.BuildSuccess(c, data, "Success", http.StatusOK)
+}
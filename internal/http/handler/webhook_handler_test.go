@@ -0,0 +1,202 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/handler"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/webhook/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/valyala/fasthttp"
+)
+
+type WebhookHandlerTestSuite struct {
+	suite.Suite
+	webhookUsecase *mocks.ICrudWebhookUsecase
+	presenter      *mocks.Presenter
+	parser         *mocks.Parser
+	handler        *handler.WebhookHandler
+}
+
+func (s *WebhookHandlerTestSuite) SetupTest() {
+	s.webhookUsecase = &mocks.ICrudWebhookUsecase{}
+	s.presenter = &mocks.Presenter{}
+	s.parser = &mocks.Parser{}
+
+	s.handler = handler.NewWebhookHandler(s.parser, s.presenter, s.webhookUsecase)
+}
+
+func TestWebhookHandler(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerTestSuite))
+}
+
+func (s *WebhookHandlerTestSuite) TestRegister() {
+	app := fiber.New()
+
+	s.handler.Register(app)
+}
+
+func (s *WebhookHandlerTestSuite) TestCreate() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.webhookUsecase.On("Create", mock.Anything, mock.Anything).Return(&entity.WebhookResponse{ID: 1}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail parser",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase Create",
+			mockFunc: func() {
+				s.parser.On("ParserBodyRequestWithUserID", mock.Anything, mock.Anything).Return(nil).Once()
+				s.webhookUsecase.On("Create", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.Create(c)
+
+			if err != nil {
+				t.Errorf("Create() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *WebhookHandlerTestSuite) TestGetDeliveries() {
+	app := fiber.New()
+	c := app.AcquireCtx(&fasthttp.RequestCtx{})
+
+	defer app.ReleaseCtx(c)
+
+	ID := int64(1)
+	userID := int64(2)
+
+	testCases := []struct {
+		name     string
+		mockFunc func()
+	}{
+		{
+			name: "success",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.webhookUsecase.On("GetDeliveries", mock.Anything, userID, ID).Return([]*entity.WebhookDeliveryResponse{}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail get id from parser param",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "fail usecase GetDeliveries",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.webhookUsecase.On("GetDeliveries", mock.Anything, userID, ID).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := s.handler.GetDeliveries(c)
+
+			if err != nil {
+				t.Errorf("GetDeliveries() error = %v", err)
+				return
+			}
+		})
+	}
+}
+
+func (s *WebhookHandlerTestSuite) TestRedeliver() {
+	ID := int64(1)
+	userID := int64(2)
+
+	testCases := []struct {
+		name       string
+		deliveryID string
+		mockFunc   func()
+	}{
+		{
+			name:       "success",
+			deliveryID: "5",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.webhookUsecase.On("Redeliver", mock.Anything, userID, ID, int64(5)).Return(&entity.WebhookDeliveryResponse{ID: 6}, nil).Once()
+				s.presenter.On("BuildSuccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:       "invalid delivery id",
+			deliveryID: "abc",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:       "fail usecase Redeliver",
+			deliveryID: "5",
+			mockFunc: func() {
+				s.parser.On("ParserIntIDFromPathParams", mock.Anything).Return(ID, nil).Once()
+				s.parser.On("ParserUserID", mock.Anything).Return(userID, nil).Once()
+				s.webhookUsecase.On("Redeliver", mock.Anything, userID, ID, int64(5)).Return(nil, fmt.Errorf("ERROR")).Once()
+				s.presenter.On("BuildError", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			app := fiber.New()
+			app.Post("/webhooks/:id/deliveries/:delivery_id/redeliver", s.handler.Redeliver)
+
+			req := httptest.NewRequest(fiber.MethodPost, "/webhooks/1/deliveries/"+tt.deliveryID+"/redeliver", nil)
+			_, err := app.Test(req)
+
+			if err != nil {
+				t.Errorf("Redeliver() error = %v", err)
+				return
+			}
+			s.webhookUsecase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ClientIP returns the address of the client. When the connection comes from a trusted proxy (fiber.Config
+// TrustedProxies) the proxy header is read from right to left skipping the trusted proxies, the leftmost
+// addresses are sent by the client so c.IP() can be spoofed while the first untrusted address can not
+func ClientIP(c *fiber.Ctx) string {
+	remoteIP := c.Context().RemoteIP().String()
+
+	cfg := c.App().Config()
+	if !cfg.EnableTrustedProxyCheck || cfg.ProxyHeader == "" || !c.IsProxyTrusted() {
+		return remoteIP
+	}
+
+	trusted := parseTrustedProxies(cfg.TrustedProxies)
+	addrs := strings.Split(c.Get(cfg.ProxyHeader), ",")
+	clientIP := remoteIP
+	for i := len(addrs) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(addrs[i]))
+		if err != nil {
+			// Addresses left of an invalid one are not set by a trusted proxy
+			break
+		}
+
+		addr = addr.Unmap()
+		clientIP = addr.String()
+		if !isTrustedProxy(trusted, addr) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+func parseTrustedProxies(proxies []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if strings.Contains(proxy, "/") {
+			if prefix, err := netip.ParsePrefix(proxy); err == nil {
+				prefixes = append(prefixes, prefix.Masked())
+			}
+		} else if addr, err := netip.ParseAddr(proxy); err == nil {
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+
+	return prefixes
+}
+
+func isTrustedProxy(trusted []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	// Requests of app.Test come from 0.0.0.0
+	tests := []struct {
+		name         string
+		proxies      []string
+		forwardedFor string
+		expectedIP   string
+	}{
+		{"no trusted proxy", nil, "1.1.1.1", "0.0.0.0"},
+		{"untrusted proxy", []string{"192.168.100.0/24"}, "1.1.1.1", "0.0.0.0"},
+		{"without header", []string{"0.0.0.0"}, "", "0.0.0.0"},
+		{"single proxy", []string{"0.0.0.0"}, "1.1.1.1", "1.1.1.1"},
+		{"spoofed address", []string{"0.0.0.0"}, "2.2.2.2, 1.1.1.1", "1.1.1.1"},
+		{"proxy chain", []string{"0.0.0.0", "10.0.0.0/8"}, "2.2.2.2, 1.1.1.1, 10.1.1.1, 10.2.2.2", "1.1.1.1"},
+		{"every address trusted", []string{"0.0.0.0/0"}, "10.1.1.1, 10.2.2.2", "10.1.1.1"},
+		{"invalid address", []string{"0.0.0.0", "10.0.0.0/8"}, "1.1.1.1, unknown, 10.1.1.1", "10.1.1.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{TrustedProxyOption: config.TrustedProxyOption{
+				TrustedProxies: tt.proxies,
+				ProxyHeader:    fiber.HeaderXForwardedFor,
+			}}
+
+			app := fiber.New(config.NewFiberConfiguration(cfg))
+			app.Get("/", func(c *fiber.Ctx) error { return c.SendString(middleware.ClientIP(c)) })
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.forwardedFor != "" {
+				req.Header.Set(fiber.HeaderXForwardedFor, tt.forwardedFor)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.expectedIP, string(body))
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+)
+
+var etagHandler = etag.New(etag.Config{Weak: true})
+
+// ETag sets weak ETag computed from response body and replies 304 Not Modified when it matches If-None-Match.
+// Handler that sets its own ETag (ex. from data version) is left as is, so it must check c.Fresh() by itself
+func ETag(c *fiber.Ctx) error {
+	return etagHandler(c)
+}
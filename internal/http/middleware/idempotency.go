@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotentReplayed  = "Idempotent-Replayed"
+	idempotencyKeyMaxLength   = 255
+	idempotencyReserveTimeout = time.Minute
+)
+
+// IdempotencyStore keeps request fingerprint and response of Idempotency-Key,
+// implemented by memory, redis and mysql IdempotencyKeyRepository
+type IdempotencyStore interface {
+	// Reserve stores in progress record, it returns false when an unexpired record with the same key exists
+	Reserve(ctx context.Context, params *entity.IdempotencyRecord) (bool, error)
+	// Get returns unexpired record of the key, nil when not found
+	Get(ctx context.Context, key string) (*entity.IdempotencyRecord, error)
+	// Save stores the response of reserved key
+	Save(ctx context.Context, params *entity.IdempotencyRecord) error
+	// Delete releases the key so the request can be retried
+	Delete(ctx context.Context, key string) error
+}
+
+// idempotencyHandler passes every request through until UseIdempotencyStore is called
+var idempotencyHandler fiber.Handler = func(c *fiber.Ctx) error {
+	return c.Next()
+}
+
+// UseIdempotencyStore configures store and TTL used by Idempotency middleware, call it before registering routes
+func UseIdempotencyStore(store IdempotencyStore, ttl time.Duration) {
+	idempotencyHandler = NewIdempotency(store, ttl)
+}
+
+// Idempotency makes a route safe to retry with Idempotency-Key header, put it after VerifyJWTToken so keys are scoped per user
+// (per client IP otherwise). Requests without the header are processed as usual
+func Idempotency(c *fiber.Ctx) error {
+	return idempotencyHandler(c)
+}
+
+// NewIdempotency returns middleware that stores response of a request with Idempotency-Key header for ttl.
+// Retry with the same key and body replays stored status and body, the same key with a different
+// method, URL (path and query string) or body is rejected with 409 Conflict. Server errors (5xx) are not stored
+// so they can be retried. Requests are rejected with 503 when the store fails, processing them unprotected could
+// apply a retried request twice
+func NewIdempotency(store IdempotencyStore, ttl time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		funcName := "Idempotency"
+
+		idempotencyKey := c.Get(HeaderIdempotencyKey)
+		if idempotencyKey == "" {
+			return c.Next()
+		}
+		if len(idempotencyKey) > idempotencyKeyMaxLength {
+			return json.WriteError(c, apperr.ErrInvalidRequest())
+		}
+
+		scope := idempotencyScope(c)
+		key := idempotencyHash(scope, idempotencyKey)
+		fingerprint := idempotencyHash(c.Method(), c.OriginalURL(), string(c.Body()))
+		captureFieldError := entity.CaptureFields{
+			"scope":           scope,
+			"idempotency_key": idempotencyKey,
+		}
+
+		reserved, err := store.Reserve(c.Context(), &entity.IdempotencyRecord{
+			Key:         key,
+			Fingerprint: fingerprint,
+			ExpiresAt:   time.Now().Add(min(idempotencyReserveTimeout, ttl)),
+		})
+		if err != nil {
+			helper.LogErrorContext(c.Context(), "store.Reserve", funcName, err, captureFieldError, "")
+
+			return json.WriteError(c, apperr.ErrIdempotencyStoreUnavailable())
+		}
+
+		if !reserved {
+			record, err := store.Get(c.Context(), key)
+			if err != nil {
+				helper.LogErrorContext(c.Context(), "store.Get", funcName, err, captureFieldError, "")
+
+				return json.WriteError(c, apperr.ErrIdempotencyStoreUnavailable())
+			}
+
+			switch {
+			case record == nil:
+				// Expired between Reserve and Get, ask the client to retry instead of processing it twice
+				return json.WriteError(c, apperr.ErrIdempotencyKeyInProgress())
+			case record.Fingerprint != fingerprint:
+				return json.WriteError(c, apperr.ErrIdempotencyKeyMismatch())
+			case !record.Completed():
+				return json.WriteError(c, apperr.ErrIdempotencyKeyInProgress())
+			}
+
+			c.Set(HeaderIdempotentReplayed, "true")
+			if record.ContentType != "" {
+				c.Set(fiber.HeaderContentType, record.ContentType)
+			}
+
+			return c.Status(record.StatusCode).Send(record.Body)
+		}
+
+		if err := c.Next(); err != nil {
+			releaseIdempotencyKey(c, store, key, captureFieldError)
+
+			return err
+		}
+
+		statusCode := c.Response().StatusCode()
+		if statusCode >= fiber.StatusInternalServerError {
+			releaseIdempotencyKey(c, store, key, captureFieldError)
+
+			return nil
+		}
+
+		err = store.Save(c.Context(), &entity.IdempotencyRecord{
+			Key:         key,
+			Fingerprint: fingerprint,
+			StatusCode:  statusCode,
+			ContentType: string(c.Response().Header.ContentType()),
+			Body:        append([]byte(nil), c.Response().Body()...),
+			ExpiresAt:   time.Now().Add(ttl),
+		})
+		if err != nil {
+			// Response is already built, the key is released so retry is processed again
+			helper.LogErrorContext(c.Context(), "store.Save", funcName, err, captureFieldError, "")
+			releaseIdempotencyKey(c, store, key, captureFieldError)
+		}
+
+		return nil
+	}
+}
+
+// idempotencyScope returns the owner of the keys, the user set by VerifyJWTToken or the client IP on routes
+// without authentication so keys of different clients never collide
+func idempotencyScope(c *fiber.Ctx) string {
+	if userID := c.Locals("user_id"); userID != nil {
+		return fmt.Sprintf("user:%v", userID)
+	}
+
+	return "ip:" + ClientIP(c)
+}
+
+func releaseIdempotencyKey(c *fiber.Ctx, store IdempotencyStore, key string, captureFieldError entity.CaptureFields) {
+	if err := store.Delete(c.Context(), key); err != nil {
+		helper.LogErrorContext(c.Context(), "store.Delete", "Idempotency", err, captureFieldError, "")
+	}
+}
+
+func idempotencyHash(values ...string) string {
+	hash := sha256.New()
+	for _, value := range values {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,213 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/memory"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	app   *fiber.App
+	store *memory.IdempotencyKeyRepository
+	calls int
+}
+
+func TestIdempotency(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (s *IdempotencyTestSuite) SetupTest() {
+	s.calls = 0
+	s.store = memory.NewIdempotencyKeyRepository()
+	s.app = fiber.New()
+
+	setUser := func(c *fiber.Ctx) error {
+		c.Locals("user_id", int64(1))
+		return c.Next()
+	}
+	idempotency := middleware.NewIdempotency(s.store, time.Hour)
+
+	s.app.Post("/todo-lists", setUser, idempotency, func(c *fiber.Ctx) error {
+		s.calls++
+		body := string(c.Body())
+		if strings.Contains(body, "fail") {
+			return c.Status(fiber.StatusInternalServerError).SendString("failed")
+		}
+		if strings.Contains(body, "nested") {
+			status, _, _ := s.request(c.Get(middleware.HeaderIdempotencyKey), body)
+			return c.Status(fiber.StatusCreated).JSON(fiber.Map{"nested": status})
+		}
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"call": s.calls})
+	})
+}
+
+func (s *IdempotencyTestSuite) request(key string, body string) (int, string, string) {
+	req := httptest.NewRequest(fiber.MethodPost, "/todo-lists", strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(middleware.HeaderIdempotencyKey, key)
+	}
+
+	resp, err := s.app.Test(req)
+	s.Require().NoError(err)
+	respBody, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, string(respBody), resp.Header.Get(middleware.HeaderIdempotentReplayed)
+}
+
+func (s *IdempotencyTestSuite) TestReplay() {
+	status, body, replayed := s.request("key-1", `{"title":"Test"}`)
+	s.Equal(fiber.StatusCreated, status)
+	s.Equal(`{"call":1}`, body)
+	s.Empty(replayed)
+
+	status, body, replayed = s.request("key-1", `{"title":"Test"}`)
+	s.Equal(fiber.StatusCreated, status)
+	s.Equal(`{"call":1}`, body)
+	s.Equal("true", replayed)
+	s.Equal(1, s.calls)
+}
+
+func (s *IdempotencyTestSuite) TestWithoutKey() {
+	s.request("", `{"title":"Test"}`)
+	status, body, _ := s.request("", `{"title":"Test"}`)
+
+	s.Equal(fiber.StatusCreated, status)
+	s.Equal(`{"call":2}`, body)
+}
+
+func (s *IdempotencyTestSuite) TestMismatch() {
+	s.request("key-1", `{"title":"Test"}`)
+	status, body, _ := s.request("key-1", `{"title":"Other"}`)
+
+	s.Equal(fiber.StatusConflict, status)
+	s.Contains(body, `"code":"10"`)
+	s.Equal(1, s.calls)
+}
+
+func (s *IdempotencyTestSuite) TestInProgress() {
+	// Handler sends the same request again while the first one is still being processed
+	status, body, _ := s.request("key-1", `{"title":"nested"}`)
+
+	s.Equal(fiber.StatusCreated, status)
+	s.Equal(`{"nested":409}`, body)
+	s.Equal(1, s.calls)
+}
+
+func (s *IdempotencyTestSuite) TestServerErrorNotStored() {
+	status, _, _ := s.request("key-1", `{"title":"fail"}`)
+	s.Equal(fiber.StatusInternalServerError, status)
+
+	status, _, replayed := s.request("key-1", `{"title":"fail"}`)
+	s.Equal(fiber.StatusInternalServerError, status)
+	s.Empty(replayed)
+	s.Equal(2, s.calls)
+}
+
+func (s *IdempotencyTestSuite) TestStoreError() {
+	app := fiber.New()
+	app.Post("/todo-lists", middleware.NewIdempotency(errorStore{}, time.Hour), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+
+	req := httptest.NewRequest(fiber.MethodPost, "/todo-lists", strings.NewReader(`{}`))
+	req.Header.Set(middleware.HeaderIdempotencyKey, "key-1")
+	resp, err := app.Test(req)
+
+	s.NoError(err)
+	s.Equal(fiber.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func (s *IdempotencyTestSuite) TestQueryString() {
+	app := fiber.New()
+	app.Post("/import", middleware.NewIdempotency(s.store, time.Hour), func(c *fiber.Ctx) error {
+		s.calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"dry_run": c.Query("dry_run")})
+	})
+
+	request := func(target string) int {
+		req := httptest.NewRequest(fiber.MethodPost, target, strings.NewReader(`{}`))
+		req.Header.Set(middleware.HeaderIdempotencyKey, "key-1")
+		resp, err := app.Test(req)
+		s.Require().NoError(err)
+
+		return resp.StatusCode
+	}
+
+	// The result of a dry run is not replayed for the real request
+	s.Equal(fiber.StatusCreated, request("/import?dry_run=true"))
+	s.Equal(fiber.StatusConflict, request("/import"))
+	s.Equal(1, s.calls)
+}
+
+func (s *IdempotencyTestSuite) TestScope() {
+	app := fiber.New()
+	app.Post("/todo-lists", middleware.NewIdempotency(s.store, time.Hour), func(c *fiber.Ctx) error {
+		s.calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"call": s.calls})
+	})
+
+	// The same key of a user and of an unauthenticated client are different keys
+	s.request("key-1", `{"title":"Test"}`)
+
+	req := httptest.NewRequest(fiber.MethodPost, "/todo-lists", strings.NewReader(`{"title":"Test"}`))
+	req.Header.Set(middleware.HeaderIdempotencyKey, "key-1")
+	resp, err := app.Test(req)
+	s.Require().NoError(err)
+	body, _ := io.ReadAll(resp.Body)
+
+	s.Equal(fiber.StatusCreated, resp.StatusCode)
+	s.Equal(`{"call":2}`, string(body))
+	s.Empty(resp.Header.Get(middleware.HeaderIdempotentReplayed))
+}
+
+func (s *IdempotencyTestSuite) TestExpired() {
+	app := fiber.New()
+	app.Post("/todo-lists", middleware.NewIdempotency(s.store, 10*time.Millisecond), func(c *fiber.Ctx) error {
+		s.calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"call": s.calls})
+	})
+
+	request := func() string {
+		req := httptest.NewRequest(fiber.MethodPost, "/todo-lists", strings.NewReader(`{"title":"Test"}`))
+		req.Header.Set(middleware.HeaderIdempotencyKey, "key-1")
+		resp, err := app.Test(req)
+		s.Require().NoError(err)
+		body, _ := io.ReadAll(resp.Body)
+
+		return string(body)
+	}
+
+	s.Equal(`{"call":1}`, request())
+	time.Sleep(20 * time.Millisecond)
+	s.Equal(`{"call":2}`, request())
+}
+
+type errorStore struct{}
+
+func (errorStore) Reserve(context.Context, *entity.IdempotencyRecord) (bool, error) {
+	return false, errors.New("store error")
+}
+
+func (errorStore) Get(context.Context, string) (*entity.IdempotencyRecord, error) {
+	return nil, errors.New("store error")
+}
+
+func (errorStore) Save(context.Context, *entity.IdempotencyRecord) error {
+	return errors.New("store error")
+}
+
+func (errorStore) Delete(context.Context, string) error {
+	return errors.New("store error")
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+)
+
+// Language negotiates the language of the response from Accept-Language and stores it in c.Context() and
+// c.UserContext() so usecases translate validation messages (see i18n). The language is sent in Content-Language
+func Language(c *fiber.Ctx) error {
+	language := i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+
+	i18n.Store(c.Context(), language)
+	c.SetUserContext(i18n.NewContext(c.UserContext(), language))
+	c.Set(fiber.HeaderContentLanguage, language)
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	return c.Next()
+}
@@ -0,0 +1,51 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage(t *testing.T) {
+	app := fiber.New()
+	app.Use(middleware.Language)
+	app.Get("/", func(c *fiber.Ctx) error {
+		// Usecases receive c.Context(), both contexts must carry the same language
+		if i18n.FromContext(c.Context()) != i18n.FromContext(c.UserContext()) {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+
+		return c.SendString(i18n.FromContext(c.Context()))
+	})
+
+	tests := []struct {
+		acceptLanguage string
+		expected       string
+	}{
+		{"", i18n.Default()},
+		{"en-US,en;q=0.9", i18n.English},
+		{"fr-CH, en;q=0.8, id;q=0.9", i18n.Indonesian},
+		{"de", i18n.Default()},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		if tt.acceptLanguage != "" {
+			req.Header.Set(fiber.HeaderAcceptLanguage, tt.acceptLanguage)
+		}
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+
+		assert.Equal(t, tt.expected, string(body), tt.acceptLanguage)
+		assert.Equal(t, tt.expected, resp.Header.Get(fiber.HeaderContentLanguage), tt.acceptLanguage)
+		assert.Equal(t, fiber.HeaderAcceptLanguage, resp.Header.Get(fiber.HeaderVary), tt.acceptLanguage)
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+)
+
+// Metrics records count and latency of every request labelled by method, route template and status code
+// (see metrics.ObserveHTTPRequest)
+func Metrics(c *fiber.Ctx) error {
+	start := time.Now()
+
+	err := c.Next()
+
+	// The error is turned into a response by the error handler after this middleware returns
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			status = fiberErr.Code
+		}
+	}
+
+	metrics.ObserveHTTPRequest(c.Method(), c.Route().Path, strconv.Itoa(status), time.Since(start))
+
+	return err
+}
@@ -0,0 +1,34 @@
+package middleware_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	app := fiber.New()
+	app.Use(middleware.Metrics)
+	app.Get("/todo-lists/:id", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/fail", func(c *fiber.Ctx) error {
+		return fiber.ErrServiceUnavailable
+	})
+
+	// Requests of the same route are counted under the route template
+	for _, path := range []string{"/todo-lists/1", "/todo-lists/2", "/fail"} {
+		_, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues("GET", "/todo-lists/:id", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues("GET", "/fail", "503")))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.HTTPRequestDuration))
+}
@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+// LocalsAPIKeyID is the Locals key of the API key ID, it must only be set by the middleware verifying the API key
+// so a client can not get a new counter by sending made up keys
+const LocalsAPIKeyID = "api_key_id"
+
+// Rate limit policies of the API, they are configured with UseRateLimitStore
+const (
+	RateLimitAuth  = "auth"
+	RateLimitRead  = "read"
+	RateLimitWrite = "write"
+)
+
+// RateLimitStore counts requests per key, implemented by memory and redis RateLimitRepository
+type RateLimitStore interface {
+	// Take counts a request of key under policy and returns the state of the key after it
+	Take(ctx context.Context, key string, policy entity.RateLimitPolicy) (*entity.RateLimitResult, error)
+}
+
+// rateLimitHandlers passes every request through until UseRateLimitStore is called
+var rateLimitHandlers = map[string]fiber.Handler{}
+
+// UseRateLimitStore configures store and policies used by RateLimit middleware, call it before registering routes.
+// Policy with Limit lower than 1 is disabled
+func UseRateLimitStore(store RateLimitStore, policies ...entity.RateLimitPolicy) {
+	handlers := make(map[string]fiber.Handler, len(policies))
+	for _, policy := range policies {
+		if policy.Limit > 0 {
+			handlers[policy.Name] = NewRateLimit(store, policy)
+		}
+	}
+
+	rateLimitHandlers = handlers
+}
+
+// RateLimit limits requests of a route or group with the named policy, requests pass through when the policy is
+// not configured. Several policies can apply to the same request, the headers are set by the last one
+func RateLimit(policy string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		handler, ok := rateLimitHandlers[policy]
+		if !ok {
+			return c.Next()
+		}
+
+		return handler(c)
+	}
+}
+
+// RateLimitByMethod limits safe requests (GET, HEAD and OPTIONS) with read policy and the others with write policy,
+// it is meant for route groups
+func RateLimitByMethod(read string, write string) fiber.Handler {
+	readHandler, writeHandler := RateLimit(read), RateLimit(write)
+
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return readHandler(c)
+		default:
+			return writeHandler(c)
+		}
+	}
+}
+
+// NewRateLimit returns middleware that allows policy.Limit requests per policy.Window for each key of policy.KeyBy,
+// other requests are rejected with 429 Too Many Requests and Retry-After header. Every response has the
+// RateLimit-* headers of the key. Requests pass through when the store fails so it does not take the API down
+func NewRateLimit(store RateLimitStore, policy entity.RateLimitPolicy) fiber.Handler {
+	policyHeader := fmt.Sprintf("%d;w=%d", policy.Limit, int(policy.Window.Seconds()))
+
+	return func(c *fiber.Ctx) error {
+		funcName := "RateLimit"
+
+		key := rateLimitKey(c, policy)
+		result, err := store.Take(c.Context(), key, policy)
+		if err != nil {
+			helper.LogErrorContext(c.Context(), "store.Take", funcName, err, entity.CaptureFields{
+				"policy": policy.Name,
+				"key":    key,
+			}, "")
+			return c.Next()
+		}
+
+		c.Set(HeaderRateLimitLimit, strconv.Itoa(policy.Limit))
+		c.Set(HeaderRateLimitRemaining, strconv.Itoa(max(result.Remaining, 0)))
+		c.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(result.Reset)))
+		c.Set(HeaderRateLimitPolicy, policyHeader)
+
+		if !result.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(max(ceilSeconds(result.RetryAfter), 1)))
+			return json.WriteError(c, apperr.ErrTooManyRequests())
+		}
+
+		return c.Next()
+	}
+}
+
+// rateLimitKey returns the counter key of the request, it falls back to client IP when the request has no user
+// or verified API key. User ID is read from the verified JWT so the middleware can run before VerifyJWTToken,
+// the API key is only used after its middleware verified it and set LocalsAPIKeyID
+func rateLimitKey(c *fiber.Ctx, policy entity.RateLimitPolicy) string {
+	switch policy.KeyBy {
+	case entity.RateLimitKeyByUser:
+		if userID := c.Locals("user_id"); userID != nil {
+			return fmt.Sprintf("%s:user:%v", policy.Name, userID)
+		}
+		if claims, err := auth.ParseToken(c); err == nil {
+			return fmt.Sprintf("%s:user:%d", policy.Name, claims.UserID)
+		}
+	case entity.RateLimitKeyByAPIKey:
+		if apiKeyID := c.Locals(LocalsAPIKeyID); apiKeyID != nil {
+			return fmt.Sprintf("%s:api_key:%v", policy.Name, apiKeyID)
+		}
+	}
+
+	return policy.Name + ":ip:" + ClientIP(c)
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,191 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/memory"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+	store *memory.RateLimitRepository
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (s *RateLimitTestSuite) SetupTest() {
+	s.store = memory.NewRateLimitRepository()
+}
+
+func (s *RateLimitTestSuite) newApp(policy entity.RateLimitPolicy) *fiber.App {
+	app := fiber.New()
+	app.Get("/todo-lists", func(c *fiber.Ctx) error {
+		if userID := c.Get("X-User-ID"); userID != "" {
+			c.Locals("user_id", userID)
+		}
+		// Set by the API key authentication after the key is verified
+		if apiKeyID := c.Get("X-API-Key-ID"); apiKeyID != "" {
+			c.Locals(middleware.LocalsAPIKeyID, apiKeyID)
+		}
+		return c.Next()
+	}, middleware.NewRateLimit(s.store, policy), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	return app
+}
+
+func (s *RateLimitTestSuite) request(app *fiber.App, headers map[string]string) (int, string, http.Header) {
+	req := httptest.NewRequest(fiber.MethodGet, "/todo-lists", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := app.Test(req)
+	s.Require().NoError(err)
+	body, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, string(body), resp.Header
+}
+
+func (s *RateLimitTestSuite) TestAlgorithms() {
+	for _, algorithm := range []string{entity.RateLimitSlidingWindow, entity.RateLimitTokenBucket} {
+		s.Run(algorithm, func() {
+			app := s.newApp(entity.RateLimitPolicy{
+				Name:      algorithm,
+				Algorithm: algorithm,
+				KeyBy:     entity.RateLimitKeyByIP,
+				Limit:     2,
+				Window:    time.Minute,
+			})
+
+			status, _, header := s.request(app, nil)
+			s.Equal(fiber.StatusOK, status)
+			s.Equal("2", header.Get(middleware.HeaderRateLimitLimit))
+			s.Equal("1", header.Get(middleware.HeaderRateLimitRemaining))
+			s.Equal("2;w=60", header.Get(middleware.HeaderRateLimitPolicy))
+
+			status, _, header = s.request(app, nil)
+			s.Equal(fiber.StatusOK, status)
+			s.Equal("0", header.Get(middleware.HeaderRateLimitRemaining))
+
+			status, body, header := s.request(app, nil)
+			s.Equal(fiber.StatusTooManyRequests, status)
+			s.Contains(body, `"code":"11"`)
+			s.Equal("0", header.Get(middleware.HeaderRateLimitRemaining))
+
+			retryAfter, err := strconv.Atoi(header.Get(fiber.HeaderRetryAfter))
+			s.NoError(err)
+			s.Greater(retryAfter, 0)
+			s.LessOrEqual(retryAfter, 120)
+		})
+	}
+}
+
+func (s *RateLimitTestSuite) TestKeyByUser() {
+	app := s.newApp(entity.RateLimitPolicy{
+		Name:   "read",
+		KeyBy:  entity.RateLimitKeyByUser,
+		Limit:  1,
+		Window: time.Minute,
+	})
+
+	status, _, _ := s.request(app, map[string]string{"X-User-ID": "1"})
+	s.Equal(fiber.StatusOK, status)
+	status, _, _ = s.request(app, map[string]string{"X-User-ID": "1"})
+	s.Equal(fiber.StatusTooManyRequests, status)
+
+	// Other user and guest (counted by IP) have their own counter
+	status, _, _ = s.request(app, map[string]string{"X-User-ID": "2"})
+	s.Equal(fiber.StatusOK, status)
+	status, _, _ = s.request(app, nil)
+	s.Equal(fiber.StatusOK, status)
+}
+
+func (s *RateLimitTestSuite) TestKeyByAPIKey() {
+	app := s.newApp(entity.RateLimitPolicy{
+		Name:   "api",
+		KeyBy:  entity.RateLimitKeyByAPIKey,
+		Limit:  1,
+		Window: time.Minute,
+	})
+
+	status, _, _ := s.request(app, map[string]string{"X-API-Key-ID": "1"})
+	s.Equal(fiber.StatusOK, status)
+	status, _, _ = s.request(app, map[string]string{"X-API-Key-ID": "1"})
+	s.Equal(fiber.StatusTooManyRequests, status)
+	status, _, _ = s.request(app, map[string]string{"X-API-Key-ID": "2"})
+	s.Equal(fiber.StatusOK, status)
+
+	// Unverified keys are counted by IP, a new made up key does not get a new counter
+	status, _, _ = s.request(app, map[string]string{"X-API-Key": "made-up-1"})
+	s.Equal(fiber.StatusOK, status)
+	status, _, _ = s.request(app, map[string]string{"X-API-Key": "made-up-2"})
+	s.Equal(fiber.StatusTooManyRequests, status)
+}
+
+func (s *RateLimitTestSuite) TestByMethod() {
+	middleware.UseRateLimitStore(s.store,
+		entity.RateLimitPolicy{Name: middleware.RateLimitRead, Limit: 2, Window: time.Minute},
+		entity.RateLimitPolicy{Name: middleware.RateLimitWrite, Limit: 1, Window: time.Minute},
+	)
+	defer middleware.UseRateLimitStore(s.store)
+
+	app := fiber.New()
+	api := app.Group("/api", middleware.RateLimitByMethod(middleware.RateLimitRead, middleware.RateLimitWrite))
+	api.Get("/todo-lists", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+	api.Post("/todo-lists", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) })
+
+	send := func(method string) int {
+		resp, err := app.Test(httptest.NewRequest(method, "/api/todo-lists", nil))
+		s.Require().NoError(err)
+		return resp.StatusCode
+	}
+
+	s.Equal(fiber.StatusCreated, send(fiber.MethodPost))
+	s.Equal(fiber.StatusTooManyRequests, send(fiber.MethodPost))
+	s.Equal(fiber.StatusOK, send(fiber.MethodGet))
+	s.Equal(fiber.StatusOK, send(fiber.MethodGet))
+	s.Equal(fiber.StatusTooManyRequests, send(fiber.MethodGet))
+}
+
+func (s *RateLimitTestSuite) TestNotConfigured() {
+	app := fiber.New()
+	app.Get("/", middleware.RateLimit("unknown"), func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	s.NoError(err)
+	s.Equal(fiber.StatusOK, resp.StatusCode)
+	s.Empty(resp.Header.Get(middleware.HeaderRateLimitLimit))
+}
+
+func (s *RateLimitTestSuite) TestStoreError() {
+	app := fiber.New()
+	policy := entity.RateLimitPolicy{Name: "read", Limit: 1, Window: time.Minute}
+	app.Get("/", middleware.NewRateLimit(errorRateLimitStore{}, policy), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	s.NoError(err)
+	s.Equal(fiber.StatusOK, resp.StatusCode)
+}
+
+type errorRateLimitStore struct{}
+
+func (errorRateLimitStore) Take(context.Context, string, entity.RateLimitPolicy) (*entity.RateLimitResult, error) {
+	return nil, errors.New("store error")
+}
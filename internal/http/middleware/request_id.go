@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+)
+
+// LocalsRequestID is the c.Locals key of the request ID, used by the access log format ${locals:request_id}
+const LocalsRequestID = "request_id"
+
+// RequestID accepts X-Request-ID sent by the client or generates one, echoes it in the response and stores it
+// in c.Context() and c.UserContext() so usecases, logs and published queue messages carry it (see requestid)
+func RequestID(c *fiber.Ctx) error {
+	id := c.Get(requestid.Header)
+	if !requestid.Valid(id) {
+		id = requestid.New()
+	}
+
+	requestid.Store(c.Context(), id)
+	c.SetUserContext(requestid.NewContext(c.UserContext(), id))
+	c.Locals(LocalsRequestID, id)
+	c.Set(requestid.Header, id)
+
+	return c.Next()
+}
@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	app := fiber.New()
+	app.Use(middleware.RequestID)
+	app.Get("/", func(c *fiber.Ctx) error {
+		// Usecases receive c.Context(), both contexts must carry the same ID
+		if requestid.FromContext(c.Context()) != requestid.FromContext(c.UserContext()) {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+
+		return c.SendString(requestid.FromContext(c.Context()))
+	})
+
+	request := func(id string) (string, string) {
+		req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+		if id != "" {
+			req.Header.Set(requestid.Header, id)
+		}
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+
+		return resp.Header.Get(requestid.Header), string(body)
+	}
+
+	t.Run("Accept client ID", func(t *testing.T) {
+		header, body := request("req-123")
+
+		assert.Equal(t, "req-123", header)
+		assert.Equal(t, "req-123", body)
+	})
+
+	t.Run("Generate ID", func(t *testing.T) {
+		header, body := request("")
+
+		assert.Len(t, header, 36)
+		assert.Equal(t, header, body)
+	})
+
+	t.Run("Replace invalid ID", func(t *testing.T) {
+		header, body := request(strings.Repeat("a", 129))
+
+		assert.Len(t, header, 36)
+		assert.Equal(t, header, body)
+	})
+}
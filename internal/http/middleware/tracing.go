@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts a server span for every request, continuing the trace of the caller when it sends traceparent.
+// The span is named after the matched route (ex. GET /api/v1/todo-lists/:id) and is stored in c.Context() and
+// c.UserContext() so database and queue spans started by usecases are its children
+func Tracing(c *fiber.Ctx) error {
+	ctx := tracing.Extract(c.UserContext(), requestHeaders{c})
+	ctx, span := tracing.Start(ctx, c.Method(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(c.Method()),
+			semconv.URLPath(c.Path()),
+			semconv.URLScheme(c.Protocol()),
+			semconv.UserAgentOriginal(c.Get(fiber.HeaderUserAgent)),
+			semconv.ClientAddress(ClientIP(c)),
+		),
+	)
+	defer span.End()
+
+	if id := requestid.FromContext(c.Context()); id != "" {
+		span.SetAttributes(attribute.String("http.request.id", id))
+	}
+
+	tracing.Store(c.Context(), span)
+	c.SetUserContext(ctx)
+
+	err := c.Next()
+
+	// The error is turned into a response by the error handler after this middleware returns
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			status = fiberErr.Code
+		}
+	}
+
+	route := c.Route().Path
+	span.SetName(c.Method() + " " + route)
+	span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPResponseStatusCode(status))
+	if status >= fiber.StatusInternalServerError {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.SetStatus(codes.Error, "")
+	}
+
+	return err
+}
+
+// requestHeaders adapts the request headers to propagation.TextMapCarrier
+type requestHeaders struct {
+	c *fiber.Ctx
+}
+
+func (h requestHeaders) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h requestHeaders) Set(key string, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+func (h requestHeaders) Keys() []string {
+	var keys []string
+	h.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+
+	return keys
+}
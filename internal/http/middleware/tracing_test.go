@@ -0,0 +1,68 @@
+package middleware_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/internal/http/middleware"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	app := fiber.New()
+	app.Use(middleware.Tracing)
+	app.Get("/todo-lists/:id", func(c *fiber.Ctx) error {
+		// Spans started by usecases from c.Context() are children of the request span
+		_, span := tracing.Start(c.Context(), "usecase")
+		span.End()
+
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/fail", func(c *fiber.Ctx) error {
+		return fiber.ErrServiceUnavailable
+	})
+
+	t.Run("Server Span", func(t *testing.T) {
+		exporter.Reset()
+
+		req := httptest.NewRequest(fiber.MethodGet, "/todo-lists/1", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		_, err := app.Test(req)
+		require.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+
+		usecase, server := spans[0], spans[1]
+		assert.Equal(t, "GET /todo-lists/:id", server.Name)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+		assert.Contains(t, server.Attributes, attribute.Int("http.response.status_code", fiber.StatusOK))
+		assert.Equal(t, server.SpanContext.SpanID(), usecase.Parent.SpanID())
+	})
+
+	t.Run("Error Status", func(t *testing.T) {
+		exporter.Reset()
+
+		_, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/fail", nil))
+		require.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Contains(t, spans[0].Attributes, attribute.Int("http.response.status_code", fiber.StatusServiceUnavailable))
+	})
+}
@@ -1,15 +1,49 @@
 package middleware
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	apperr "github.com/rahmatrdn/go-skeleton/error"
 	"github.com/rahmatrdn/go-skeleton/internal/http/auth"
+	"github.com/rahmatrdn/go-skeleton/internal/presenter/json"
 )
 
 func VerifyJWTToken(c *fiber.Ctx) error {
 	if err := auth.VerifyToken(c); err != nil {
-		return c.Status(apperr.ErrInvalidToken().HTTPCode).JSON(apperr.ErrInvalidToken())
+		return json.WriteError(c, apperr.ErrInvalidToken())
 	}
 
 	return c.Next()
 }
+
+// WebSocketTokenProtocol is the WebSocket subprotocol that carries the JWT, browsers send it with
+// new WebSocket(url, ["access_token", token]) and the server selects it in the handshake response
+const WebSocketTokenProtocol = "access_token"
+
+// VerifyStreamToken verifies JWT like VerifyJWTToken and also accepts the token in access_token query,
+// browser EventSource can not send Authorization header
+func VerifyStreamToken(c *fiber.Ctx) error {
+	if c.Get(fiber.HeaderAuthorization) == "" && c.Query("access_token") != "" {
+		c.Request().Header.Set(fiber.HeaderAuthorization, "Bearer "+c.Query("access_token"))
+	}
+
+	return VerifyJWTToken(c)
+}
+
+// VerifyWebSocketToken verifies JWT like VerifyJWTToken and also accepts the token as the subprotocol following
+// WebSocketTokenProtocol in Sec-WebSocket-Protocol header, browser WebSocket can not send Authorization header.
+// The token is not read from the query so it does not end up in access logs
+func VerifyWebSocketToken(c *fiber.Ctx) error {
+	if c.Get(fiber.HeaderAuthorization) == "" {
+		protocols := strings.Split(c.Get(fiber.HeaderSecWebSocketProtocol), ",")
+		for i := 0; i < len(protocols)-1; i++ {
+			if strings.TrimSpace(protocols[i]) == WebSocketTokenProtocol {
+				c.Request().Header.Set(fiber.HeaderAuthorization, "Bearer "+strings.TrimSpace(protocols[i+1]))
+				break
+			}
+		}
+	}
+
+	return VerifyJWTToken(c)
+}
@@ -0,0 +1,143 @@
+// Package i18n carries the language of a request through context.Context and translates the messages sent to
+// clients. The language is negotiated from Accept-Language, see Negotiate
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	English    = "en"
+	Indonesian = "id"
+
+	// PayloadKey is the key of the language in the payload passed to queue consumers, see FromPayload
+	PayloadKey = "_language"
+)
+
+// Languages are the supported languages, every message has a translation in each of them
+var Languages = []string{English, Indonesian}
+
+// defaultLanguage is used when the client accepts none of Languages, Indonesian keeps the messages of clients
+// that do not send Accept-Language as they were
+var defaultLanguage = Indonesian
+
+type contextKey struct{}
+
+// userValueSetter is implemented by *fasthttp.RequestCtx (fiber.Ctx.Context()) which is passed to usecases
+type userValueSetter interface {
+	SetUserValue(key interface{}, value interface{})
+}
+
+// SetDefault configures the default language, unsupported languages are ignored. Call it before serving requests
+func SetDefault(language string) {
+	if language = Normalize(language); Supported(language) {
+		defaultLanguage = language
+	}
+}
+
+func Default() string {
+	return defaultLanguage
+}
+
+// Normalize returns the primary language of a language tag, ex. "en-US" becomes "en"
+func Normalize(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	return language
+}
+
+func Supported(language string) bool {
+	for _, v := range Languages {
+		if v == language {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Negotiate returns the supported language the client prefers in an Accept-Language header, ex.
+// "fr-CH, en;q=0.8, id;q=0.9" returns id. The default language is returned when none is accepted
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		language string
+		q        float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		language := Normalize(tag)
+		if language == "*" {
+			language = defaultLanguage
+		}
+		if q > 0 && Supported(language) {
+			candidates = append(candidates, candidate{language, q})
+		}
+	}
+
+	// stable so languages of the same quality keep the order of the header
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	if len(candidates) > 0 {
+		return candidates[0].language
+	}
+
+	return defaultLanguage
+}
+
+func NewContext(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, contextKey{}, language)
+}
+
+// Store sets the language on a request context that stores values in place, ex. *fasthttp.RequestCtx
+func Store(ctx userValueSetter, language string) {
+	ctx.SetUserValue(contextKey{}, language)
+}
+
+// FromContext returns the language of ctx, empty when there is none
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	language, _ := ctx.Value(contextKey{}).(string)
+	return language
+}
+
+// Language returns the language of ctx or the default language when ctx has none (ex. jobs of the scheduler)
+func Language(ctx context.Context) string {
+	if language := FromContext(ctx); Supported(language) {
+		return language
+	}
+
+	return defaultLanguage
+}
+
+// FromPayload returns ctx with the language of a consumed queue message, ctx is returned as is when the
+// message has none
+func FromPayload(ctx context.Context, payload map[string]interface{}) context.Context {
+	language, _ := payload[PayloadKey].(string)
+	if language == "" {
+		return ctx
+	}
+
+	return NewContext(ctx, language)
+}
@@ -0,0 +1,90 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		expected       string
+	}{
+		{"", i18n.Indonesian},
+		{"en", i18n.English},
+		{"en-US,en;q=0.9", i18n.English},
+		{"id-ID", i18n.Indonesian},
+		{"fr-CH, en;q=0.8, id;q=0.9", i18n.Indonesian},
+		{"en;q=0.5, id;q=0.5", i18n.English},
+		{"en;q=0, id;q=0.1", i18n.Indonesian},
+		{"en;q=invalid", i18n.Indonesian},
+		{"de, *;q=0.5", i18n.Indonesian},
+		{"de, fr", i18n.Indonesian},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, i18n.Negotiate(tt.acceptLanguage), tt.acceptLanguage)
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	defer i18n.SetDefault(i18n.Indonesian)
+
+	i18n.SetDefault("fr")
+	assert.Equal(t, i18n.Indonesian, i18n.Default())
+
+	i18n.SetDefault("en-GB")
+	assert.Equal(t, i18n.English, i18n.Default())
+	assert.Equal(t, i18n.English, i18n.Negotiate("de"))
+	assert.Equal(t, i18n.English, i18n.Language(context.Background()))
+}
+
+func TestMessage(t *testing.T) {
+	en := i18n.NewContext(context.Background(), i18n.English)
+	id := i18n.NewContext(context.Background(), i18n.Indonesian)
+
+	assert.Equal(t, "Date range is at most 31 days", i18n.Message(en, i18n.MsgDateRangeMax, 31))
+	assert.Equal(t, "Rentang tanggal maksimal 31 hari", i18n.Message(id, i18n.MsgDateRangeMax, 31))
+	assert.Equal(t, "Time Zone is invalid", i18n.Message(en, i18n.MsgInvalidTimezone))
+	assert.Equal(t, "unknown", i18n.Message(en, "unknown"))
+}
+
+func TestPayload(t *testing.T) {
+	ctx := i18n.FromPayload(context.Background(), map[string]interface{}{i18n.PayloadKey: i18n.English})
+	assert.Equal(t, i18n.English, i18n.Language(ctx))
+
+	ctx = i18n.FromPayload(context.Background(), map[string]interface{}{})
+	assert.Empty(t, i18n.FromContext(ctx))
+	assert.Equal(t, i18n.Default(), i18n.Language(ctx))
+}
+
+func TestValidator(t *testing.T) {
+	type request struct {
+		Title string `validate:"required" name:"Judul" name_en:"Title"`
+		Notes string `validate:"required"`
+	}
+
+	tests := []struct {
+		language string
+		expected []string
+	}{
+		{i18n.English, []string{"Title is a required field", "Notes is a required field"}},
+		{i18n.Indonesian, []string{"Judul wajib diisi", "Notes wajib diisi"}},
+	}
+	for _, tt := range tests {
+		validate, trans := i18n.Validator(tt.language)
+		err := validate.Struct(request{})
+		if !assert.Error(t, err, tt.language) {
+			continue
+		}
+
+		var messages []string
+		for _, v := range err.(validator.ValidationErrors) {
+			messages = append(messages, v.Translate(trans))
+		}
+		assert.Equal(t, tt.expected, messages, tt.language)
+	}
+}
@@ -0,0 +1,46 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+)
+
+// Keys of the messages sent to clients, their translations are in messages
+const (
+	MsgDateRangeOrder  = "date_range_order"
+	MsgDateRangeMax    = "date_range_max"
+	MsgInvalidTimezone = "invalid_timezone"
+	MsgWebhookURL      = "webhook_url"
+)
+
+var messages = map[string]map[string]string{
+	English: {
+		MsgDateRangeOrder:  "Start Date must be before or equal to End Date",
+		MsgDateRangeMax:    "Date range is at most %d days",
+		MsgInvalidTimezone: "Time Zone is invalid",
+		MsgWebhookURL:      "URL must be a public http or https address",
+	},
+	Indonesian: {
+		MsgDateRangeOrder:  "Tanggal Awal harus sebelum atau sama dengan Tanggal Akhir",
+		MsgDateRangeMax:    "Rentang tanggal maksimal %d hari",
+		MsgInvalidTimezone: "Zona Waktu tidak valid",
+		MsgWebhookURL:      "URL harus berupa alamat http atau https publik",
+	},
+}
+
+// Message returns the message of key in the language of ctx formatted with args (see fmt.Sprintf), key is
+// returned when it has no translation
+func Message(ctx context.Context, key string, args ...interface{}) string {
+	format, ok := messages[Language(ctx)][key]
+	if !ok {
+		format, ok = messages[English][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,62 @@
+package i18n
+
+import (
+	"reflect"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	id_translations "github.com/go-playground/validator/v10/translations/id"
+)
+
+type registerTranslationsFunc func(v *validator.Validate, trans ut.Translator) error
+
+type validatorTranslation struct {
+	validate *validator.Validate
+	trans    ut.Translator
+}
+
+// validators are registered once at startup, one validator per language since the field labels are cached
+// with the struct metadata
+var validators = map[string]*validatorTranslation{}
+
+func init() {
+	registerValidator(English, en.New(), en_translations.RegisterDefaultTranslations, "name_en")
+	registerValidator(Indonesian, id.New(), id_translations.RegisterDefaultTranslations, "name_id", "name")
+}
+
+// registerValidator adds the validator of language, field labels in messages are read from the first of
+// labelTags a field has (ex. `name_en:"Title"`) and fall back to the Go field name
+func registerValidator(language string, locale locales.Translator, register registerTranslationsFunc, labelTags ...string) {
+	trans, _ := ut.New(locale, locale).GetTranslator(locale.Locale())
+
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range labelTags {
+			if label := field.Tag.Get(tag); label != "" {
+				return label
+			}
+		}
+
+		return field.Name
+	})
+	if err := register(validate, trans); err != nil {
+		panic("i18n: register " + language + " validator translations: " + err.Error())
+	}
+
+	validators[language] = &validatorTranslation{validate: validate, trans: trans}
+}
+
+// Validator returns the validator of language and the translator of its error messages, the default language
+// is used when language is not supported
+func Validator(language string) (*validator.Validate, ut.Translator) {
+	v, ok := validators[language]
+	if !ok {
+		v = validators[defaultLanguage]
+	}
+
+	return v.validate, v.trans
+}
@@ -0,0 +1,126 @@
+// Package metrics defines the Prometheus metrics of the app, they are registered to the default registry which
+// also exposes Go runtime and process metrics. The API serves them at /metrics, the worker and scheduler through
+// their own listener (see Serve)
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "go_skeleton"
+
+// Result label values of queue metrics
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+var (
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by route template and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	QueuePublishedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queue_published_total",
+		Help:      "Number of messages published to RabbitMQ by routing key and result.",
+	}, []string{"key", "result"})
+
+	QueueConsumedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queue_consumed_total",
+		Help:      "Number of messages processed by consumers by routing key and result.",
+	}, []string{"key", "result"})
+
+	QueueRetriedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queue_retried_total",
+		Help:      "Number of failed messages published again for retry by routing key.",
+	}, []string{"key"})
+
+	QueueProcessDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "queue_process_duration_seconds",
+		Help:      "Time spent by consumers processing a message by routing key.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"key"})
+)
+
+// ObserveHTTPRequest records a served request, route is the route template (ex. /api/v1/todo-lists/:id)
+// so the number of series does not grow with IDs
+func ObserveHTTPRequest(method string, route string, status string, duration time.Duration) {
+	HTTPRequestsTotal.WithLabelValues(method, route, status).Inc()
+	HTTPRequestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
+
+// ObserveConsumed records a message processed by a consumer, err is the error returned by the consumer
+func ObserveConsumed(key string, err error, duration time.Duration) {
+	QueueConsumedTotal.WithLabelValues(key, result(err)).Inc()
+	QueueProcessDuration.WithLabelValues(key).Observe(duration.Seconds())
+}
+
+func ObservePublished(key string, err error) {
+	QueuePublishedTotal.WithLabelValues(key, result(err)).Inc()
+}
+
+// RegisterDB exposes the connection pool stats (sql.DB.Stats) of db, name is the db_name label.
+// Registering the same name again is a no-op, ex. when several repositories open their own connection
+func RegisterDB(db *sql.DB, name string) error {
+	err := prometheus.Register(collectors.NewDBStatsCollector(db, name))
+
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		return nil
+	}
+
+	return err
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve serves metrics at addr/metrics until the process exits, it is used by binaries without HTTP server.
+// Nothing is served when addr is empty
+func Serve(addr string) {
+	if addr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		log.Printf("[METRICS] Serving metrics, listening at %s\n", addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[METRICS] Fail serving metrics: %s\n", err.Error())
+		}
+	}()
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+
+	return ResultSuccess
+}
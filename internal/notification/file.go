@@ -0,0 +1,40 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileNotifier writes messages as .eml files to a directory, used in development to preview emails
+type FileNotifier struct {
+	dir      string
+	from     string
+	renderer *Renderer
+}
+
+func NewFileNotifier(dir string, from string, renderer *Renderer) *FileNotifier {
+	return &FileNotifier{dir, from, renderer}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, message Message) error {
+	subject, body, err := n.renderer.Render(message)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	email, err := buildEmail(n.from, message.To, subject, body, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(n.dir, 0o755); err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%s_%s_%d.eml", now.Format("20060102150405.000000000"), message.Type, message.UserID)
+
+	return os.WriteFile(filepath.Join(n.dir, fileName), email, 0o644)
+}
@@ -0,0 +1,38 @@
+package notification_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileNotifier(t *testing.T) {
+	renderer, err := notification.NewRenderer("en")
+	require.NoError(t, err)
+
+	dir := filepath.Join(t.TempDir(), "notifications")
+	notifier := notification.NewFileNotifier(dir, "no-reply@example.com", renderer)
+
+	err = notifier.Notify(context.Background(), notification.Message{
+		UserID:  1,
+		To:      "user@example.com",
+		Type:    "unknown",
+		Subject: "Hello",
+		Body:    "Hi",
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*_unknown_1.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "From: no-reply@example.com\r\n")
+	assert.Contains(t, string(content), "Subject: Hello\r\n")
+}
@@ -0,0 +1,54 @@
+// Package notification sends messages to users through pluggable drivers
+package notification
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+)
+
+const TypeTodoReminder = "todo.reminder"
+
+// Message is a notification for a user, Data contains values of the notification type (ex. todo list ID).
+// To is the recipient email and Locale the template language, both are optional
+type Message struct {
+	UserID  int64                  `json:"user_id"`
+	To      string                 `json:"to,omitempty"`
+	Locale  string                 `json:"locale,omitempty"`
+	Type    string                 `json:"type"`
+	Subject string                 `json:"subject"`
+	Body    string                 `json:"body"`
+	Data    map[string]interface{} `json:"data,omitempty"`
+}
+
+func (m *Message) LoadFromMap(payload map[string]interface{}) error {
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, m)
+	}
+	return err
+}
+
+// Notifier delivers message to the user, returned error means the message may be retried
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+// LogNotifier writes messages to the application log, used in development or when no driver is configured
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, message Message) error {
+	helper.LogInfoContext(ctx, message.Type, "LogNotifier.Notify", entity.CaptureFields{
+		"user_id": helper.ToString(message.UserID),
+		"to":      message.To,
+		"body":    message.Body,
+	}, message.Subject)
+
+	return nil
+}
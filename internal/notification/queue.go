@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+)
+
+// QueueNotifier publishes messages to the worker (topic notification.send) which sends them with the
+// configured driver, so callers (ex. HTTP handlers) do not wait for the SMTP server. Failed sends are retried
+// by the queue
+type QueueNotifier struct {
+	queue queue.Queue
+}
+
+func NewQueueNotifier(queue queue.Queue) *QueueNotifier {
+	return &QueueNotifier{queue}
+}
+
+func (n *QueueNotifier) Notify(ctx context.Context, message Message) error {
+	payload, err := helper.Serialize(message)
+	if err != nil {
+		return err
+	}
+
+	return n.queue.PublishWithContext(ctx, queue.ProcessNotificationSend, payload, 1)
+}
@@ -0,0 +1,45 @@
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQueueNotifier(t *testing.T) {
+	message := notification.Message{UserID: 1, Type: notification.TypeTodoReminder, Data: map[string]interface{}{"title": "Meeting"}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockQueue := &mocks.Queue{}
+		mockQueue.On("PublishWithContext", mock.Anything, queue.ProcessNotificationSend, mock.MatchedBy(func(payload []byte) bool {
+			var published map[string]interface{}
+			_ = json.Unmarshal(payload, &published)
+
+			var got notification.Message
+			_ = got.LoadFromMap(published)
+
+			return assert.ObjectsAreEqual(message, got)
+		}), int32(1)).Return(nil)
+
+		err := notification.NewQueueNotifier(mockQueue).Notify(context.Background(), message)
+
+		assert.NoError(t, err)
+		mockQueue.AssertExpectations(t)
+	})
+
+	t.Run("Publish Failed", func(t *testing.T) {
+		mockQueue := &mocks.Queue{}
+		mockQueue.On("PublishWithContext", mock.Anything, queue.ProcessNotificationSend, mock.Anything, int32(1)).Return(errors.New("closed"))
+
+		err := notification.NewQueueNotifier(mockQueue).Notify(context.Background(), message)
+
+		assert.Error(t, err)
+	})
+}
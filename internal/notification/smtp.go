@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPConfig contains SMTP server options, Username is optional (no AUTH when it is empty)
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// SMTPNotifier sends messages as HTML email, the connection is upgraded with STARTTLS when the server supports it
+type SMTPNotifier struct {
+	config   SMTPConfig
+	renderer *Renderer
+}
+
+func NewSMTPNotifier(config SMTPConfig, renderer *Renderer) *SMTPNotifier {
+	return &SMTPNotifier{config, renderer}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, message Message) error {
+	if message.To == "" {
+		return errors.New("notification recipient is empty")
+	}
+
+	subject, body, err := n.renderer.Render(message)
+	if err != nil {
+		return err
+	}
+	email, err := buildEmail(n.config.From, message.To, subject, body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return n.send(ctx, message.To, email)
+}
+
+func (n *SMTPNotifier) send(ctx context.Context, to string, email []byte) error {
+	dialer := net.Dialer{Timeout: n.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port)))
+	if err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok && n.config.Timeout > 0 {
+		deadline = time.Now().Add(n.config.Timeout)
+	}
+	if !deadline.IsZero() {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.config.Host}); err != nil {
+			return err
+		}
+	}
+	if n.config.Username != "" {
+		auth := smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.config.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(email); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,187 @@
+package notification_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts one session and records its commands and DATA, authentication is accepted
+// unless rejectAuth is set
+type fakeSMTPServer struct {
+	listener   net.Listener
+	rejectAuth bool
+	commands   []string
+	data       string
+	done       chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T, rejectAuth bool) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{listener: listener, rejectAuth: rejectAuth, done: make(chan struct{})}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP fake")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		s.commands = append(s.commands, command)
+
+		switch command {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			if s.rejectAuth {
+				reply("535 authentication failed")
+				continue
+			}
+			reply("235 authenticated")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			reply("250 OK")
+		case "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.data = data.String()
+
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	renderer, err := notification.NewRenderer("en")
+	require.NoError(t, err)
+
+	message := notification.Message{
+		UserID: 1,
+		To:     "user@example.com",
+		Type:   notification.TypeTodoReminder,
+		Data:   map[string]interface{}{"title": "Weekly meeting", "due_at": "2024-01-10 09:00:00"},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		server := newFakeSMTPServer(t, false)
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     server.port(),
+			Username: "user",
+			Password: "secret",
+			From:     "no-reply@example.com",
+			Timeout:  5 * time.Second,
+		}, renderer)
+
+		err := notifier.Notify(context.Background(), message)
+		require.NoError(t, err)
+		<-server.done
+
+		assert.Equal(t, []string{"EHLO", "AUTH", "MAIL", "RCPT", "DATA", "QUIT"}, server.commands)
+		assert.Contains(t, server.data, "To: user@example.com\r\n")
+		assert.Contains(t, server.data, "Subject: Reminder: Weekly meeting\r\n")
+		assert.Contains(t, server.data, "Content-Type: text/html; charset=UTF-8\r\n")
+		assert.Contains(t, server.data, "2024-01-10 09:00:00")
+	})
+
+	t.Run("Without Auth", func(t *testing.T) {
+		server := newFakeSMTPServer(t, false)
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:    "127.0.0.1",
+			Port:    server.port(),
+			From:    "no-reply@example.com",
+			Timeout: 5 * time.Second,
+		}, renderer)
+
+		err := notifier.Notify(context.Background(), message)
+		require.NoError(t, err)
+		<-server.done
+
+		assert.NotContains(t, server.commands, "AUTH")
+	})
+
+	t.Run("Auth Rejected", func(t *testing.T) {
+		server := newFakeSMTPServer(t, true)
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     server.port(),
+			Username: "user",
+			Password: "wrong",
+			From:     "no-reply@example.com",
+			Timeout:  5 * time.Second,
+		}, renderer)
+
+		err := notifier.Notify(context.Background(), message)
+		assert.Error(t, err)
+	})
+
+	t.Run("Empty Recipient", func(t *testing.T) {
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{Host: "127.0.0.1", Port: 1}, renderer)
+
+		err := notifier.Notify(context.Background(), notification.Message{UserID: 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("Server Unavailable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:    "127.0.0.1",
+			Port:    port,
+			Timeout: time.Second,
+		}, renderer)
+
+		err = notifier.Notify(context.Background(), message)
+		assert.Error(t, err)
+	})
+}
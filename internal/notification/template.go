@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"html"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// DefaultLocale is used when neither the message nor the renderer has a locale with the template
+const DefaultLocale = "en"
+
+//go:embed templates
+var templateFS embed.FS
+
+// fallbackTemplate renders Subject and Body of the message when its type has no template
+const fallbackTemplate = `{{define "subject"}}{{.Message.Subject}}{{end}}{{define "body"}}<p>{{.Message.Body}}</p>{{end}}`
+
+// Renderer renders messages with the embedded templates/<locale>/<type>.html, each template defines a
+// "subject" and a "body" block, the body is wrapped by templates/layout.html
+type Renderer struct {
+	defaultLocale string
+	templates     map[string]*template.Template
+	fallback      *template.Template
+}
+
+type templateData struct {
+	Locale  string
+	Subject string
+	Message Message
+	Data    map[string]interface{}
+}
+
+func NewRenderer(defaultLocale string) (*Renderer, error) {
+	r := &Renderer{
+		defaultLocale: NormalizeLocale(defaultLocale),
+		templates:     make(map[string]*template.Template),
+	}
+
+	files, err := fs.Glob(templateFS, "templates/*/*.html")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		tmpl, err := template.ParseFS(templateFS, "templates/layout.html", file)
+		if err != nil {
+			return nil, err
+		}
+
+		locale := path.Base(path.Dir(file))
+		notificationType := strings.TrimSuffix(path.Base(file), ".html")
+		r.templates[locale+"/"+notificationType] = tmpl
+	}
+
+	r.fallback, err = template.ParseFS(templateFS, "templates/layout.html")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.fallback.Parse(fallbackTemplate); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Render returns subject (plain text) and HTML body of the message. The template is looked up in the message
+// locale, then the renderer default locale and "en"
+func (r *Renderer) Render(message Message) (subject string, body string, err error) {
+	tmpl, locale := r.lookup(message)
+	data := templateData{
+		Locale:  locale,
+		Message: message,
+		Data:    message.Data,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", err
+	}
+	// Subject is a header, not HTML, so the escaping of html/template is reverted
+	data.Subject = strings.TrimSpace(html.UnescapeString(buf.String()))
+
+	buf.Reset()
+	if err := tmpl.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return "", "", err
+	}
+
+	return data.Subject, buf.String(), nil
+}
+
+func (r *Renderer) lookup(message Message) (*template.Template, string) {
+	for _, locale := range []string{NormalizeLocale(message.Locale), r.defaultLocale, DefaultLocale} {
+		if tmpl, ok := r.templates[locale+"/"+message.Type]; ok {
+			return tmpl, locale
+		}
+	}
+
+	locale := NormalizeLocale(message.Locale)
+	if locale == "" {
+		locale = r.defaultLocale
+	}
+
+	return r.fallback, locale
+}
+
+// NormalizeLocale returns the language of locale, ex. "id-ID" becomes "id"
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	return locale
+}
@@ -0,0 +1,72 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer(t *testing.T) {
+	data := map[string]interface{}{"title": "Meeting <Q1> & review", "due_at": "2024-01-10 09:00:00"}
+
+	testcases := []struct {
+		name          string
+		defaultLocale string
+		message       notification.Message
+		wantSubject   string
+		wantBody      []string
+	}{
+		{
+			name:          "English",
+			defaultLocale: "en",
+			message:       notification.Message{Type: notification.TypeTodoReminder, Data: data},
+			wantSubject:   "Reminder: Meeting <Q1> & review",
+			wantBody:      []string{`<html lang="en">`, "Meeting &lt;Q1&gt; &amp; review", "is due at"},
+		},
+		{
+			name:          "Message Locale",
+			defaultLocale: "en",
+			message:       notification.Message{Type: notification.TypeTodoReminder, Locale: "id-ID", Data: data},
+			wantSubject:   "Pengingat: Meeting <Q1> & review",
+			wantBody:      []string{`<html lang="id">`, "jatuh tempo pada"},
+		},
+		{
+			name:          "Default Locale",
+			defaultLocale: "id",
+			message:       notification.Message{Type: notification.TypeTodoReminder, Data: data},
+			wantSubject:   "Pengingat: Meeting <Q1> & review",
+			wantBody:      []string{"jatuh tempo pada"},
+		},
+		{
+			name:          "Unknown Locale",
+			defaultLocale: "fr",
+			message:       notification.Message{Type: notification.TypeTodoReminder, Locale: "de", Data: data},
+			wantSubject:   "Reminder: Meeting <Q1> & review",
+			wantBody:      []string{`<html lang="en">`},
+		},
+		{
+			name:          "Without Template",
+			defaultLocale: "en",
+			message:       notification.Message{Type: "unknown", Subject: "Hello", Body: "Hi <there>"},
+			wantSubject:   "Hello",
+			wantBody:      []string{"<p>Hi &lt;there&gt;</p>", "<title>Hello</title>"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			renderer, err := notification.NewRenderer(tc.defaultLocale)
+			require.NoError(t, err)
+
+			subject, body, err := renderer.Render(tc.message)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantSubject, subject)
+			for _, want := range tc.wantBody {
+				assert.Contains(t, body, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSONNull is a member value that sets the field to null instead of removing it,
+// it is produced by JSON Patch add and replace operations with null value
+var JSONNull = json.RawMessage("null")
+
+// MergePatch is a JSON Merge Patch document (RFC 7396). A member set to null removes (clears) the field,
+// a member that is not present is left unchanged
+type MergePatch map[string]interface{}
+
+// Fields returns top level member names of the patch in sorted order
+func (p MergePatch) Fields() []string {
+	fields := make([]string, 0, len(p))
+	for name := range p {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// StructFields maps patch members into Go field names of target (by json tag),
+// the result can be passed to validator StructPartial so only supplied fields are validated
+func (p MergePatch) StructFields(target interface{}) []string {
+	t := reflect.TypeOf(target)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, ok := p[jsonFieldName(field)]; ok {
+			fields = append(fields, field.Name)
+		}
+	}
+
+	return fields
+}
+
+// Apply merges the patch into target (pointer to struct) following RFC 7396, members set to null
+// become zero value. Unknown members and fields tagged `patch:"readonly"` are rejected so typos are not
+// silently ignored, JSONNull is only accepted by nullable fields (pointer, map, slice and interface)
+func (p MergePatch) Apply(target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("merge patch target must be a non-nil pointer")
+	}
+	if err := checkMembers(p, rv.Elem().Type()); err != nil {
+		return err
+	}
+
+	original, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(original, &document); err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergePatch(document, map[string]interface{}(p)))
+	if err != nil {
+		return err
+	}
+
+	// Decode into a zero value so removed members are cleared
+	result := reflect.New(rv.Elem().Type())
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(result.Interface()); err != nil {
+		return err
+	}
+	rv.Elem().Set(result.Elem())
+
+	return nil
+}
+
+// mergePatch is the MergePatch(Target, Patch) function of RFC 7396
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+
+	return targetObject
+}
+
+// checkMembers walks the patch along struct fields of t, refusing read-only fields and null values
+// for fields that can not hold null
+func checkMembers(patch map[string]interface{}, t reflect.Type) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := jsonFieldName(field)
+		value, ok := patch[name]
+		if !ok {
+			continue
+		}
+		if field.Tag.Get("patch") == "readonly" {
+			return fmt.Errorf("field %q is read-only", name)
+		}
+
+		switch value := value.(type) {
+		case json.RawMessage:
+			if bytes.Equal(value, JSONNull) && !isNullable(field.Type) {
+				return fmt.Errorf("field %q can not be null", name)
+			}
+		case map[string]interface{}:
+			if err := checkMembers(value, field.Type); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isNullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return true
+	}
+
+	return false
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
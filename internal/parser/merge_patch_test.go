@@ -0,0 +1,164 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+type patchSchedule struct {
+	Day  string `json:"day"`
+	Time string `json:"time"`
+}
+
+type patchTarget struct {
+	ID          int64          `json:"id" patch:"readonly"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Tags        []string       `json:"tags"`
+	Schedule    patchSchedule  `json:"schedule"`
+	Reminder    *patchSchedule `json:"reminder"`
+}
+
+func TestMergePatchApply(t *testing.T) {
+	current := patchTarget{
+		ID:          1,
+		Title:       "Title",
+		Description: "Description",
+		Tags:        []string{"work"},
+		Schedule:    patchSchedule{Day: "monday", Time: "09:00"},
+		Reminder:    &patchSchedule{Day: "sunday", Time: "20:00"},
+	}
+
+	tests := []struct {
+		name    string
+		patch   parser.MergePatch
+		want    patchTarget
+		wantErr bool
+	}{
+		{
+			name:  "replace value",
+			patch: parser.MergePatch{"title": "New Title"},
+			want: patchTarget{ID: 1, Title: "New Title", Description: "Description", Tags: []string{"work"},
+				Schedule: current.Schedule, Reminder: current.Reminder},
+		},
+		{
+			name:  "null clears field",
+			patch: parser.MergePatch{"description": nil, "tags": nil},
+			want:  patchTarget{ID: 1, Title: "Title", Schedule: current.Schedule, Reminder: current.Reminder},
+		},
+		{
+			name:  "nested object is merged",
+			patch: parser.MergePatch{"schedule": map[string]interface{}{"time": "10:00"}},
+			want: patchTarget{ID: 1, Title: "Title", Description: "Description", Tags: []string{"work"},
+				Schedule: patchSchedule{Day: "monday", Time: "10:00"}, Reminder: current.Reminder},
+		},
+		{
+			name:  "null clears nested member",
+			patch: parser.MergePatch{"schedule": map[string]interface{}{"day": nil}},
+			want: patchTarget{ID: 1, Title: "Title", Description: "Description", Tags: []string{"work"},
+				Schedule: patchSchedule{Time: "09:00"}, Reminder: current.Reminder},
+		},
+		{
+			name:  "explicit null on nullable field",
+			patch: parser.MergePatch{"reminder": parser.JSONNull},
+			want: patchTarget{ID: 1, Title: "Title", Description: "Description", Tags: []string{"work"},
+				Schedule: current.Schedule},
+		},
+		{
+			name:    "explicit null on non-nullable field",
+			patch:   parser.MergePatch{"title": parser.JSONNull},
+			wantErr: true,
+		},
+		{
+			name:    "explicit null on non-nullable nested field",
+			patch:   parser.MergePatch{"schedule": map[string]interface{}{"day": parser.JSONNull}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown member",
+			patch:   parser.MergePatch{"titel": "Typo"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown nested member",
+			patch:   parser.MergePatch{"schedule": map[string]interface{}{"month": "may"}},
+			wantErr: true,
+		},
+		{
+			name:    "read-only field",
+			patch:   parser.MergePatch{"id": 2},
+			wantErr: true,
+		},
+		{
+			name:    "read-only field set to null",
+			patch:   parser.MergePatch{"id": nil},
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			patch:   parser.MergePatch{"title": 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := current
+			target.Tags = append([]string(nil), current.Tags...)
+
+			err := tt.patch.Apply(&target)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, current, target, "target must be left unchanged")
+
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, target)
+		})
+	}
+}
+
+func TestMergePatchApplyInvalidTarget(t *testing.T) {
+	patch := parser.MergePatch{"title": "Title"}
+
+	assert.Error(t, patch.Apply(patchTarget{}))
+	assert.Error(t, patch.Apply((*patchTarget)(nil)))
+}
+
+func TestMergePatchStructFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		patch  parser.MergePatch
+		target interface{}
+		want   []string
+	}{
+		{"json tag name", parser.MergePatch{"title": "Title", "description": nil}, patchTarget{}, []string{"Title", "Description"}},
+		{"pointer target", parser.MergePatch{"schedule": map[string]interface{}{"day": "monday"}}, &patchTarget{}, []string{"Schedule"}},
+		{"unknown member is skipped", parser.MergePatch{"titel": "Typo"}, patchTarget{}, nil},
+		{"field without json tag", parser.MergePatch{"Day": "monday"}, struct{ Day string }{}, []string{"Day"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.patch.StructFields(tt.target))
+		})
+	}
+}
+
+func TestMergePatchFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch parser.MergePatch
+		want  []string
+	}{
+		{"sorted", parser.MergePatch{"title": "Title", "doing_at": "2024-01-01", "description": nil}, []string{"description", "doing_at", "title"}},
+		{"top level only", parser.MergePatch{"schedule": map[string]interface{}{"day": "monday"}}, []string{"schedule"}},
+		{"empty", parser.MergePatch{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.patch.Fields())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -48,6 +49,10 @@ type Parser interface {
 	ParserBodyWithIntIDPathParamsAndUserID(c *fiber.Ctx, req WithPathIDAndUserID) error
 
 	ParseQueryParams(c *fiber.Ctx, req QueryParamsRequest) error
+
+	// ParserPatchRequest parses the request body as JSON Merge Patch (RFC 7396), body with Content-Type
+	// application/json-patch+json is parsed as JSON Patch (RFC 6902) and converted into merge patch.
+	ParserPatchRequest(c *fiber.Ctx) (MergePatch, error)
 }
 
 type RequestParser struct {
@@ -141,3 +146,25 @@ func (p *RequestParser) ParseQueryParams(c *fiber.Ctx, req QueryParamsRequest) e
 
 	return nil
 }
+
+// Get Request Body as merge patch, a patch without any member (ex. {} or []) is rejected
+func (p *RequestParser) ParserPatchRequest(c *fiber.Ctx) (MergePatch, error) {
+	if len(c.Body()) == 0 {
+		return nil, apperr.ErrEmptyPatch()
+	}
+
+	decode := DecodeMergePatch
+	if strings.HasPrefix(string(c.Request().Header.ContentType()), JSONPatchContentType) {
+		decode = DecodeJSONPatch
+	}
+
+	document, err := decode(c.Body())
+	if err != nil {
+		return nil, apperr.ErrInvalidRequest()
+	}
+	if len(document) == 0 {
+		return nil, apperr.ErrEmptyPatch()
+	}
+
+	return document, nil
+}
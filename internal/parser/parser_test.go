@@ -0,0 +1,48 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestParserPatchRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        parser.MergePatch
+		wantErr     error
+	}{
+		{"merge patch", parser.MergePatchContentType, `{"description": null}`, parser.MergePatch{"description": nil}, nil},
+		{"json patch", parser.JSONPatchContentType, `[{"op": "replace", "path": "/title", "value": "New"}]`, parser.MergePatch{"title": "New"}, nil},
+		{"json patch replace with null", parser.JSONPatchContentType, `[{"op": "replace", "path": "/title", "value": null}]`, parser.MergePatch{"title": parser.JSONNull}, nil},
+		{"json patch remove", parser.JSONPatchContentType, `[{"op": "remove", "path": "/description"}]`, parser.MergePatch{"description": nil}, nil},
+		{"json patch nested member", parser.JSONPatchContentType, `[{"op": "add", "path": "/schedule/day", "value": "monday"}]`, parser.MergePatch{"schedule": map[string]interface{}{"day": "monday"}}, nil},
+		{"json patch without value", parser.JSONPatchContentType, `[{"op": "replace", "path": "/title"}]`, nil, apperr.ErrInvalidRequest()},
+		{"json patch array index", parser.JSONPatchContentType, `[{"op": "add", "path": "/tags/0", "value": "work"}]`, nil, apperr.ErrInvalidRequest()},
+		{"json patch unsupported op", parser.JSONPatchContentType, `[{"op": "move", "from": "/title", "path": "/description"}]`, nil, apperr.ErrInvalidRequest()},
+		{"empty body", parser.MergePatchContentType, ``, nil, apperr.ErrEmptyPatch()},
+		{"empty merge patch", parser.MergePatchContentType, `{}`, nil, apperr.ErrEmptyPatch()},
+		{"empty json patch", parser.JSONPatchContentType, `[]`, nil, apperr.ErrEmptyPatch()},
+		{"invalid body", parser.MergePatchContentType, `[1]`, nil, apperr.ErrInvalidRequest()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			c := app.AcquireCtx(&fasthttp.RequestCtx{})
+			defer app.ReleaseCtx(c)
+
+			c.Request().Header.SetContentType(tt.contentType)
+			c.Request().SetBodyString(tt.body)
+
+			got, err := parser.NewParser().ParserPatchRequest(c)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
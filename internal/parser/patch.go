@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+// JSONPatchOperation is a single operation of JSON Patch document (RFC 6902)
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// DecodeMergePatch decodes request body into merge patch, the document must be a JSON object
+func DecodeMergePatch(body []byte) (MergePatch, error) {
+	var document MergePatch
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, fmt.Errorf("merge patch must be a JSON object")
+	}
+
+	return document, nil
+}
+
+// DecodeJSONPatch decodes JSON Patch document and converts it into merge patch.
+// Only add, replace and remove on object members are supported, array indexes can not be
+// expressed as merge patch so they are rejected together with move, copy and test operations.
+// add and replace with null value are kept as JSONNull so they are not mistaken for remove
+func DecodeJSONPatch(body []byte) (MergePatch, error) {
+	var operations []JSONPatchOperation
+	if err := json.Unmarshal(body, &operations); err != nil {
+		return nil, err
+	}
+
+	document := MergePatch{}
+	for i, op := range operations {
+		keys, err := splitJSONPointer(op.Path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		var value interface{}
+		switch op.Op {
+		case "add", "replace":
+			if len(op.Value) == 0 {
+				return nil, fmt.Errorf("operation %d: value is required", i)
+			}
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return nil, fmt.Errorf("operation %d: %w", i, err)
+			}
+			if value == nil {
+				value = JSONNull
+			}
+		case "remove":
+			value = nil
+		default:
+			return nil, fmt.Errorf("operation %d: op %q is not supported", i, op.Op)
+		}
+
+		// Walk down to the parent member, creating nested objects along the way
+		parent := map[string]interface{}(document)
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := parent[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[key] = child
+			}
+			parent = child
+		}
+		parent[keys[len(keys)-1]] = value
+	}
+
+	return document, nil
+}
+
+// splitJSONPointer splits JSON Pointer (RFC 6901) into unescaped reference tokens
+func splitJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" || !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path %q must point to an object member", pointer)
+	}
+
+	keys := strings.Split(pointer[1:], "/")
+	for i, key := range keys {
+		if key == "-" || (key != "" && strings.Trim(key, "0123456789") == "") {
+			return nil, fmt.Errorf("path %q: array index is not supported", pointer)
+		}
+		keys[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(key)
+	}
+
+	return keys, nil
+}
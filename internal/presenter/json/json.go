@@ -1,11 +1,6 @@
 package json
 
 import (
-	"encoding/json"
-	"errors"
-	"net/http"
-	"strings"
-
 	"github.com/rahmatrdn/go-skeleton/entity"
 	apperr "github.com/rahmatrdn/go-skeleton/error"
 
@@ -14,6 +9,14 @@ import (
 
 type Json struct{}
 
+// exposeInternalErrors sends the cause of internal errors to clients, keep it disabled in production
+var exposeInternalErrors bool
+
+// ExposeInternalErrors configures whether BuildError sends the cause of internal errors instead of a general message
+func ExposeInternalErrors(expose bool) {
+	exposeInternalErrors = expose
+}
+
 // NewPresenter initialize new JSON presenter that used to hold logic for presenter logic
 func NewJsonPresenter() *Json {
 	return &Json{}
@@ -41,42 +44,8 @@ func (p *Json) BuildSuccess(c *fiber.Ctx, data interface{}, message string, code
 	return c.JSON(response)
 }
 
+// BuildError writes err with the HTTP status of its kind (see apperr.Kind), errors that are not apperr.Error nor
+// apperr.CustomErrorResponse are internal errors
 func (p *Json) BuildError(c *fiber.Ctx, err error) error {
-	unwrappedErr := errors.Unwrap(err)
-
-	if unwrappedErr != nil {
-		errorData := strings.Split(unwrappedErr.Error(), "XX: ")
-
-		if len(errorData) < 2 {
-			return c.Status(apperr.ErrGeneralInvalid().HTTPCode).
-				JSON(apperr.CustomError(err.Error(),
-					entity.BAD_REQUEST_CODE,
-					http.StatusUnprocessableEntity))
-		}
-
-		errorCode := errorData[1]
-		errorMessage := errorData[0]
-
-		// Handle error struct validation
-		if errorCode == entity.INVALID_PAYLOAD_CODE {
-			var errResponse []entity.ErrorResponse
-			json.Unmarshal([]byte(errorMessage), &errResponse)
-
-			return c.Status(apperr.ErrGeneralInvalid().HTTPCode).
-				JSON(
-					apperr.ErrInvalidPayload(errResponse),
-				)
-		}
-	}
-
-	switch err := err.(type) {
-	case apperr.CustomErrorResponse:
-		httpCode := err.HTTPCode
-		return c.Status(httpCode).JSON(err)
-	default:
-		return c.Status(apperr.ErrGeneralInvalid().HTTPCode).
-			JSON(apperr.CustomError(err.Error(),
-				entity.BAD_REQUEST_CODE,
-				http.StatusUnprocessableEntity))
-	}
+	return WriteErrorWithMeta(c, apperr.ResponseOf(err, exposeInternalErrors))
 }
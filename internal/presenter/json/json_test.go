@@ -0,0 +1,97 @@
+package json_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	presenter "github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildError(t *testing.T) {
+	presenter.UseProblemDetails(false, "")
+	defer presenter.ExposeInternalErrors(false)
+
+	testcases := []struct {
+		name        string
+		err         error
+		expose      bool
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "Validation",
+			err:         errwrap.Wrap(apperr.InvalidField("title", "required", "", "title is required"), "Usecase.Create"),
+			wantStatus:  fiber.StatusUnprocessableEntity,
+			wantCode:    entity.INVALID_PAYLOAD_CODE,
+			wantMessage: entity.INVALID_PAYLOAD_MSG,
+		},
+		{
+			name:        "Not Found",
+			err:         apperr.NotFound(entity.DATA_NOT_FOUND_MSG).Wrap(errors.New("record not found")),
+			wantStatus:  fiber.StatusNotFound,
+			wantCode:    entity.BAD_REQUEST_CODE,
+			wantMessage: entity.DATA_NOT_FOUND_MSG,
+		},
+		{
+			name:        "Forbidden",
+			err:         apperr.Forbidden(entity.FORBIDDEN_MSG),
+			wantStatus:  fiber.StatusForbidden,
+			wantCode:    entity.FORBIDDEN_CODE,
+			wantMessage: entity.FORBIDDEN_MSG,
+		},
+		{
+			name:        "Wrapped Custom Error",
+			err:         errwrap.Wrap(apperr.ErrPreconditionFailed(), "Usecase.Update"),
+			wantStatus:  fiber.StatusPreconditionFailed,
+			wantCode:    entity.PRECONDITION_CODE,
+			wantMessage: entity.PRECONDITION_MSG,
+		},
+		{
+			name:        "Internal Hidden",
+			err:         errwrap.Wrap(errors.New("dial tcp: connection refused"), "Repository.GetByID"),
+			wantStatus:  fiber.StatusInternalServerError,
+			wantCode:    entity.INTERNAL_ERROR_CODE,
+			wantMessage: entity.GENERAL_ERROR_MESSAGE,
+		},
+		{
+			name:        "Internal Exposed",
+			err:         errwrap.Wrap(errors.New("dial tcp: connection refused"), "Repository.GetByID"),
+			expose:      true,
+			wantStatus:  fiber.StatusInternalServerError,
+			wantCode:    entity.INTERNAL_ERROR_CODE,
+			wantMessage: "Repository.GetByID: dial tcp: connection refused",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			presenter.ExposeInternalErrors(tt.expose)
+
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				return presenter.NewJsonPresenter().BuildError(c, tt.err)
+			})
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			assert.NoError(t, err)
+
+			raw, _ := io.ReadAll(resp.Body)
+			var body apperr.CustomErrorResponseWithMeta
+			assert.NoError(t, json.Unmarshal(raw, &body))
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantStatus, body.HTTPCode)
+			assert.Equal(t, tt.wantCode, body.ErrCode)
+			assert.Equal(t, tt.wantMessage, body.Message)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package json
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+var (
+	// problemByDefault writes every error as problem+json, otherwise only requests accepting it get it
+	problemByDefault bool
+	// problemTypeBaseURL prefixes the error code in type of problem+json, type is about:blank when it is empty
+	problemTypeBaseURL string
+)
+
+// UseProblemDetails configures the error format, errors are written as application/problem+json by default
+// when byDefault is true. typeBaseURL (ex. https://api.example.com/problems) is the base of the type URI,
+// call it before serving requests
+func UseProblemDetails(byDefault bool, typeBaseURL string) {
+	problemByDefault = byDefault
+	problemTypeBaseURL = strings.TrimSuffix(typeBaseURL, "/")
+}
+
+// AcceptsProblem reports whether the error of the request is written as problem+json, ex. requests sent with
+// Accept: application/problem+json. The legacy format is kept for existing clients unless configured otherwise
+func AcceptsProblem(c *fiber.Ctx) bool {
+	return problemByDefault || strings.Contains(c.Get(fiber.HeaderAccept), MIMEApplicationProblemJSON)
+}
+
+// WriteError writes err with its HTTP status in the format of the request (see AcceptsProblem), it is meant for
+// middlewares that reply without presenter
+func WriteError(c *fiber.Ctx, err apperr.CustomErrorResponse) error {
+	if !AcceptsProblem(c) {
+		return c.Status(err.HTTPCode).JSON(err)
+	}
+
+	return writeProblem(c, err.HTTPCode, err.ErrCode, err.Message, nil)
+}
+
+// WriteErrorWithMeta writes err like WriteError, field violations of Meta are the errors extension of problem+json
+func WriteErrorWithMeta(c *fiber.Ctx, err apperr.CustomErrorResponseWithMeta) error {
+	if !AcceptsProblem(c) {
+		return c.Status(err.HTTPCode).JSON(err)
+	}
+
+	return writeProblem(c, err.HTTPCode, err.ErrCode, err.Message, err.Meta)
+}
+
+func writeProblem(c *fiber.Ctx, status int, code string, detail string, errs []entity.ErrorResponse) error {
+	problemType := "about:blank"
+	if problemTypeBaseURL != "" && code != "" {
+		problemType = problemTypeBaseURL + "/" + code
+	}
+
+	c.Vary(fiber.HeaderAccept)
+
+	return c.Status(status).JSON(entity.ProblemDetails{
+		Type:      problemType,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  c.Path(),
+		Code:      code,
+		RequestID: requestid.FromContext(c.Context()),
+		Errors:    errs,
+	}, MIMEApplicationProblemJSON)
+}
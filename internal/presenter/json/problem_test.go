@@ -0,0 +1,104 @@
+package json_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	presenter "github.com/rahmatrdn/go-skeleton/internal/presenter/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProblemTestSuite struct {
+	suite.Suite
+	app *fiber.App
+}
+
+func TestProblem(t *testing.T) {
+	suite.Run(t, new(ProblemTestSuite))
+}
+
+func (s *ProblemTestSuite) SetupTest() {
+	presenter.UseProblemDetails(false, "")
+
+	p := presenter.NewJsonPresenter()
+	s.app = fiber.New()
+	s.app.Get("/not-found", func(c *fiber.Ctx) error {
+		return p.BuildError(c, apperr.ErrRecordNotFound())
+	})
+	s.app.Get("/invalid", func(c *fiber.Ctx) error {
+		return p.BuildError(c, apperr.InvalidField("title", "required", "", "title is required"))
+	})
+	s.app.Get("/error", func(c *fiber.Ctx) error {
+		return p.BuildError(c, errors.New("something failed"))
+	})
+}
+
+func (s *ProblemTestSuite) request(path string, accept string) (int, string, map[string]interface{}) {
+	req := httptest.NewRequest(fiber.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set(fiber.HeaderAccept, accept)
+	}
+
+	resp, err := s.app.Test(req)
+	s.Require().NoError(err)
+
+	body, _ := io.ReadAll(resp.Body)
+	var result map[string]interface{}
+	s.Require().NoError(json.Unmarshal(body, &result))
+
+	return resp.StatusCode, resp.Header.Get(fiber.HeaderContentType), result
+}
+
+func (s *ProblemTestSuite) TestLegacy() {
+	status, contentType, body := s.request("/not-found", fiber.MIMEApplicationJSON)
+
+	s.Equal(fiber.StatusNotFound, status)
+	s.Equal(fiber.MIMEApplicationJSON, contentType)
+	s.Equal(entity.DATA_NOT_FOUND_MSG, body["message"])
+	s.EqualValues(fiber.StatusNotFound, body["http_code"])
+	s.NotContains(body, "type")
+}
+
+func (s *ProblemTestSuite) TestAccept() {
+	status, contentType, body := s.request("/not-found", presenter.MIMEApplicationProblemJSON)
+
+	s.Equal(fiber.StatusNotFound, status)
+	s.Equal(presenter.MIMEApplicationProblemJSON, contentType)
+	s.Equal("about:blank", body["type"])
+	s.Equal("Not Found", body["title"])
+	s.EqualValues(fiber.StatusNotFound, body["status"])
+	s.Equal(entity.DATA_NOT_FOUND_MSG, body["detail"])
+	s.Equal("/not-found", body["instance"])
+}
+
+func (s *ProblemTestSuite) TestValidationErrors() {
+	presenter.UseProblemDetails(true, "https://api.example.com/problems/")
+
+	status, contentType, body := s.request("/invalid", "")
+
+	s.Equal(fiber.StatusUnprocessableEntity, status)
+	s.Equal(presenter.MIMEApplicationProblemJSON, contentType)
+	s.Equal("https://api.example.com/problems/"+entity.INVALID_PAYLOAD_CODE, body["type"])
+	s.Equal(entity.INVALID_PAYLOAD_CODE, body["code"])
+	s.Equal([]interface{}{map[string]interface{}{
+		"failed_field": "title",
+		"tag":          "required",
+		"value":        "",
+		"message":      "title is required",
+	}}, body["errors"])
+}
+
+func (s *ProblemTestSuite) TestInternalError() {
+	presenter.UseProblemDetails(true, "")
+
+	status, _, body := s.request("/error", "")
+
+	s.Equal(fiber.StatusInternalServerError, status)
+	s.Equal(entity.GENERAL_ERROR_MESSAGE, body["detail"])
+}
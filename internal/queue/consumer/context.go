@@ -0,0 +1,14 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
+)
+
+// messageContext returns ctx with the request ID, language and trace context the message was published with
+func messageContext(ctx context.Context, payload map[string]interface{}) context.Context {
+	return tracing.FromPayload(i18n.FromPayload(requestid.FromPayload(ctx, payload), payload), payload)
+}
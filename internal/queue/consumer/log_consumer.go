@@ -36,7 +36,7 @@ func (l *LogQueue) ProcessSyncLog(payload map[string]interface{}) error {
 		executionTime = params.LogFields["execution_time"]
 	}
 
-	err := l.logMongoRepo.Create(l.ctx, moentity.LogCollection{
+	err := l.logMongoRepo.Create(messageContext(l.ctx, payload), moentity.LogCollection{
 		Status:        string(params.Status),
 		FuncName:      params.FuncName,
 		ErrorMessage:  params.ErrorMessage,
@@ -44,6 +44,7 @@ func (l *LogQueue) ProcessSyncLog(payload map[string]interface{}) error {
 		LogFields:     params.LogFields,
 		Created:       time.Now().UTC().Add(7 * time.Hour),
 		ExecutionTime: helper.ToInt(executionTime),
+		RequestID:     params.RequestID,
 	})
 
 	if err != nil {
@@ -0,0 +1,33 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+)
+
+type NotificationQueue struct {
+	ctx                     context.Context
+	sendNotificationUsecase notification_usecase.ISendNotificationUsecase
+}
+
+type NotificationConsumer interface {
+	ProcessSend(payload map[string]interface{}) error
+}
+
+func NewNotificationConsumer(
+	ctx context.Context,
+	sendNotificationUsecase notification_usecase.ISendNotificationUsecase,
+) NotificationConsumer {
+	return &NotificationQueue{ctx, sendNotificationUsecase}
+}
+
+func (l *NotificationQueue) ProcessSend(payload map[string]interface{}) error {
+	var params notification.Message
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	return l.sendNotificationUsecase.Send(messageContext(l.ctx, payload), params)
+}
@@ -0,0 +1,33 @@
+package consumer
+
+import (
+	"context"
+
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+)
+
+type ReminderQueue struct {
+	ctx                     context.Context
+	deliveryReminderUsecase reminder_usecase.IDeliveryReminderUsecase
+}
+
+type ReminderConsumer interface {
+	ProcessReminder(payload map[string]interface{}) error
+}
+
+func NewReminderConsumer(
+	ctx context.Context,
+	deliveryReminderUsecase reminder_usecase.IDeliveryReminderUsecase,
+) ReminderConsumer {
+	return &ReminderQueue{ctx, deliveryReminderUsecase}
+}
+
+func (l *ReminderQueue) ProcessReminder(payload map[string]interface{}) error {
+	var params entity.ReminderMessage
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	return l.deliveryReminderUsecase.Send(messageContext(l.ctx, payload), params)
+}
@@ -0,0 +1,30 @@
+package consumer
+
+import (
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+)
+
+type StreamQueue struct {
+	publisher realtime.Publisher
+}
+
+type StreamConsumer interface {
+	ProcessEvent(payload map[string]interface{}) error
+}
+
+func NewStreamConsumer(publisher realtime.Publisher) StreamConsumer {
+	return &StreamQueue{publisher}
+}
+
+// ProcessEvent pushes todo list event received through RabbitMQ fanout to subscribers connected to this instance
+func (l *StreamQueue) ProcessEvent(payload map[string]interface{}) error {
+	var params entity.Event
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	l.publisher.Publish(params)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package consumer
+
+import (
+	"context"
+
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+type TodoListImportQueue struct {
+	ctx                   context.Context
+	importTodoListUsecase todo_list_usecase.IImportTodoListUsecase
+}
+
+type TodoListImportConsumer interface {
+	ProcessImport(payload map[string]interface{}) error
+}
+
+func NewTodoListImportConsumer(
+	ctx context.Context,
+	importTodoListUsecase todo_list_usecase.IImportTodoListUsecase,
+) TodoListImportConsumer {
+	return &TodoListImportQueue{ctx, importTodoListUsecase}
+}
+
+func (l *TodoListImportQueue) ProcessImport(payload map[string]interface{}) error {
+	var params entity.ImportTodoListMessage
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	return l.importTodoListUsecase.ProcessQueuedImport(messageContext(l.ctx, payload), params)
+}
@@ -0,0 +1,45 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
+	webhookEntity "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook/entity"
+)
+
+type WebhookQueue struct {
+	ctx                    context.Context
+	deliveryWebhookUsecase webhook_usecase.IDeliveryWebhookUsecase
+}
+
+type WebhookConsumer interface {
+	ProcessEvent(payload map[string]interface{}) error
+	ProcessDelivery(payload map[string]interface{}) error
+}
+
+func NewWebhookConsumer(
+	ctx context.Context,
+	deliveryWebhookUsecase webhook_usecase.IDeliveryWebhookUsecase,
+) WebhookConsumer {
+	return &WebhookQueue{ctx, deliveryWebhookUsecase}
+}
+
+// ProcessEvent fans out todo list event into deliveries of subscribed webhooks
+func (l *WebhookQueue) ProcessEvent(payload map[string]interface{}) error {
+	var params entity.Event
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	return l.deliveryWebhookUsecase.Dispatch(messageContext(l.ctx, payload), params)
+}
+
+func (l *WebhookQueue) ProcessDelivery(payload map[string]interface{}) error {
+	var params webhookEntity.WebhookDeliveryMessage
+	if err := params.LoadFromMap(payload); err != nil {
+		return err
+	}
+
+	return l.deliveryWebhookUsecase.Deliver(messageContext(l.ctx, payload), params.DeliveryID)
+}
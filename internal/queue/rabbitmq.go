@@ -6,8 +6,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/metrics"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Queue interface {
@@ -17,6 +26,7 @@ type Queue interface {
 	Reconnect() error
 	HandleConsumedDeliveries(key string, handle func(payload map[string]interface{}) error)
 	Publish(key string, message []byte, attempts int32) error
+	PublishWithContext(ctx context.Context, key string, message []byte, attempts int32) error
 }
 
 type MessageBody struct {
@@ -99,6 +109,11 @@ func (c *RabbitMQ) Reconnect() error {
 	return nil
 }
 
+// IsConnected reports whether the connection and channel are open, it is used by the readiness probe
+func (c *RabbitMQ) IsConnected() bool {
+	return c.conn != nil && !c.conn.IsClosed() && c.channel != nil && !c.channel.IsClosed()
+}
+
 // Consumer Things
 func (c *RabbitMQ) consume(key string) (<-chan amqp.Delivery, error) {
 	q, err := c.BindQueue(key)
@@ -137,8 +152,59 @@ func (c *RabbitMQ) HandleConsumedDeliveries(key string, handle func(payload map[
 	}
 }
 
+// HandleBroadcastDeliveries consumes key through an exclusive queue owned by this process (deleted when
+// the connection is closed), so every process bound to the key receives its own copy of each message,
+// ex. todo list events fanned out to every API replica. Messages are not retried when handle fails
+func (c *RabbitMQ) HandleBroadcastDeliveries(key string, handle func(payload map[string]interface{}) error) {
+	delivery, err := c.consumeBroadcast(key)
+	if err != nil {
+		panic(err)
+	}
+
+	for {
+		go broadcastHandler(key, delivery, handle)
+		if err := <-c.Err; err != nil {
+			fmt.Println(fmt.Sprintf("[BROADCAST] RabbitMQ connection closed: %s", err.Error()))
+
+			c.Reconnect()
+			deliveries, err := c.consumeBroadcast(key)
+			if err != nil {
+				panic(err)
+			}
+			delivery = deliveries
+		}
+	}
+}
+
+func (c *RabbitMQ) consumeBroadcast(key string) (<-chan amqp.Delivery, error) {
+	q, err := c.channel.QueueDeclare("", false, true, true, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.channel.QueueBind(q.Name, key, c.Exchange, false, nil); err != nil {
+		return nil, err
+	}
+
+	consumerTag := fmt.Sprintf("ctag:%s", q.Name)
+	c.consumerTags[consumerTag] = true
+
+	return c.channel.Consume(q.Name, consumerTag, true, true, false, false, nil)
+}
+
 // Publisher Things
 func (c *RabbitMQ) Publish(key string, message []byte, attempts int32) error {
+	return c.PublishWithContext(c.Ctx, key, message, attempts)
+}
+
+// PublishWithContext publishes like Publish and forwards the request ID stored in ctx (if any) as the
+// x-request-id header and the trace context as traceparent header, so the consumer can restore them
+func (c *RabbitMQ) PublishWithContext(ctx context.Context, key string, message []byte, attempts int32) (err error) {
+	ctx, span := tracing.Start(ctx, key+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messagingAttributes(key, semconv.MessagingOperationTypePublish)...),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	if attempts > int32(c.RetryCount) {
 		fmt.Println(fmt.Sprintf("[PUBLISHER] Too many attempts: %s", key))
 		return nil
@@ -160,14 +226,20 @@ func (c *RabbitMQ) Publish(key string, message []byte, attempts int32) error {
 			"x-attempts": attempts,
 		},
 	}
+	if id := requestid.FromContext(ctx); id != "" {
+		p.Headers[requestid.AMQPHeader] = id
+	}
+	tracing.Inject(ctx, tracing.AMQPHeaders(p.Headers))
 
 	fmt.Println(fmt.Sprintf("[PUBLISHER] Publishing message: %s - %d", key, attempts))
 
-	if err := c.channel.PublishWithContext(c.Ctx, c.Exchange, key, false, false, p); err != nil {
+	err = c.channel.PublishWithContext(c.Ctx, c.Exchange, key, false, false, p)
+	metrics.ObservePublished(key, err)
+	if err != nil {
 		fmt.Println(fmt.Sprintf("[PUBLISHER] Error in publishing message: %s", err.Error()))
 
 		c.Reconnect()
-		return c.Publish(key, message, attempts+1)
+		return c.PublishWithContext(ctx, key, message, attempts+1)
 	}
 
 	fmt.Println(fmt.Sprintf("[PUBLISHER] Published message: %s - %d", key, attempts))
@@ -183,8 +255,13 @@ func handler(c RabbitMQ, key string, messages <-chan amqp.Delivery, handle func(
 			attempts = message.Headers["x-attempts"].(int32)
 		}
 
+		ctx, span := consumerSpan(c.Ctx, key, message)
 		d, _ := deserialize(message.Body)
+		withMessageContext(ctx, d)
+		start := time.Now()
 		err := handle(d)
+		metrics.ObserveConsumed(key, err, time.Since(start))
+		tracing.End(span, err)
 
 		message.Ack(false)
 
@@ -192,7 +269,8 @@ func handler(c RabbitMQ, key string, messages <-chan amqp.Delivery, handle func(
 			fmt.Println(err.Error())
 
 			if attempts < int32(c.RetryCount) {
-				c.Publish(key, message.Body, attempts+int32(1))
+				metrics.QueueRetriedTotal.WithLabelValues(key).Inc()
+				c.PublishWithContext(ctx, key, message.Body, attempts+int32(1))
 			} else {
 				fmt.Println(fmt.Sprintf("Too many attempts: %s", key))
 			}
@@ -200,6 +278,48 @@ func handler(c RabbitMQ, key string, messages <-chan amqp.Delivery, handle func(
 	}
 }
 
+// consumerSpan starts the span of processing message, as a child of the publisher span. The returned context
+// also carries the request ID of the publisher
+func consumerSpan(ctx context.Context, key string, message amqp.Delivery) (context.Context, trace.Span) {
+	if id, _ := message.Headers[requestid.AMQPHeader].(string); id != "" {
+		ctx = requestid.NewContext(ctx, id)
+	}
+	ctx = tracing.Extract(ctx, tracing.AMQPHeaders(message.Headers))
+
+	return tracing.Start(ctx, key+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(messagingAttributes(key, semconv.MessagingOperationTypeDeliver)...),
+	)
+}
+
+// withMessageContext adds the request ID, language and trace context of ctx to payload, consumers restore them
+// with requestid.FromPayload, i18n.FromPayload and tracing.FromPayload
+func withMessageContext(ctx context.Context, payload map[string]interface{}) {
+	if payload == nil {
+		return
+	}
+	if id := requestid.FromContext(ctx); id != "" {
+		payload[requestid.PayloadKey] = id
+	}
+	if language := i18n.FromContext(ctx); language != "" {
+		payload[i18n.PayloadKey] = language
+	}
+
+	carrier := propagation.MapCarrier{}
+	tracing.Inject(ctx, carrier)
+	if len(carrier) > 0 {
+		payload[tracing.PayloadKey] = carrier
+	}
+}
+
+func messagingAttributes(key string, operation attribute.KeyValue) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(key),
+		operation,
+	}
+}
+
 func deserialize(b []byte) (map[string]interface{}, error) {
 	var msg map[string]interface{}
 	buf := bytes.NewBuffer(b)
@@ -208,3 +328,18 @@ func deserialize(b []byte) (map[string]interface{}, error) {
 	err := decoder.Decode(&msg)
 	return msg, err
 }
+
+func broadcastHandler(key string, messages <-chan amqp.Delivery, handle func(payload map[string]interface{}) error) {
+	for message := range messages {
+		ctx, span := consumerSpan(context.Background(), key, message)
+		d, _ := deserialize(message.Body)
+		withMessageContext(ctx, d)
+		start := time.Now()
+		err := handle(d)
+		metrics.ObserveConsumed(key, err, time.Since(start))
+		tracing.End(span, err)
+		if err != nil {
+			fmt.Println(fmt.Sprintf("[BROADCAST] Error in handling message %s: %s", key, err.Error()))
+		}
+	}
+}
@@ -3,4 +3,12 @@ package queue
 var (
 	ProcessSyncLog = "log.insert"
 	ProcessExample = "example.consumer"
+
+	ProcessTodoListImport = "todo_list.import"
+	ProcessTodoListEvent  = "todo_list.event"
+	ProcessTodoReminder   = "todo.reminder"
+
+	ProcessWebhookDelivery = "webhook.delivery"
+
+	ProcessNotificationSend = "notification.send"
 )
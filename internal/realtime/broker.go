@@ -0,0 +1,165 @@
+package realtime
+
+import (
+	"sync"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+const (
+	// subscriptionBuffer is the number of events buffered per subscriber, events are dropped
+	// for a subscriber that is not reading fast enough instead of blocking the publisher
+	subscriptionBuffer = 64
+	// recentEventLimit is the number of event IDs remembered to drop duplicates (ex. own event received back through fanout)
+	recentEventLimit = 1024
+)
+
+// Publisher publishes event to the subscribers of the event user
+type Publisher interface {
+	Publish(event entity.Event)
+}
+
+// Subscriber subscribes to the events of a user
+type Subscriber interface {
+	Subscribe(userID int64) *Subscription
+}
+
+// Broker is an in-process pub/sub of user events for a single node,
+// events of other nodes are received through RabbitMQ fanout (see FanoutHandler)
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[int64]map[*Subscription]struct{}
+	closed      bool
+
+	recentMu  sync.Mutex
+	recentIDs map[string]struct{}
+	recent    []string
+	recentPos int
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: make(map[int64]map[*Subscription]struct{}),
+		recentIDs:   make(map[string]struct{}, recentEventLimit),
+		recent:      make([]string, recentEventLimit),
+	}
+}
+
+// Subscription receives events of a user until it is closed or the broker is closed
+type Subscription struct {
+	UserID int64
+
+	broker *Broker
+	events chan entity.Event
+	once   sync.Once
+}
+
+// Events returns channel of events, it is closed when the subscription or broker is closed
+func (s *Subscription) Events() <-chan entity.Event {
+	return s.events
+}
+
+// Close unsubscribes from the broker, it is safe to call more than once
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
+
+func (b *Broker) Subscribe(userID int64) *Subscription {
+	sub := &Subscription{
+		UserID: userID,
+		broker: b,
+		events: make(chan entity.Event, subscriptionBuffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(sub.events)
+		return sub
+	}
+
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+	b.subscribers[userID][sub] = struct{}{}
+
+	return sub
+}
+
+// Publish sends event to the subscribers of event user, an event ID that was already published is ignored
+func (b *Broker) Publish(event entity.Event) {
+	if b.seen(event.ID) {
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers[event.UserID] {
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
+
+// SubscriberCount returns the number of active subscriptions of a user
+func (b *Broker) SubscriberCount(userID int64) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subscribers[userID])
+}
+
+// Close closes all subscriptions so open streams end, called before the server is shut down
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+
+	for _, subs := range b.subscribers {
+		for sub := range subs {
+			sub.once.Do(func() { close(sub.events) })
+		}
+	}
+	b.subscribers = make(map[int64]map[*Subscription]struct{})
+}
+
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if subs, ok := b.subscribers[sub.UserID]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(b.subscribers, sub.UserID)
+		}
+	}
+	sub.once.Do(func() { close(sub.events) })
+}
+
+// seen records event ID and reports whether it was recorded before, only the last recentEventLimit IDs are kept
+func (b *Broker) seen(eventID string) bool {
+	if eventID == "" {
+		return false
+	}
+
+	b.recentMu.Lock()
+	defer b.recentMu.Unlock()
+
+	if _, ok := b.recentIDs[eventID]; ok {
+		return true
+	}
+
+	delete(b.recentIDs, b.recent[b.recentPos])
+	b.recent[b.recentPos] = eventID
+	b.recentIDs[eventID] = struct{}{}
+	b.recentPos = (b.recentPos + 1) % recentEventLimit
+
+	return false
+}
@@ -0,0 +1,90 @@
+package realtime_test
+
+import (
+	"testing"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+	"github.com/stretchr/testify/suite"
+)
+
+type BrokerTestSuite struct {
+	suite.Suite
+	broker *realtime.Broker
+}
+
+func (s *BrokerTestSuite) SetupTest() {
+	s.broker = realtime.NewBroker()
+}
+
+func TestBroker(t *testing.T) {
+	suite.Run(t, new(BrokerTestSuite))
+}
+
+func (s *BrokerTestSuite) TestPublish() {
+	sub := s.broker.Subscribe(1)
+	other := s.broker.Subscribe(2)
+	defer sub.Close()
+	defer other.Close()
+
+	s.broker.Publish(entity.Event{ID: "evt-1", Type: entity.EventTodoListCreated, UserID: 1})
+	// Duplicate event (ex. own event received back through fanout) is ignored
+	s.broker.Publish(entity.Event{ID: "evt-1", Type: entity.EventTodoListCreated, UserID: 1})
+	s.broker.Publish(entity.Event{ID: "evt-2", Type: entity.EventTodoListDeleted, UserID: 1})
+
+	s.Require().Len(sub.Events(), 2)
+	s.Equal("evt-1", (<-sub.Events()).ID)
+	s.Equal("evt-2", (<-sub.Events()).ID)
+	s.Empty(other.Events())
+}
+
+func (s *BrokerTestSuite) TestPublishSlowSubscriber() {
+	sub := s.broker.Subscribe(1)
+	defer sub.Close()
+
+	for i := 0; i < 100; i++ {
+		s.broker.Publish(entity.Event{Type: entity.EventTodoListUpdated, UserID: 1})
+	}
+
+	s.Equal(64, len(sub.Events()))
+}
+
+func (s *BrokerTestSuite) TestClose() {
+	sub := s.broker.Subscribe(1)
+	s.Equal(1, s.broker.SubscriberCount(1))
+
+	sub.Close()
+	sub.Close()
+	s.Equal(0, s.broker.SubscriberCount(1))
+	_, ok := <-sub.Events()
+	s.False(ok)
+
+	open := s.broker.Subscribe(1)
+	s.broker.Close()
+	_, ok = <-open.Events()
+	s.False(ok)
+	open.Close()
+
+	// Subscribing after the broker is closed returns closed subscription
+	_, ok = <-s.broker.Subscribe(1).Events()
+	s.False(ok)
+}
+
+func (s *BrokerTestSuite) TestPublishers() {
+	sub := s.broker.Subscribe(1)
+	defer sub.Close()
+
+	var received []string
+	publisher := realtime.Publishers{
+		s.broker,
+		nil,
+		realtime.PublisherFunc(func(event entity.Event) {
+			received = append(received, event.ID)
+		}),
+	}
+
+	publisher.Publish(entity.Event{ID: "evt-1", Type: entity.EventTodoListUpdated, UserID: 1})
+
+	s.Len(sub.Events(), 1)
+	s.Equal([]string{"evt-1"}, received)
+}
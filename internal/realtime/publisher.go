@@ -0,0 +1,21 @@
+package realtime
+
+import "github.com/rahmatrdn/go-skeleton/entity"
+
+// PublisherFunc adapts a function into Publisher, ex. to react on events of the same instance
+type PublisherFunc func(event entity.Event)
+
+func (f PublisherFunc) Publish(event entity.Event) {
+	f(event)
+}
+
+// Publishers publishes event to every publisher in order, nil publishers are skipped
+type Publishers []Publisher
+
+func (p Publishers) Publish(event entity.Event) {
+	for _, publisher := range p {
+		if publisher != nil {
+			publisher.Publish(event)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package realtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+)
+
+// WriteSSEEvent writes event in Server-Sent Events format, the event ID is sent as id and the event type as event
+func WriteSSEEvent(w io.Writer, event entity.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+
+	return err
+}
+
+// WriteSSERetry tells the client how long to wait before reconnecting
+func WriteSSERetry(w io.Writer, retry time.Duration) error {
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", retry.Milliseconds())
+
+	return err
+}
+
+// WriteSSEHeartbeat writes a comment line so proxies do not close idle stream
+func WriteSSEHeartbeat(w io.Writer) error {
+	_, err := io.WriteString(w, ": ping\n\n")
+
+	return err
+}
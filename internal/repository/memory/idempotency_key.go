@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+)
+
+// IdempotencyKeyRepository keeps records in process memory, it is meant for single instance
+// deployment and local development since records are not shared and lost on restart
+type IdempotencyKeyRepository struct {
+	mu        sync.Mutex
+	records   map[string]entity.IdempotencyRecord
+	nextSweep time.Time
+}
+
+func NewIdempotencyKeyRepository() *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{records: make(map[string]entity.IdempotencyRecord)}
+}
+
+// Reserve stores in progress record, it returns false when an unexpired record with the same key exists.
+// An expired record of the key is replaced, other expired records are removed at most once per minute so
+// the map does not grow without bound
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, params *entity.IdempotencyRecord) (bool, error) {
+	funcName := "IdempotencyKeyRepository.Reserve"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if !now.Before(r.nextSweep) {
+		for key, record := range r.records {
+			if !record.ExpiresAt.After(now) {
+				delete(r.records, key)
+			}
+		}
+		r.nextSweep = now.Add(time.Minute)
+	}
+
+	if record, ok := r.records[params.Key]; ok && record.ExpiresAt.After(now) {
+		return false, nil
+	}
+	r.records[params.Key] = *params
+
+	return true, nil
+}
+
+// Get returns a copy of unexpired record of the key, nil when not found
+func (r *IdempotencyKeyRepository) Get(ctx context.Context, key string) (*entity.IdempotencyRecord, error) {
+	funcName := "IdempotencyKeyRepository.Get"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.records[key]
+	if !ok || !record.ExpiresAt.After(time.Now()) {
+		return nil, nil
+	}
+
+	return &record, nil
+}
+
+// Save stores the response of reserved key
+func (r *IdempotencyKeyRepository) Save(ctx context.Context, params *entity.IdempotencyRecord) error {
+	funcName := "IdempotencyKeyRepository.Save"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records[params.Key] = *params
+
+	return nil
+}
+
+// Delete releases the key so the request can be retried
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	funcName := "IdempotencyKeyRepository.Delete"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.records, key)
+
+	return nil
+}
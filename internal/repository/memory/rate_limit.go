@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+)
+
+// rateLimitCounter is the state of a key, window fields are used by sliding window and tokens by token bucket.
+// Times are Unix milliseconds like the Redis scripts
+type rateLimitCounter struct {
+	windowStart int64
+	current     int
+	previous    int
+	tokens      float64
+	updatedAt   int64
+	expiresAt   int64
+}
+
+// RateLimitRepository keeps counters in process memory, it is meant for single instance
+// deployment and local development since every instance counts requests by itself
+type RateLimitRepository struct {
+	mu        sync.Mutex
+	counters  map[string]*rateLimitCounter
+	nextSweep int64
+}
+
+func NewRateLimitRepository() *RateLimitRepository {
+	return &RateLimitRepository{counters: make(map[string]*rateLimitCounter)}
+}
+
+// Take counts a request of key under policy and returns the state of the key after it.
+// Expired counters are removed at most once per minute so the map does not grow without bound
+func (r *RateLimitRepository) Take(ctx context.Context, key string, policy entity.RateLimitPolicy) (*entity.RateLimitResult, error) {
+	funcName := "RateLimitRepository.Take"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	window := policy.Window.Milliseconds()
+	if policy.Limit < 1 || window < 1 {
+		return nil, errwrap.Wrap(fmt.Errorf("invalid rate limit policy %q", policy.Name), funcName)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	if now >= r.nextSweep {
+		for k, counter := range r.counters {
+			if counter.expiresAt <= now {
+				delete(r.counters, k)
+			}
+		}
+		r.nextSweep = now + time.Minute.Milliseconds()
+	}
+
+	counter, ok := r.counters[key]
+	if !ok || counter.expiresAt <= now {
+		counter = &rateLimitCounter{windowStart: now - now%window, tokens: float64(policy.Limit), updatedAt: now}
+		r.counters[key] = counter
+	}
+
+	switch policy.Algorithm {
+	case entity.RateLimitTokenBucket:
+		return counter.takeToken(policy.Limit, window, now), nil
+	default:
+		return counter.takeWindow(policy.Limit, window, now), nil
+	}
+}
+
+// takeWindow estimates the requests of the last window as the requests of the current fixed window plus the
+// requests of the previous one weighted by how much it overlaps the last window
+func (c *rateLimitCounter) takeWindow(limit int, window int64, now int64) *entity.RateLimitResult {
+	start := now - now%window
+	if c.windowStart != start {
+		c.previous = 0
+		if c.windowStart == start-window {
+			c.previous = c.current
+		}
+		c.current = 0
+		c.windowStart = start
+	}
+
+	elapsed := now - start
+	count := float64(c.previous)*float64(window-elapsed)/float64(window) + float64(c.current)
+
+	if count+1 > float64(limit) {
+		var retryAfter int64
+		if c.current+1 > limit {
+			// The next window starts with current as previous, wait until enough of it slides out
+			retryAfter = start + window - now + int64(math.Ceil(float64(window)*(1-float64(limit-1)/float64(c.current))))
+		} else {
+			retryAfter = int64(math.Ceil(float64(window)*(1-float64(limit-1-c.current)/float64(c.previous)))) - elapsed
+		}
+
+		return &entity.RateLimitResult{
+			Remaining:  0,
+			Reset:      time.Duration(start+2*window-now) * time.Millisecond,
+			RetryAfter: time.Duration(max(retryAfter, 0)) * time.Millisecond,
+		}
+	}
+
+	c.current++
+	c.expiresAt = start + 2*window
+
+	return &entity.RateLimitResult{
+		Allowed:   true,
+		Remaining: int(math.Floor(float64(limit) - count - 1)),
+		Reset:     time.Duration(start+2*window-now) * time.Millisecond,
+	}
+}
+
+// takeToken refills limit tokens per window since the last request, a request takes one token
+func (c *rateLimitCounter) takeToken(limit int, window int64, now int64) *entity.RateLimitResult {
+	rate := float64(limit) / float64(window)
+	c.tokens = math.Min(float64(limit), c.tokens+float64(max(now-c.updatedAt, 0))*rate)
+	c.updatedAt = now
+	c.expiresAt = now + window
+
+	result := &entity.RateLimitResult{}
+	if c.tokens >= 1 {
+		c.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration(math.Ceil((1-c.tokens)/rate)) * time.Millisecond
+	}
+	result.Remaining = int(math.Floor(c.tokens))
+	result.Reset = time.Duration(math.Ceil((float64(limit)-c.tokens)/rate)) * time.Millisecond
+
+	return result
+}
@@ -11,6 +11,7 @@ type LogCollection struct {
 	LogFields     map[string]string `bson:"log_fields" json:"log_fields"`
 	Created       time.Time         `bson:"created" json:"created"`
 	ExecutionTime int               `bson:"exec_time" json:"exec_time"`
+	RequestID     string            `bson:"request_id,omitempty" json:"request_id,omitempty"`
 }
 
 func NewLogCollection() LogCollection {
@@ -6,7 +6,10 @@ import (
 	errwrap "github.com/pkg/errors"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mongodb/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
 	"go.mongodb.org/mongo-driver/mongo"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type LogRepository interface {
@@ -21,13 +24,27 @@ func NewLogRepository(db *mongo.Database) *Log {
 	return &Log{collection: db.Collection(LogCollection)}
 }
 
-func (r *Log) Create(ctx context.Context, params entity.LogCollection) error {
+func (r *Log) Create(ctx context.Context, params entity.LogCollection) (err error) {
 	funcName := "[LogRepositoryMongo.Create]"
 
+	ctx, span := r.startSpan(ctx, "insert")
+	defer func() { tracing.End(span, err) }()
+
 	if err := helper.CheckDeadline(ctx); err != nil {
 		return errwrap.Wrap(err, funcName)
 	}
 
-	_, err := r.collection.InsertOne(ctx, params)
+	_, err = r.collection.InsertOne(ctx, params)
 	return err
 }
+
+func (r *Log) startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "mongodb."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemMongoDB,
+			semconv.DBCollectionName(r.collection.Name()),
+			semconv.DBOperationName(operation),
+		),
+	)
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type IdempotencyKey struct {
+	Key         string    `gorm:"column:idempotency_key"`
+	Fingerprint string    `gorm:"column:fingerprint"`
+	StatusCode  int       `gorm:"column:status_code"`
+	ContentType string    `gorm:"column:content_type"`
+	Body        []byte    `gorm:"column:body"`
+	ExpiresAt   time.Time `gorm:"column:expires_at"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
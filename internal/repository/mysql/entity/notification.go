@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+type Notification struct {
+	ID        int64      `gorm:"column:id"`
+	UserID    int64      `gorm:"column:user_id"`
+	Type      string     `gorm:"column:type"`
+	Title     string     `gorm:"column:title"`
+	Body      string     `gorm:"column:body"`
+	Data      string     `gorm:"column:data"`
+	ReadAt    *time.Time `gorm:"column:read_at"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// NotificationCount is the number of user notifications
+type NotificationCount struct {
+	Total  int64 `gorm:"column:total"`
+	Unread int64 `gorm:"column:unread"`
+}
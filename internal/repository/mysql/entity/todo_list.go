@@ -3,15 +3,35 @@ package entity
 import "time"
 
 type TodoList struct {
-	Title       string    `gorm:"column:title"`
-	UserID      int64     `gorm:"column:user_id"`
-	Description string    `gorm:"column:description"`
-	DoingAt     time.Time `gorm:"column:doing_at"`
-	CreatedAt   time.Time `gorm:"column:created_at"`
-	UpdatedAt   time.Time `gorm:"column:updated_at"`
-	ID          int64     `gorm:"column:id"`
+	Title       string     `gorm:"column:title"`
+	UserID      int64      `gorm:"column:user_id"`
+	Description string     `gorm:"column:description"`
+	DoingAt     time.Time  `gorm:"column:doing_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	Position    string     `gorm:"column:position"`
+	Version     int64      `gorm:"column:version"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at"`
+	ID          int64      `gorm:"column:id"`
 }
 
 func (TodoList) TableName() string {
 	return "todo_lists"
 }
+
+// TodoListStatusCount is the number of todo lists per status, Total, Completed, Pending and Overdue are
+// counted within the requested date range while OverdueAll counts every overdue todo list of the user
+type TodoListStatusCount struct {
+	Total      int64 `gorm:"column:total"`
+	Completed  int64 `gorm:"column:completed"`
+	Pending    int64 `gorm:"column:pending"`
+	Overdue    int64 `gorm:"column:overdue"`
+	OverdueAll int64 `gorm:"column:overdue_all"`
+}
+
+// TodoListDailyCount is the number of todo lists scheduled (doing_at) and completed (completed_at) on a day
+type TodoListDailyCount struct {
+	Day       time.Time `gorm:"column:day"`
+	Scheduled int64     `gorm:"column:scheduled"`
+	Completed int64     `gorm:"column:completed"`
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type TodoListHistoryAction string
+
+const (
+	TodoListHistoryCreate TodoListHistoryAction = "create"
+	TodoListHistoryUpdate TodoListHistoryAction = "update"
+	TodoListHistoryDelete TodoListHistoryAction = "delete"
+)
+
+type TodoListHistory struct {
+	ID         int64                 `gorm:"column:id"`
+	TodoListID int64                 `gorm:"column:todo_list_id"`
+	UserID     int64                 `gorm:"column:user_id"`
+	ActorID    int64                 `gorm:"column:actor_id"`
+	Action     TodoListHistoryAction `gorm:"column:action"`
+	Changes    string                `gorm:"column:changes"`
+	CreatedAt  time.Time             `gorm:"column:created_at"`
+}
+
+func (TodoListHistory) TableName() string {
+	return "todo_list_histories"
+}
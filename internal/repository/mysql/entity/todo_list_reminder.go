@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type TodoListReminder struct {
+	ID            int64      `gorm:"column:id"`
+	TodoListID    int64      `gorm:"column:todo_list_id"`
+	UserID        int64      `gorm:"column:user_id"`
+	OffsetMinutes int        `gorm:"column:offset_minutes"`
+	SentFor       *time.Time `gorm:"column:sent_for"`
+	SentAt        *time.Time `gorm:"column:sent_at"`
+	QueuedAt      *time.Time `gorm:"column:queued_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at"`
+}
+
+func (TodoListReminder) TableName() string {
+	return "todo_list_reminders"
+}
+
+// TodoListReminderDue is a reminder with the todo list it belongs to
+type TodoListReminderDue struct {
+	TodoListReminder
+	Title       string     `gorm:"column:title"`
+	DoingAt     time.Time  `gorm:"column:doing_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending  WebhookDeliveryStatus = "pending"
+	WebhookDeliveryRetrying WebhookDeliveryStatus = "retrying"
+	WebhookDeliverySuccess  WebhookDeliveryStatus = "success"
+	WebhookDeliveryFailed   WebhookDeliveryStatus = "failed"
+)
+
+type Webhook struct {
+	ID           int64      `gorm:"column:id"`
+	UserID       int64      `gorm:"column:user_id"`
+	URL          string     `gorm:"column:url"`
+	Secret       string     `gorm:"column:secret"`
+	EventTypes   string     `gorm:"column:event_types"`
+	IsActive     bool       `gorm:"column:is_active"`
+	FailureCount int        `gorm:"column:failure_count"`
+	DisabledAt   *time.Time `gorm:"column:disabled_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at"`
+}
+
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+type WebhookDelivery struct {
+	ID        int64  `gorm:"column:id"`
+	WebhookID int64  `gorm:"column:webhook_id"`
+	EventID   string `gorm:"column:event_id"`
+	EventType string `gorm:"column:event_type"`
+	// DispatchKey is the event ID of deliveries created by dispatch so an event is dispatched once per webhook,
+	// it is nil for redeliveries
+	DispatchKey    *string               `gorm:"column:dispatch_key"`
+	Payload        string                `gorm:"column:payload"`
+	Status         WebhookDeliveryStatus `gorm:"column:status"`
+	Attempts       int                   `gorm:"column:attempts"`
+	ResponseStatus int                   `gorm:"column:response_status"`
+	ResponseBody   string                `gorm:"column:response_body"`
+	Error          string                `gorm:"column:error"`
+	NextRetryAt    *time.Time            `gorm:"column:next_retry_at"`
+	DeliveredAt    *time.Time            `gorm:"column:delivered_at"`
+	CreatedAt      time.Time             `gorm:"column:created_at"`
+	UpdatedAt      time.Time             `gorm:"column:updated_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,109 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+)
+
+type IIdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, params *generalEntity.IdempotencyRecord) (bool, error)
+	Get(ctx context.Context, key string) (*generalEntity.IdempotencyRecord, error)
+	Save(ctx context.Context, params *generalEntity.IdempotencyRecord) error
+	Delete(ctx context.Context, key string) error
+}
+
+type IdempotencyKeyRepository struct {
+	GormTrxSupport
+}
+
+func NewIdempotencyKeyRepository(mysql *config.Mysql) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+// Reserve inserts in progress record, it returns false when an unexpired record with the same key exists
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, params *generalEntity.IdempotencyRecord) (bool, error) {
+	funcName := "IdempotencyKeyRepository.Reserve"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	err := r.db.WithContext(ctx).Exec("DELETE FROM idempotency_keys WHERE idempotency_key = ? AND expires_at <= ?", params.Key, time.Now()).Error
+	if err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	result := r.db.WithContext(ctx).Exec("INSERT IGNORE INTO idempotency_keys (idempotency_key, fingerprint, expires_at) VALUES (?, ?, ?)",
+		params.Key, params.Fingerprint, params.ExpiresAt)
+	if result.Error != nil {
+		return false, errwrap.Wrap(result.Error, funcName)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Get returns unexpired record of the key, nil when not found
+func (r *IdempotencyKeyRepository) Get(ctx context.Context, key string) (*generalEntity.IdempotencyRecord, error) {
+	funcName := "IdempotencyKeyRepository.Get"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	var result []*entity.IdempotencyKey
+	err := r.db.WithContext(ctx).Raw("SELECT * FROM idempotency_keys WHERE idempotency_key = ? AND expires_at > ? LIMIT 1", key, time.Now()).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return &generalEntity.IdempotencyRecord{
+		Key:         result[0].Key,
+		Fingerprint: result[0].Fingerprint,
+		StatusCode:  result[0].StatusCode,
+		ContentType: result[0].ContentType,
+		Body:        result[0].Body,
+		ExpiresAt:   result[0].ExpiresAt,
+	}, nil
+}
+
+// Save stores the response of reserved key
+func (r *IdempotencyKeyRepository) Save(ctx context.Context, params *generalEntity.IdempotencyRecord) error {
+	funcName := "IdempotencyKeyRepository.Save"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	err := r.db.WithContext(ctx).Exec("UPDATE idempotency_keys SET status_code = ?, content_type = ?, body = ?, expires_at = ? WHERE idempotency_key = ?",
+		params.StatusCode, params.ContentType, params.Body, params.ExpiresAt, params.Key).Error
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// Delete releases the key so the request can be retried
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	funcName := "IdempotencyKeyRepository.Delete"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Exec("DELETE FROM idempotency_keys WHERE idempotency_key = ?", key).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
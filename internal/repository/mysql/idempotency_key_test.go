@@ -0,0 +1,295 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.IdempotencyKeyRepository
+}
+
+func TestIdempotencyKeyRepository(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyRepositoryTestSuite))
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewIdempotencyKeyRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) TestReserve() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := &generalEntity.IdempotencyRecord{Key: "key", Fingerprint: "fingerprint", ExpiresAt: time.Now().Add(time.Minute)}
+	deleteQuery := regexp.QuoteMeta("DELETE FROM idempotency_keys WHERE idempotency_key = ? AND expires_at <= ?")
+	insertQuery := regexp.QuoteMeta("INSERT IGNORE INTO idempotency_keys (idempotency_key, fingerprint, expires_at) VALUES (?, ?, ?)")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name: "Success Reserved",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(deleteQuery).WithArgs("key", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.mock.ExpectExec(insertQuery).WithArgs("key", "fingerprint", params.ExpiresAt).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+		{
+			name: "Success Already Exists",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(deleteQuery).WithArgs("key", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.mock.ExpectExec(insertQuery).WithArgs("key", "fingerprint", params.ExpiresAt).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			want: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(deleteQuery).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			reserved, err := s.repo.Reserve(tt.ctx, params)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.want, reserved)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) TestGet() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM idempotency_keys WHERE idempotency_key = ? AND expires_at > ? LIMIT 1")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		want      *generalEntity.IdempotencyRecord
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"idempotency_key", "fingerprint", "status_code", "content_type", "body"}).
+					AddRow("key", "fingerprint", 201, "application/json", []byte(`{"code":201}`))
+				s.mock.ExpectQuery(query).WithArgs("key", sqlmock.AnyArg()).WillReturnRows(rows)
+			},
+			want: &generalEntity.IdempotencyRecord{
+				Key:         "key",
+				Fingerprint: "fingerprint",
+				StatusCode:  201,
+				ContentType: "application/json",
+				Body:        []byte(`{"code":201}`),
+			},
+		},
+		{
+			name: "Success Not Found",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WithArgs("key", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"idempotency_key"}))
+			},
+			want: nil,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			record, err := s.repo.Get(tt.ctx, "key")
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.want, record)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) TestSave() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := &generalEntity.IdempotencyRecord{
+		Key:         "key",
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte(`{"code":201}`),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	query := regexp.QuoteMeta("UPDATE idempotency_keys SET status_code = ?, content_type = ?, body = ?, expires_at = ? WHERE idempotency_key = ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).
+					WithArgs(201, "application/json", params.Body, params.ExpiresAt, "key").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.Save(tt.ctx, params)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *IdempotencyKeyRepositoryTestSuite) TestDelete() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("DELETE FROM idempotency_keys WHERE idempotency_key = ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WithArgs("key").WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.Delete(tt.ctx, "key")
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
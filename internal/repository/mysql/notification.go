@@ -0,0 +1,138 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+)
+
+type INotificationRepository interface {
+	Create(ctx context.Context, params *entity.Notification) error
+	GetByID(ctx context.Context, ID int64) (result *entity.Notification, err error)
+	GetByUserID(ctx context.Context, userID int64, limit int, offset int) (result []*entity.Notification, err error)
+	CountByUserID(ctx context.Context, userID int64) (result *entity.NotificationCount, err error)
+	MarkRead(ctx context.Context, ID int64, now time.Time) error
+	MarkAllRead(ctx context.Context, userID int64, now time.Time) (int64, error)
+	DeleteReadBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+type NotificationRepository struct {
+	GormTrxSupport
+}
+
+func NewNotificationRepository(mysql *config.Mysql) *NotificationRepository {
+	return &NotificationRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, params *entity.Notification) error {
+	funcName := "NotificationRepository.Create"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Create(params).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) GetByID(ctx context.Context, ID int64) (result *entity.Notification, err error) {
+	funcName := "NotificationRepository.GetByID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Raw("SELECT * FROM notifications WHERE id = ? LIMIT 1", ID).Scan(&result).Error; err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetByUserID returns a page of user notifications ordered from the newest
+func (r *NotificationRepository) GetByUserID(ctx context.Context, userID int64, limit int, offset int) (result []*entity.Notification, err error) {
+	funcName := "NotificationRepository.GetByUserID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM notifications WHERE user_id = ? ORDER BY id DESC LIMIT ? OFFSET ?", userID, limit, offset).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// CountByUserID returns the total and unread number of user notifications
+func (r *NotificationRepository) CountByUserID(ctx context.Context, userID int64) (result *entity.NotificationCount, err error) {
+	funcName := "NotificationRepository.CountByUserID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT COUNT(*) AS total, COALESCE(SUM(read_at IS NULL), 0) AS unread FROM notifications WHERE user_id = ?", userID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, ID int64, now time.Time) error {
+	funcName := "NotificationRepository.MarkRead"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Exec("UPDATE notifications SET read_at = ? WHERE id = ? AND read_at IS NULL", now, ID).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// MarkAllRead marks unread user notifications as read, returns the number of marked notifications
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	funcName := "NotificationRepository.MarkAllRead"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return 0, errwrap.Wrap(err, funcName)
+	}
+
+	result := r.db.WithContext(ctx).Exec("UPDATE notifications SET read_at = ? WHERE user_id = ? AND read_at IS NULL", now, userID)
+	if result.Error != nil {
+		return 0, errwrap.Wrap(result.Error, funcName)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteReadBefore deletes at most limit notifications read before the given time, returns the number of
+// deleted notifications
+func (r *NotificationRepository) DeleteReadBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	funcName := "NotificationRepository.DeleteReadBefore"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return 0, errwrap.Wrap(err, funcName)
+	}
+
+	result := r.db.WithContext(ctx).Exec("DELETE FROM notifications WHERE read_at < ? LIMIT ?", before, limit)
+	if result.Error != nil {
+		return 0, errwrap.Wrap(result.Error, funcName)
+	}
+
+	return result.RowsAffected, nil
+}
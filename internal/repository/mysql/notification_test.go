@@ -0,0 +1,174 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type NotificationRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.NotificationRepository
+}
+
+func TestNotificationRepository(t *testing.T) {
+	suite.Run(t, new(NotificationRepositoryTestSuite))
+}
+
+func (s *NotificationRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewNotificationRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *NotificationRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *NotificationRepositoryTestSuite) TestGetByUserID() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM notifications WHERE user_id = ? ORDER BY id DESC LIMIT ? OFFSET ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "type", "title", "body", "data"}).
+					AddRow(2, 1, "todo.reminder", "Reminder: Weekly meeting", "Weekly meeting is due", `{"todo_list_id":10}`)
+				s.mock.ExpectQuery(query).WithArgs(1, 20, 20).WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetByUserID(tt.ctx, 1, 20, 20)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Require().Len(result, 1)
+				s.Equal(int64(2), result[0].ID)
+				s.Equal("Reminder: Weekly meeting", result[0].Title)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *NotificationRepositoryTestSuite) TestCountByUserID() {
+	query := regexp.QuoteMeta("SELECT COUNT(*) AS total, COALESCE(SUM(read_at IS NULL), 0) AS unread FROM notifications WHERE user_id = ?")
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"total", "unread"}).AddRow(5, 2))
+
+		result, err := s.repo.CountByUserID(context.Background(), 1)
+
+		s.NoError(err)
+		s.Equal(int64(5), result.Total)
+		s.Equal(int64(2), result.Unread)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Error DB", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		_, err := s.repo.CountByUserID(context.Background(), 1)
+
+		s.Error(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *NotificationRepositoryTestSuite) TestMarkAllRead() {
+	query := regexp.QuoteMeta("UPDATE notifications SET read_at = ? WHERE user_id = ? AND read_at IS NULL")
+	now := time.Now()
+
+	s.Run("Success", func() {
+		s.mock.ExpectExec(query).WithArgs(now, 1).WillReturnResult(sqlmock.NewResult(0, 3))
+
+		affected, err := s.repo.MarkAllRead(context.Background(), 1, now)
+
+		s.NoError(err)
+		s.Equal(int64(3), affected)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Error DB", func() {
+		s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+
+		_, err := s.repo.MarkAllRead(context.Background(), 1, now)
+
+		s.Error(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *NotificationRepositoryTestSuite) TestDeleteReadBefore() {
+	query := regexp.QuoteMeta("DELETE FROM notifications WHERE read_at < ? LIMIT ?")
+	before := time.Now().AddDate(0, 0, -30)
+
+	s.Run("Success", func() {
+		s.mock.ExpectExec(query).WithArgs(before, 1000).WillReturnResult(sqlmock.NewResult(0, 12))
+
+		deleted, err := s.repo.DeleteReadBefore(context.Background(), before, 1000)
+
+		s.NoError(err)
+		s.Equal(int64(12), deleted)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Error DB", func() {
+		s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+
+		_, err := s.repo.DeleteReadBefore(context.Background(), before, 1000)
+
+		s.Error(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
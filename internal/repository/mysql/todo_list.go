@@ -2,8 +2,10 @@ package mysql
 
 import (
 	"context"
+	"time"
 
 	"github.com/rahmatrdn/go-skeleton/config"
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
 
@@ -15,11 +17,20 @@ import (
 
 type ITodoListRepository interface {
 	TrxSupportRepo
+	TodoListStatsReader
+	Search(ctx context.Context, userID int64, query string, limit int, offset int) (result []*generalEntity.TodoListSearchResult, err error)
 	GetByUserID(ctx context.Context, ID int64) (result []*entity.TodoList, err error)
+	GetByDoingAtRange(ctx context.Context, userID int64, from time.Time, to time.Time) (result []*entity.TodoList, err error)
+	ChunkByUserID(ctx context.Context, userID int64, batchSize int, fn func(result []*entity.TodoList) error) error
 	GetByID(ctx context.Context, ID int64) (result *entity.TodoList, err error)
 	Create(ctx context.Context, dbTrx TrxObj, params *entity.TodoList, nonZeroVal bool) error
+	BulkCreate(ctx context.Context, dbTrx TrxObj, params []*entity.TodoList, batchSize int) error
 	LockByID(ctx context.Context, dbTrx TrxObj, ID int64) (result *entity.TodoList, err error)
+	GetLastPosition(ctx context.Context, dbTrx TrxObj, userID int64) (position string, err error)
+	GetPrevPosition(ctx context.Context, dbTrx TrxObj, userID int64, position string, excludeID int64) (result string, err error)
+	GetNextPosition(ctx context.Context, dbTrx TrxObj, userID int64, position string, excludeID int64) (result string, err error)
 	Update(ctx context.Context, dbTrx TrxObj, params *entity.TodoList, changes *entity.TodoList) (err error)
+	UpdateColumns(ctx context.Context, dbTrx TrxObj, params *entity.TodoList, changes *entity.TodoList, columns ...string) (err error)
 	DeleteByID(ctx context.Context, dbTrx TrxObj, id int64) error
 }
 
@@ -38,7 +49,7 @@ func (r *TodoListRepository) GetByUserID(ctx context.Context, userID int64) (res
 		return nil, errwrap.Wrap(err, funcName)
 	}
 
-	err = r.db.Raw("SELECT * FROM todo_lists WHERE user_id = ?", userID).Scan(&result).Error
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM todo_lists WHERE user_id = ? ORDER BY position ASC, id ASC", userID).Scan(&result).Error
 	if errwrap.Is(err, gorm.ErrRecordNotFound) {
 		return nil, apperr.ErrRecordNotFound()
 	}
@@ -46,6 +57,50 @@ func (r *TodoListRepository) GetByUserID(ctx context.Context, userID int64) (res
 	return result, err
 }
 
+// GetByDoingAtRange returns user todo lists whose doing date is within from and to (inclusive dates),
+// ordered by doing date then position
+func (r *TodoListRepository) GetByDoingAtRange(ctx context.Context, userID int64, from time.Time, to time.Time) (result []*entity.TodoList, err error) {
+	funcName := "TodoListRepository.GetByDoingAtRange"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM todo_lists WHERE user_id = ? AND doing_at BETWEEN ? AND ? ORDER BY doing_at ASC, position ASC, id ASC",
+		userID, from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// ChunkByUserID reads user todo lists ordered by ID in batches of batchSize and passes every batch to fn,
+// so large result sets can be processed (ex. streamed) without loading all rows into memory
+func (r *TodoListRepository) ChunkByUserID(ctx context.Context, userID int64, batchSize int, fn func(result []*entity.TodoList) error) error {
+	funcName := "TodoListRepository.ChunkByUserID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	var batch []*entity.TodoList
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			if err := helper.CheckDeadline(ctx); err != nil {
+				return err
+			}
+
+			return fn(batch)
+		}).Error
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
 func (r *TodoListRepository) GetByID(ctx context.Context, ID int64) (result *entity.TodoList, err error) {
 	funcName := "TodoListRepository.GetByID"
 
@@ -53,7 +108,7 @@ func (r *TodoListRepository) GetByID(ctx context.Context, ID int64) (result *ent
 		return nil, errwrap.Wrap(err, funcName)
 	}
 
-	err = r.db.Raw("SELECT * FROM todo_lists WHERE id = ? LIMIT 1", ID).Scan(&result).Error
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM todo_lists WHERE id = ? LIMIT 1", ID).Scan(&result).Error
 	if errwrap.Is(err, gorm.ErrRecordNotFound) {
 		return nil, apperr.ErrRecordNotFound()
 	}
@@ -69,7 +124,21 @@ func (r *TodoListRepository) Create(ctx context.Context, dbTrx TrxObj, params *e
 	}
 
 	cols := helper.NonZeroCols(params, nonZeroVal)
-	return r.Trx(dbTrx).Select(cols).Create(&params).Error
+	return r.Trx(dbTrx).WithContext(ctx).Select(cols).Create(&params).Error
+}
+
+func (r *TodoListRepository) BulkCreate(ctx context.Context, dbTrx TrxObj, params []*entity.TodoList, batchSize int) error {
+	funcName := "TodoListRepository.BulkCreate"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.Trx(dbTrx).WithContext(ctx).CreateInBatches(params, batchSize).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
 }
 
 func (r *TodoListRepository) LockByID(ctx context.Context, dbTrx TrxObj, ID int64) (result *entity.TodoList, err error) {
@@ -79,7 +148,7 @@ func (r *TodoListRepository) LockByID(ctx context.Context, dbTrx TrxObj, ID int6
 		return nil, errwrap.Wrap(err, funcName)
 	}
 
-	err = r.Trx(dbTrx).
+	err = r.Trx(dbTrx).WithContext(ctx).
 		Raw("SELECT * FROM todo_lists WHERE id = ? FOR UPDATE", ID).
 		Scan(&result).Error
 
@@ -90,6 +159,61 @@ func (r *TodoListRepository) LockByID(ctx context.Context, dbTrx TrxObj, ID int6
 	return result, err
 }
 
+// GetLastPosition returns the highest position of user todo lists (empty when user has no todo list).
+// Rows are locked so concurrent appends for the same user are serialized
+func (r *TodoListRepository) GetLastPosition(ctx context.Context, dbTrx TrxObj, userID int64) (position string, err error) {
+	funcName := "TodoListRepository.GetLastPosition"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	err = r.Trx(dbTrx).WithContext(ctx).
+		Raw("SELECT position FROM todo_lists WHERE user_id = ? ORDER BY position DESC LIMIT 1 FOR UPDATE", userID).
+		Scan(&position).Error
+	if err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	return position, nil
+}
+
+// GetPrevPosition returns the nearest position lower than position (empty when there is none)
+func (r *TodoListRepository) GetPrevPosition(ctx context.Context, dbTrx TrxObj, userID int64, position string, excludeID int64) (result string, err error) {
+	funcName := "TodoListRepository.GetPrevPosition"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	err = r.Trx(dbTrx).WithContext(ctx).
+		Raw("SELECT position FROM todo_lists WHERE user_id = ? AND position < ? AND id <> ? ORDER BY position DESC LIMIT 1 FOR UPDATE", userID, position, excludeID).
+		Scan(&result).Error
+	if err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetNextPosition returns the nearest position greater than position (empty when there is none)
+func (r *TodoListRepository) GetNextPosition(ctx context.Context, dbTrx TrxObj, userID int64, position string, excludeID int64) (result string, err error) {
+	funcName := "TodoListRepository.GetNextPosition"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	err = r.Trx(dbTrx).WithContext(ctx).
+		Raw("SELECT position FROM todo_lists WHERE user_id = ? AND position > ? AND id <> ? ORDER BY position ASC LIMIT 1 FOR UPDATE", userID, position, excludeID).
+		Scan(&result).Error
+	if err != nil {
+		return "", errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
 func (r *TodoListRepository) Update(ctx context.Context, dbTrx TrxObj, params *entity.TodoList, changes *entity.TodoList) (err error) {
 	funcName := "TodoListRepository.Update"
 
@@ -97,7 +221,7 @@ func (r *TodoListRepository) Update(ctx context.Context, dbTrx TrxObj, params *e
 		return errwrap.Wrap(err, funcName)
 	}
 
-	db := r.Trx(dbTrx).Model(params)
+	db := r.Trx(dbTrx).WithContext(ctx).Model(params)
 	if changes != nil {
 		err = db.Updates(*changes).Error
 	} else {
@@ -111,6 +235,22 @@ func (r *TodoListRepository) Update(ctx context.Context, dbTrx TrxObj, params *e
 	return nil
 }
 
+// UpdateColumns writes only the given columns from changes, zero values are written as well
+// (ex. clearing description) while Update skips zero fields of changes
+func (r *TodoListRepository) UpdateColumns(ctx context.Context, dbTrx TrxObj, params *entity.TodoList, changes *entity.TodoList, columns ...string) (err error) {
+	funcName := "TodoListRepository.UpdateColumns"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.Trx(dbTrx).WithContext(ctx).Model(params).Select(columns).Updates(changes).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
 func (r *TodoListRepository) DeleteByID(ctx context.Context, dbTrx TrxObj, id int64) error {
 	funcName := "TodoListRepository.DeleteByID"
 
@@ -118,7 +258,7 @@ func (r *TodoListRepository) DeleteByID(ctx context.Context, dbTrx TrxObj, id in
 		return errwrap.Wrap(err, funcName)
 	}
 
-	err := r.Trx(dbTrx).Where("id = ?", id).Delete(&entity.TodoList{}).Error
+	err := r.Trx(dbTrx).WithContext(ctx).Where("id = ?", id).Delete(&entity.TodoList{}).Error
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,71 @@
+package mysql
+
+import (
+	"context"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+)
+
+type ITodoListHistoryRepository interface {
+	Create(ctx context.Context, dbTrx TrxObj, params *entity.TodoListHistory) error
+	BulkCreate(ctx context.Context, dbTrx TrxObj, params []*entity.TodoListHistory, batchSize int) error
+	GetByTodoListID(ctx context.Context, userID int64, todoListID int64) (result []*entity.TodoListHistory, err error)
+}
+
+type TodoListHistoryRepository struct {
+	GormTrxSupport
+}
+
+func NewTodoListHistoryRepository(mysql *config.Mysql) *TodoListHistoryRepository {
+	return &TodoListHistoryRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+// Create writes history entry, pass transaction of the todo list change so both are committed together
+func (r *TodoListHistoryRepository) Create(ctx context.Context, dbTrx TrxObj, params *entity.TodoListHistory) error {
+	funcName := "TodoListHistoryRepository.Create"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.Trx(dbTrx).WithContext(ctx).Create(params).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// BulkCreate writes history entries in batches of batchSize, used by import
+func (r *TodoListHistoryRepository) BulkCreate(ctx context.Context, dbTrx TrxObj, params []*entity.TodoListHistory, batchSize int) error {
+	funcName := "TodoListHistoryRepository.BulkCreate"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.Trx(dbTrx).WithContext(ctx).CreateInBatches(params, batchSize).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// GetByTodoListID returns history of a user todo list (including deleted todo list), newest first
+func (r *TodoListHistoryRepository) GetByTodoListID(ctx context.Context, userID int64, todoListID int64) (result []*entity.TodoListHistory, err error) {
+	funcName := "TodoListHistoryRepository.GetByTodoListID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM todo_list_histories WHERE todo_list_id = ? AND user_id = ? ORDER BY id DESC", todoListID, userID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,230 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type TodoListHistoryRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.TodoListHistoryRepository
+}
+
+func TestTodoListHistoryRepository(t *testing.T) {
+	suite.Run(t, new(TodoListHistoryRepositoryTestSuite))
+}
+
+func (s *TodoListHistoryRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewTodoListHistoryRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *TodoListHistoryRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *TodoListHistoryRepositoryTestSuite) TestCreate() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := &entity.TodoListHistory{
+		TodoListID: 1,
+		UserID:     1,
+		ActorID:    1,
+		Action:     entity.TodoListHistoryCreate,
+		Changes:    `{"title":{"old":null,"new":"Test"}}`,
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_list_histories`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				s.mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_list_histories`")).
+					WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.Create(tt.ctx, new(mocks.TrxObj), params)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListHistoryRepositoryTestSuite) TestBulkCreate() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := []*entity.TodoListHistory{
+		{TodoListID: 1, UserID: 1, ActorID: 1, Action: entity.TodoListHistoryCreate, Changes: `{"title":{"old":null,"new":"Todo 1"}}`},
+		{TodoListID: 2, UserID: 1, ActorID: 1, Action: entity.TodoListHistoryCreate, Changes: `{"title":{"old":null,"new":"Todo 2"}}`},
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_list_histories`")).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				s.mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_list_histories`")).
+					WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.BulkCreate(tt.ctx, new(mocks.TrxObj), params, 100)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListHistoryRepositoryTestSuite) TestGetByTodoListID() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM todo_list_histories WHERE todo_list_id = ? AND user_id = ? ORDER BY id DESC")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "todo_list_id", "user_id", "actor_id", "action", "changes"}).
+					AddRow(2, 1, 1, 1, "update", `{"title":{"old":"Old","new":"New"}}`).
+					AddRow(1, 1, 1, 1, "create", `{"title":{"old":null,"new":"Old"}}`)
+				s.mock.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WithArgs(1, 1).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetByTodoListID(tt.ctx, 1, 1)
+
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+				s.Equal(entity.TodoListHistoryUpdate, result[0].Action)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"gorm.io/gorm"
+)
+
+type ITodoListReminderRepository interface {
+	GetByTodoListID(ctx context.Context, todoListID int64) (result []*entity.TodoListReminder, err error)
+	ReplaceOffsets(ctx context.Context, todoListID int64, userID int64, offsets []int) error
+	GetDue(ctx context.Context, dueTime time.Duration, from time.Time, to time.Time, queuedBefore time.Time, limit int) (result []*entity.TodoListReminderDue, err error)
+	GetDueByID(ctx context.Context, ID int64) (result *entity.TodoListReminderDue, err error)
+	MarkQueued(ctx context.Context, IDs []int64, now time.Time) error
+	MarkSent(ctx context.Context, ID int64, doingAt time.Time, now time.Time) (bool, error)
+	UnmarkSent(ctx context.Context, ID int64) error
+}
+
+// reminderMaxOffset is the maximum reminder offset (7 days)
+const reminderMaxOffset = 7 * 24 * time.Hour
+
+type TodoListReminderRepository struct {
+	GormTrxSupport
+}
+
+func NewTodoListReminderRepository(mysql *config.Mysql) *TodoListReminderRepository {
+	return &TodoListReminderRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+func (r *TodoListReminderRepository) GetByTodoListID(ctx context.Context, todoListID int64) (result []*entity.TodoListReminder, err error) {
+	funcName := "TodoListReminderRepository.GetByTodoListID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM todo_list_reminders WHERE todo_list_id = ? ORDER BY offset_minutes DESC", todoListID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// ReplaceOffsets keeps reminders of the given offsets (so their sent state is kept), deletes the others
+// and creates the missing ones. Empty offsets deletes every reminder of the todo list
+func (r *TodoListReminderRepository) ReplaceOffsets(ctx context.Context, todoListID int64, userID int64, offsets []int) error {
+	funcName := "TodoListReminderRepository.ReplaceOffsets"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(offsets) == 0 {
+			return tx.Exec("DELETE FROM todo_list_reminders WHERE todo_list_id = ?", todoListID).Error
+		}
+
+		if err := tx.Exec("DELETE FROM todo_list_reminders WHERE todo_list_id = ? AND offset_minutes NOT IN ?", todoListID, offsets).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, offset := range offsets {
+			err := tx.Exec("INSERT IGNORE INTO todo_list_reminders (todo_list_id, user_id, offset_minutes, created_at) VALUES (?, ?, ?, ?)",
+				todoListID, userID, offset, now).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// GetDue returns reminders of open todo lists whose remind time is between from and to, oldest first.
+// Remind time is doing_at at dueTime (time of day in Asia/Jakarta) minus offset, reminders already sent
+// for the current doing_at or published after queuedBefore are excluded
+func (r *TodoListReminderRepository) GetDue(ctx context.Context, dueTime time.Duration, from time.Time, to time.Time, queuedBefore time.Time, limit int) (result []*entity.TodoListReminderDue, err error) {
+	funcName := "TodoListReminderRepository.GetDue"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	// Remind time is computed as DATETIME in Asia/Jakarta so it does not depend on the session time zone,
+	// doing_at range narrows the todo lists (offset is at most a week)
+	fromDatetime, toDatetime := helper.ConvertToJakartaTime(from), helper.ConvertToJakartaTime(to)
+	fromDate := helper.ConvertToJakartaDate(from.Add(-24 * time.Hour))
+	toDate := helper.ConvertToJakartaDate(to.Add(reminderMaxOffset))
+	err = r.db.WithContext(ctx).Raw(`SELECT r.*, t.title, t.doing_at, t.completed_at
+		FROM todo_list_reminders r
+		JOIN todo_lists t ON t.id = r.todo_list_id
+		WHERE t.doing_at BETWEEN ? AND ?
+			AND TIMESTAMP(t.doing_at) + INTERVAL ? SECOND - INTERVAL r.offset_minutes MINUTE BETWEEN ? AND ?
+			AND t.completed_at IS NULL
+			AND (r.sent_for IS NULL OR r.sent_for <> t.doing_at)
+			AND (r.queued_at IS NULL OR r.queued_at < ?)
+		ORDER BY TIMESTAMP(t.doing_at) - INTERVAL r.offset_minutes MINUTE ASC
+		LIMIT ?`, fromDate, toDate, int(dueTime.Seconds()), fromDatetime, toDatetime, queuedBefore, limit).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetDueByID returns reminder with its todo list, nil when the reminder or todo list is deleted
+func (r *TodoListReminderRepository) GetDueByID(ctx context.Context, ID int64) (result *entity.TodoListReminderDue, err error) {
+	funcName := "TodoListReminderRepository.GetDueByID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw(`SELECT r.*, t.title, t.doing_at, t.completed_at
+		FROM todo_list_reminders r
+		JOIN todo_lists t ON t.id = r.todo_list_id
+		WHERE r.id = ? LIMIT 1`, ID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+func (r *TodoListReminderRepository) MarkQueued(ctx context.Context, IDs []int64, now time.Time) error {
+	funcName := "TodoListReminderRepository.MarkQueued"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).Exec("UPDATE todo_list_reminders SET queued_at = ? WHERE id IN ?", now, IDs).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// MarkSent claims the reminder for doingAt atomically, it returns false when the reminder
+// was already sent for doingAt (ex. message published twice) so it must not be sent again
+func (r *TodoListReminderRepository) MarkSent(ctx context.Context, ID int64, doingAt time.Time, now time.Time) (bool, error) {
+	funcName := "TodoListReminderRepository.MarkSent"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	sentFor := doingAt.Format("2006-01-02")
+	result := r.db.WithContext(ctx).Exec("UPDATE todo_list_reminders SET sent_for = ?, sent_at = ?, updated_at = ? WHERE id = ? AND (sent_for IS NULL OR sent_for <> ?)",
+		sentFor, now, now, ID, sentFor)
+	if result.Error != nil {
+		return false, errwrap.Wrap(result.Error, funcName)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// UnmarkSent releases claimed reminder when sending failed so it can be retried
+func (r *TodoListReminderRepository) UnmarkSent(ctx context.Context, ID int64) error {
+	funcName := "TodoListReminderRepository.UnmarkSent"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Exec("UPDATE todo_list_reminders SET sent_for = NULL, sent_at = NULL WHERE id = ?", ID).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
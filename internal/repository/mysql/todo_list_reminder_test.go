@@ -0,0 +1,265 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type TodoListReminderRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.TodoListReminderRepository
+}
+
+func TestTodoListReminderRepository(t *testing.T) {
+	suite.Run(t, new(TodoListReminderRepositoryTestSuite))
+}
+
+func (s *TodoListReminderRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewTodoListReminderRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *TodoListReminderRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *TodoListReminderRepositoryTestSuite) TestReplaceOffsets() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	deleteQuery := regexp.QuoteMeta("DELETE FROM todo_list_reminders WHERE todo_list_id = ? AND offset_minutes NOT IN (?,?)")
+	insertQuery := regexp.QuoteMeta("INSERT IGNORE INTO todo_list_reminders (todo_list_id, user_id, offset_minutes, created_at) VALUES (?, ?, ?, ?)")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		offsets   []int
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name:    "Success",
+			ctx:     context.Background(),
+			offsets: []int{60, 1440},
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(deleteQuery).WithArgs(10, 60, 1440).WillReturnResult(sqlmock.NewResult(0, 1))
+				s.mock.ExpectExec(insertQuery).WithArgs(10, 1, 60, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.mock.ExpectExec(insertQuery).WithArgs(10, 1, 1440, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(5, 1))
+				s.mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Success remove all",
+			ctx:     context.Background(),
+			offsets: nil,
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM todo_list_reminders WHERE todo_list_id = ?")).
+					WithArgs(10).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				s.mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Error DB",
+			ctx:     context.Background(),
+			offsets: []int{60, 1440},
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(deleteQuery).WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			offsets:   []int{60},
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.ReplaceOffsets(tt.ctx, 10, 1, tt.offsets)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListReminderRepositoryTestSuite) TestGetDue() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("TIMESTAMP(t.doing_at) + INTERVAL ? SECOND - INTERVAL r.offset_minutes MINUTE BETWEEN ? AND ?")
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	from := time.Date(2024, 1, 10, 8, 0, 0, 0, jakarta)
+	to := time.Date(2024, 1, 10, 9, 0, 0, 0, jakarta)
+	queuedBefore := to.Add(-10 * time.Minute)
+	doingAt, _ := time.Parse("2006-01-02", "2024-01-10")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "todo_list_id", "user_id", "offset_minutes", "title", "doing_at"}).
+					AddRow(1, 10, 1, 60, "Weekly meeting", doingAt)
+				s.mock.ExpectQuery(query).
+					WithArgs("2024-01-09", "2024-01-17", 9*3600, "2024-01-10 08:00:00", "2024-01-10 09:00:00", queuedBefore, 500).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetDue(tt.ctx, 9*time.Hour, from, to, queuedBefore, 500)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Require().Len(result, 1)
+				s.Equal(int64(10), result[0].TodoListID)
+				s.Equal(60, result[0].OffsetMinutes)
+				s.Equal("Weekly meeting", result[0].Title)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListReminderRepositoryTestSuite) TestMarkSent() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("UPDATE todo_list_reminders SET sent_for = ?, sent_at = ?, updated_at = ? WHERE id = ? AND (sent_for IS NULL OR sent_for <> ?)")
+	doingAt, _ := time.Parse("2006-01-02", "2024-01-10")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func()
+		wantClaimed bool
+		wantErr     bool
+	}{
+		{
+			name: "Success claimed",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).
+					WithArgs("2024-01-10", sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "2024-01-10").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantClaimed: true,
+		},
+		{
+			name: "Success already sent",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantClaimed: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			claimed, err := s.repo.MarkSent(tt.ctx, 1, doingAt, time.Now())
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.wantClaimed, claimed)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListReminderRepositoryTestSuite) TestMarkQueued() {
+	query := regexp.QuoteMeta("UPDATE todo_list_reminders SET queued_at = ? WHERE id IN (?,?)")
+	now := time.Now()
+
+	s.mock.ExpectExec(query).WithArgs(now, 1, 2).WillReturnResult(sqlmock.NewResult(0, 2))
+	s.NoError(s.repo.MarkQueued(context.Background(), []int64{1, 2}, now))
+
+	// Nothing to update
+	s.NoError(s.repo.MarkQueued(context.Background(), nil, now))
+
+	s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+	s.Error(s.repo.MarkQueued(context.Background(), []int64{1, 2}, now))
+
+	s.NoError(s.mock.ExpectationsWereMet())
+}
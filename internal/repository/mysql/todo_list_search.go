@@ -0,0 +1,37 @@
+package mysql
+
+import (
+	"context"
+
+	errwrap "github.com/pkg/errors"
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+)
+
+const searchSnippetSize = 160
+
+// Search is the MySQL full-text search of todo_list_usecase.TodoListSearcher (FULLTEXT index)
+func (r *TodoListRepository) Search(ctx context.Context, userID int64, query string, limit int, offset int) (result []*generalEntity.TodoListSearchResult, err error) {
+	funcName := "TodoListRepository.Search"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw(`SELECT *, MATCH(title, description) AGAINST (? IN NATURAL LANGUAGE MODE) AS relevance
+		FROM todo_lists
+		WHERE user_id = ? AND MATCH(title, description) AGAINST (? IN NATURAL LANGUAGE MODE)
+		ORDER BY relevance DESC, id DESC
+		LIMIT ? OFFSET ?`, query, userID, query, limit, offset).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	for _, v := range result {
+		v.TitleSnippet = helper.HighlightSnippet(v.Title, query, searchSnippetSize)
+		v.DescriptionSnippet = helper.HighlightSnippet(v.Description, query, searchSnippetSize)
+	}
+
+	return result, nil
+}
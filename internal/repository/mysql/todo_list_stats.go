@@ -0,0 +1,98 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+)
+
+// TodoListStatsReader computes user todo list statistics with aggregate queries, rows are never loaded.
+// from and to are inclusive dates, today decides which open todo lists are overdue
+type TodoListStatsReader interface {
+	GetStatusCount(ctx context.Context, userID int64, from time.Time, to time.Time, today time.Time) (result *entity.TodoListStatusCount, err error)
+	GetDailyCount(ctx context.Context, userID int64, from time.Time, to time.Time) (result []*entity.TodoListDailyCount, err error)
+	GetCompletionDays(ctx context.Context, userID int64) (result []time.Time, err error)
+}
+
+func (r *TodoListRepository) GetStatusCount(ctx context.Context, userID int64, from time.Time, to time.Time, today time.Time) (result *entity.TodoListStatusCount, err error) {
+	funcName := "TodoListRepository.GetStatusCount"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	fromDate, toDate, todayDate := from.Format("2006-01-02"), to.Format("2006-01-02"), today.Format("2006-01-02")
+	err = r.db.WithContext(ctx).Raw(`SELECT
+			COALESCE(SUM(doing_at BETWEEN ? AND ?), 0) AS total,
+			COALESCE(SUM(doing_at BETWEEN ? AND ? AND completed_at IS NOT NULL), 0) AS completed,
+			COALESCE(SUM(doing_at BETWEEN ? AND ? AND completed_at IS NULL AND doing_at >= ?), 0) AS pending,
+			COALESCE(SUM(doing_at BETWEEN ? AND ? AND completed_at IS NULL AND doing_at < ?), 0) AS overdue,
+			COALESCE(SUM(completed_at IS NULL AND doing_at < ?), 0) AS overdue_all
+		FROM todo_lists
+		WHERE user_id = ?`,
+		fromDate, toDate,
+		fromDate, toDate,
+		fromDate, toDate, todayDate,
+		fromDate, toDate, todayDate,
+		todayDate, userID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetDailyCount returns days of the range that have scheduled or completed todo lists ordered by day,
+// days without any are not returned
+func (r *TodoListRepository) GetDailyCount(ctx context.Context, userID int64, from time.Time, to time.Time) (result []*entity.TodoListDailyCount, err error) {
+	funcName := "TodoListRepository.GetDailyCount"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	fromDate, toDate := from.Format("2006-01-02"), to.Format("2006-01-02")
+	err = r.db.WithContext(ctx).Raw(`SELECT day, SUM(scheduled) AS scheduled, SUM(completed) AS completed FROM (
+			SELECT doing_at AS day, COUNT(*) AS scheduled, 0 AS completed
+			FROM todo_lists
+			WHERE user_id = ? AND doing_at BETWEEN ? AND ?
+			GROUP BY doing_at
+			UNION ALL
+			SELECT DATE(completed_at) AS day, 0 AS scheduled, COUNT(*) AS completed
+			FROM todo_lists
+			WHERE user_id = ? AND completed_at >= ? AND completed_at < DATE_ADD(?, INTERVAL 1 DAY)
+			GROUP BY DATE(completed_at)
+		) AS daily
+		GROUP BY day
+		ORDER BY day ASC`, userID, fromDate, toDate, userID, fromDate, toDate).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetCompletionDays returns distinct days on which the user completed any todo list, latest first
+func (r *TodoListRepository) GetCompletionDays(ctx context.Context, userID int64) (result []time.Time, err error) {
+	funcName := "TodoListRepository.GetCompletionDays"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw(`SELECT DISTINCT DATE(completed_at) AS day
+		FROM todo_lists
+		WHERE user_id = ? AND completed_at IS NOT NULL
+		ORDER BY day DESC`, userID).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
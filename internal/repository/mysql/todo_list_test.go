@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	errwrap "github.com/pkg/errors"
@@ -74,7 +75,7 @@ func (s *TodoListRepositoryTestSuite) TestGetByUserID() {
 			mockSetup: func() {
 				expectedRows := sqlmock.NewRows([]string{"id", "user_id", "title"}).
 					AddRow(1, 1, "Test Todo")
-				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ?")).
+				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ? ORDER BY position ASC, id ASC")).
 					WithArgs(1).
 					WillReturnRows(expectedRows)
 			},
@@ -88,7 +89,7 @@ func (s *TodoListRepositoryTestSuite) TestGetByUserID() {
 				userID: 1,
 			},
 			mockSetup: func() {
-				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ?")).
+				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ? ORDER BY position ASC, id ASC")).
 					WithArgs(1).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -105,7 +106,7 @@ func (s *TodoListRepositoryTestSuite) TestGetByUserID() {
 				userID: 1,
 			},
 			mockSetup: func() {
-				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ?")).
+				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ? ORDER BY position ASC, id ASC")).
 					WithArgs(1).
 					WillReturnError(gorm.ErrRecordNotFound)
 			},
@@ -149,6 +150,65 @@ func (s *TodoListRepositoryTestSuite) TestGetByUserID() {
 	}
 }
 
+func (s *TodoListRepositoryTestSuite) TestGetByDoingAtRange() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM todo_lists WHERE user_id = ? AND doing_at BETWEEN ? AND ? ORDER BY doing_at ASC, position ASC, id ASC")
+	from, _ := time.Parse("2006-01-02", "2024-01-01")
+	to, _ := time.Parse("2006-01-02", "2024-01-31")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "title", "doing_at"}).
+					AddRow(1, 1, "Weekly meeting", from).
+					AddRow(2, 1, "Monthly report", to)
+				s.mock.ExpectQuery(query).WithArgs(1, "2024-01-01", "2024-01-31").WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetByDoingAtRange(tt.ctx, 1, from, to)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func (s *TodoListRepositoryTestSuite) TestGetByID() {
 	type args struct {
 		ctx context.Context
@@ -508,6 +568,63 @@ func (s *TodoListRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (s *TodoListRepositoryTestSuite) TestUpdateColumns() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := &entity.TodoList{ID: 1, Title: "Old Title", Description: "Old Desc"}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success write zero value",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `todo_lists` SET `description`=?,`version`=?,`updated_at`=? WHERE `id` = ?")).
+					WithArgs("", 2, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				s.mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `todo_lists`")).
+					WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			err := s.repo.UpdateColumns(tt.ctx, new(mocks.TrxObj), params, &entity.TodoList{Description: "", Version: 2}, "description", "version")
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func (s *TodoListRepositoryTestSuite) TestDeleteByID() {
 	type args struct {
 		ctx   context.Context
@@ -580,3 +697,558 @@ func (s *TodoListRepositoryTestSuite) TestDeleteByID() {
 		})
 	}
 }
+
+func (s *TodoListRepositoryTestSuite) TestSearch() {
+	query := regexp.QuoteMeta("SELECT *, MATCH(title, description) AGAINST (? IN NATURAL LANGUAGE MODE) AS relevance")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "relevance"}).
+					AddRow(1, 1, "Weekly meeting", "Prepare meeting notes", 0.9)
+				s.mock.ExpectQuery(query).
+					WithArgs("meeting", 1, "meeting", 20, 0).
+					WillReturnRows(expectedRows)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs("meeting", 1, "meeting", 20, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.Search(tt.ctx, 1, "meeting", 20, 0)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+				s.Equal(0.9, result[0].Relevance)
+				s.Equal("Weekly <mark>meeting</mark>", result[0].TitleSnippet)
+				s.Equal("Prepare <mark>meeting</mark> notes", result[0].DescriptionSnippet)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestChunkByUserID() {
+	query := regexp.QuoteMeta("SELECT * FROM `todo_lists` WHERE user_id = ? ORDER BY `todo_lists`.`id` LIMIT ?")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		fnErr     error
+		mockSetup func()
+		wantRows  int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"id", "user_id", "title"}).
+					AddRow(1, 1, "Todo 1").
+					AddRow(2, 1, "Todo 2")
+				s.mock.ExpectQuery(query).
+					WithArgs(1, 500).
+					WillReturnRows(expectedRows)
+			},
+			wantRows: 2,
+		},
+		{
+			name:  "Error Callback",
+			ctx:   context.Background(),
+			fnErr: sql.ErrTxDone,
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"id", "user_id", "title"}).
+					AddRow(1, 1, "Todo 1")
+				s.mock.ExpectQuery(query).
+					WithArgs(1, 500).
+					WillReturnRows(expectedRows)
+			},
+			wantRows: 1,
+			wantErr:  true,
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, 500).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			rows := 0
+			err := s.repo.ChunkByUserID(tt.ctx, 1, 500, func(result []*entity.TodoList) error {
+				rows += len(result)
+				return tt.fnErr
+			})
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.Equal(tt.wantRows, rows)
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestBulkCreate() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := []*entity.TodoList{
+		{Title: "Todo 1", UserID: 1},
+		{Title: "Todo 2", UserID: 1},
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_lists`")).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				s.mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `todo_lists`")).
+					WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			err := s.repo.BulkCreate(tt.ctx, new(mocks.TrxObj), params, 100)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetLastPosition() {
+	query := regexp.QuoteMeta("SELECT position FROM todo_lists WHERE user_id = ? ORDER BY position DESC LIMIT 1 FOR UPDATE")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow("U00100"))
+			},
+			want: "U00100",
+		},
+		{
+			name: "Success Empty List",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}))
+			},
+			want: "",
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetLastPosition(tt.ctx, new(mocks.TrxObj), 1)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.want, result)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetPrevPosition() {
+	query := regexp.QuoteMeta("SELECT position FROM todo_lists WHERE user_id = ? AND position < ? AND id <> ? ORDER BY position DESC LIMIT 1 FOR UPDATE")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "U00100", 5).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow("U00000"))
+			},
+			want: "U00000",
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "U00100", 5).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetPrevPosition(tt.ctx, new(mocks.TrxObj), 1, "U00100", 5)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.want, result)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetNextPosition() {
+	query := regexp.QuoteMeta("SELECT position FROM todo_lists WHERE user_id = ? AND position > ? AND id <> ? ORDER BY position ASC LIMIT 1 FOR UPDATE")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "U00000", 5).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow("U00100"))
+			},
+			want: "U00100",
+		},
+		{
+			name: "Success Last Position",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "U00000", 5).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}))
+			},
+			want: "",
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "U00000", 5).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetNextPosition(tt.ctx, new(mocks.TrxObj), 1, "U00000", 5)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.want, result)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetStatusCount() {
+	query := regexp.QuoteMeta("COALESCE(SUM(doing_at BETWEEN ? AND ?), 0) AS total")
+	from, _ := time.Parse("2006-01-02", "2024-01-01")
+	to, _ := time.Parse("2006-01-02", "2024-01-31")
+	today, _ := time.Parse("2006-01-02", "2024-01-15")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"total", "completed", "pending", "overdue", "overdue_all"}).
+					AddRow(10, 6, 3, 1, 2)
+				s.mock.ExpectQuery(query).
+					WithArgs("2024-01-01", "2024-01-31", "2024-01-01", "2024-01-31",
+						"2024-01-01", "2024-01-31", "2024-01-15",
+						"2024-01-01", "2024-01-31", "2024-01-15",
+						"2024-01-15", 1).
+					WillReturnRows(expectedRows)
+			},
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetStatusCount(tt.ctx, 1, from, to, today)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(&entity.TodoListStatusCount{Total: 10, Completed: 6, Pending: 3, Overdue: 1, OverdueAll: 2}, result)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetDailyCount() {
+	query := regexp.QuoteMeta("SELECT day, SUM(scheduled) AS scheduled, SUM(completed) AS completed FROM (")
+	from, _ := time.Parse("2006-01-02", "2024-01-01")
+	to, _ := time.Parse("2006-01-02", "2024-01-31")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"day", "scheduled", "completed"}).
+					AddRow(from, 2, 1).
+					AddRow(to, 1, 0)
+				s.mock.ExpectQuery(query).
+					WithArgs(1, "2024-01-01", "2024-01-31", 1, "2024-01-01", "2024-01-31").
+					WillReturnRows(expectedRows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetDailyCount(tt.ctx, 1, from, to)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+				s.Equal(int64(2), result[0].Scheduled)
+				s.Equal(int64(1), result[0].Completed)
+			}
+		})
+	}
+}
+
+func (s *TodoListRepositoryTestSuite) TestGetCompletionDays() {
+	query := regexp.QuoteMeta("SELECT DISTINCT DATE(completed_at) AS day")
+	day, _ := time.Parse("2006-01-02", "2024-01-15")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"day"}).
+					AddRow(day).
+					AddRow(day.AddDate(0, 0, -1))
+				s.mock.ExpectQuery(query).
+					WithArgs(1).
+					WillReturnRows(expectedRows)
+			},
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetCompletionDays(tt.ctx, 1)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal([]time.Time{day, day.AddDate(0, 0, -1)}, result)
+			}
+		})
+	}
+}
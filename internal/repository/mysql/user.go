@@ -17,6 +17,7 @@ type UserRepository interface {
 	TrxSupportRepo
 	Create(ctx context.Context, dbTrx TrxObj, user *entity.User) error
 	LockByID(ctx context.Context, dbTrx TrxObj, ID int64) (*entity.User, error)
+	GetByID(ctx context.Context, ID int64) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 	GetByEmailAndRole(ctx context.Context, email string, role entity.RoleType) (*entity.User, error)
 }
@@ -53,6 +54,21 @@ func (u *User) LockByID(ctx context.Context, dbTrx TrxObj, ID int64) (*entity.Us
 	return user, err
 }
 
+func (u *User) GetByID(ctx context.Context, ID int64) (*entity.User, error) {
+	funcName := "UserRepository.GetByID"
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	var user *entity.User
+	err := u.db.WithContext(ctx).Where("id = ?", ID).Take(&user).Error
+	if errwrap.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperr.ErrUserNotFound()
+	}
+
+	return user, err
+}
+
 func (u *User) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	funcName := "UserRepository.GetByEmail"
 	if err := helper.CheckDeadline(ctx); err != nil {
@@ -60,7 +76,7 @@ func (u *User) GetByEmail(ctx context.Context, email string) (*entity.User, erro
 	}
 
 	var user *entity.User
-	err := u.db.Where("email = ?", email).Take(&user).Error
+	err := u.db.WithContext(ctx).Where("email = ?", email).Take(&user).Error
 	if errwrap.Is(err, gorm.ErrRecordNotFound) {
 		return nil, apperr.ErrUserNotFound()
 	}
@@ -75,7 +91,7 @@ func (u *User) GetByEmailAndRole(ctx context.Context, email string, role entity.
 	}
 
 	var user *entity.User
-	err := u.db.Where("email = ? AND role = ?", email, role).Take(&user).Error
+	err := u.db.WithContext(ctx).Where("email = ? AND role = ?", email, role).Take(&user).Error
 	if errwrap.Is(err, gorm.ErrRecordNotFound) {
 		return nil, apperr.ErrUserNotFound()
 	}
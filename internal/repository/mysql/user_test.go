@@ -307,6 +307,93 @@ func (s *UserRepositoryTestSuite) TestGetByEmail() {
 	}
 }
 
+func (s *UserRepositoryTestSuite) TestGetByID() {
+	type args struct {
+		ctx context.Context
+		ID  int64
+	}
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name         string
+		args         args
+		mockSetup    func()
+		want         *entity.User
+		wantErr      bool
+		inspectError func(err error)
+	}{
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				ID:  1,
+			},
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"id", "email", "name"}).
+					AddRow(1, "test@example.com", "Test User")
+				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE id = ? LIMIT ?")).
+					WithArgs(1, 1).
+					WillReturnRows(expectedRows)
+			},
+			want: &entity.User{
+				ID:    1,
+				Email: "test@example.com",
+				Name:  "Test User",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			args: args{
+				ctx: context.Background(),
+				ID:  2,
+			},
+			mockSetup: func() {
+				s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE id = ? LIMIT ?")).
+					WithArgs(2, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: true,
+			inspectError: func(err error) {
+				s.Equal(apperr.ErrUserNotFound(), err)
+			},
+		},
+		{
+			name: "Context Cancelled",
+			args: args{
+				ctx: cancelledCtx,
+				ID:  1,
+			},
+			mockSetup: func() {},
+			want:      nil,
+			wantErr:   true,
+			inspectError: func(err error) {
+				s.True(errwrap.Is(err, context.Canceled))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.GetByID(tt.args.ctx, tt.args.ID)
+			if tt.wantErr {
+				s.Error(err)
+				if tt.inspectError != nil {
+					tt.inspectError(err)
+				}
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				s.Equal(tt.want.Email, result.Email)
+			}
+		})
+	}
+}
+
 func (s *UserRepositoryTestSuite) TestGetByEmailAndRole() {
 	type args struct {
 		ctx   context.Context
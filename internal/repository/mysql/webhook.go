@@ -0,0 +1,162 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"gorm.io/gorm"
+)
+
+type IWebhookRepository interface {
+	Create(ctx context.Context, params *entity.Webhook) error
+	GetByID(ctx context.Context, ID int64) (result *entity.Webhook, err error)
+	GetByUserID(ctx context.Context, userID int64) (result []*entity.Webhook, err error)
+	GetActiveByEventType(ctx context.Context, userID int64, eventType string) (result []*entity.Webhook, err error)
+	UpdateColumns(ctx context.Context, params *entity.Webhook, changes *entity.Webhook, columns ...string) error
+	DeleteByID(ctx context.Context, ID int64) error
+	RecordFailure(ctx context.Context, ID int64, maxFailures int) error
+	ResetFailure(ctx context.Context, ID int64) error
+}
+
+type WebhookRepository struct {
+	GormTrxSupport
+}
+
+func NewWebhookRepository(mysql *config.Mysql) *WebhookRepository {
+	return &WebhookRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, params *entity.Webhook) error {
+	funcName := "WebhookRepository.Create"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Create(params).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, ID int64) (result *entity.Webhook, err error) {
+	funcName := "WebhookRepository.GetByID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Raw("SELECT * FROM webhooks WHERE id = ? LIMIT 1", ID).Scan(&result).Error; err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+func (r *WebhookRepository) GetByUserID(ctx context.Context, userID int64) (result []*entity.Webhook, err error) {
+	funcName := "WebhookRepository.GetByUserID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Raw("SELECT * FROM webhooks WHERE user_id = ? ORDER BY id ASC", userID).Scan(&result).Error; err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetActiveByEventType returns active user webhooks subscribed to eventType
+func (r *WebhookRepository) GetActiveByEventType(ctx context.Context, userID int64, eventType string) (result []*entity.Webhook, err error) {
+	funcName := "WebhookRepository.GetActiveByEventType"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM webhooks WHERE user_id = ? AND is_active = 1 AND FIND_IN_SET(?, event_types) > 0 ORDER BY id ASC", userID, eventType).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// UpdateColumns writes only the given columns from changes, zero values are written as well (ex. is_active false)
+func (r *WebhookRepository) UpdateColumns(ctx context.Context, params *entity.Webhook, changes *entity.Webhook, columns ...string) error {
+	funcName := "WebhookRepository.UpdateColumns"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Model(params).Select(columns).Updates(changes).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// DeleteByID deletes webhook together with its delivery log
+func (r *WebhookRepository) DeleteByID(ctx context.Context, ID int64) error {
+	funcName := "WebhookRepository.DeleteByID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM webhook_deliveries WHERE webhook_id = ?", ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM webhooks WHERE id = ?", ID).Error
+	})
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// RecordFailure increments consecutive failure count atomically and disables the webhook
+// when the count reaches maxFailures (MySQL evaluates SET assignments from left to right)
+func (r *WebhookRepository) RecordFailure(ctx context.Context, ID int64, maxFailures int) error {
+	funcName := "WebhookRepository.RecordFailure"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	err := r.db.WithContext(ctx).Exec(`UPDATE webhooks SET failure_count = failure_count + 1,
+		disabled_at = IF(is_active = 1 AND failure_count >= ?, ?, disabled_at),
+		is_active = IF(failure_count >= ?, 0, is_active)
+		WHERE id = ?`, maxFailures, time.Now(), maxFailures, ID).Error
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// ResetFailure clears consecutive failure count after a successful delivery
+func (r *WebhookRepository) ResetFailure(ctx context.Context, ID int64) error {
+	funcName := "WebhookRepository.ResetFailure"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Exec("UPDATE webhooks SET failure_count = 0 WHERE id = ? AND failure_count > 0", ID).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"gorm.io/gorm/clause"
+)
+
+type IWebhookDeliveryRepository interface {
+	Create(ctx context.Context, params *entity.WebhookDelivery) error
+	CreateOnce(ctx context.Context, params *entity.WebhookDelivery) (created bool, err error)
+	GetByID(ctx context.Context, ID int64) (result *entity.WebhookDelivery, err error)
+	GetByWebhookID(ctx context.Context, webhookID int64, limit int) (result []*entity.WebhookDelivery, err error)
+	GetDueRetries(ctx context.Context, now time.Time, limit int) (result []*entity.WebhookDelivery, err error)
+	UpdateColumns(ctx context.Context, params *entity.WebhookDelivery, changes *entity.WebhookDelivery, columns ...string) error
+}
+
+type WebhookDeliveryRepository struct {
+	GormTrxSupport
+}
+
+func NewWebhookDeliveryRepository(mysql *config.Mysql) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{GormTrxSupport{db: mysql.DB}}
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, params *entity.WebhookDelivery) error {
+	funcName := "WebhookDeliveryRepository.Create"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Create(params).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// CreateOnce creates the delivery unless the webhook already has a delivery with the same DispatchKey, created is
+// false (and params.ID is not set) when it is a duplicate
+func (r *WebhookDeliveryRepository) CreateOnce(ctx context.Context, params *entity.WebhookDelivery) (created bool, err error) {
+	funcName := "WebhookDeliveryRepository.CreateOnce"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.Insert{Modifier: "IGNORE"}).Create(params)
+	if result.Error != nil {
+		return false, errwrap.Wrap(result.Error, funcName)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, ID int64) (result *entity.WebhookDelivery, err error) {
+	funcName := "WebhookDeliveryRepository.GetByID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Raw("SELECT * FROM webhook_deliveries WHERE id = ? LIMIT 1", ID).Scan(&result).Error; err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetByWebhookID returns the latest deliveries of a webhook, newest first
+func (r *WebhookDeliveryRepository) GetByWebhookID(ctx context.Context, webhookID int64, limit int) (result []*entity.WebhookDelivery, err error) {
+	funcName := "WebhookDeliveryRepository.GetByWebhookID"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC LIMIT ?", webhookID, limit).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// GetDueRetries returns pending or retrying deliveries whose next_retry_at has passed, oldest first
+func (r *WebhookDeliveryRepository) GetDueRetries(ctx context.Context, now time.Time, limit int) (result []*entity.WebhookDelivery, err error) {
+	funcName := "WebhookDeliveryRepository.GetDueRetries"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw("SELECT * FROM webhook_deliveries WHERE status IN (?, ?) AND next_retry_at <= ? ORDER BY next_retry_at ASC LIMIT ?",
+		entity.WebhookDeliveryPending, entity.WebhookDeliveryRetrying, now, limit).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return result, nil
+}
+
+// UpdateColumns writes only the given columns from changes, zero values are written as well (ex. clearing next_retry_at)
+func (r *WebhookDeliveryRepository) UpdateColumns(ctx context.Context, params *entity.WebhookDelivery, changes *entity.WebhookDelivery, columns ...string) error {
+	funcName := "WebhookDeliveryRepository.UpdateColumns"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.db.WithContext(ctx).Model(params).Select(columns).Updates(changes).Error; err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
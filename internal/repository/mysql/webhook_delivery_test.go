@@ -0,0 +1,241 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type WebhookDeliveryRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.WebhookDeliveryRepository
+}
+
+func TestWebhookDeliveryRepository(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryRepositoryTestSuite))
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewWebhookDeliveryRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestGetByWebhookID() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC LIMIT ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "webhook_id", "event_id", "event_type", "status", "attempts"}).
+					AddRow(2, 1, "evt-2", "todo_list.updated", "success", 1).
+					AddRow(1, 1, "evt-1", "todo_list.created", "failed", 8)
+				s.mock.ExpectQuery(query).WithArgs(1, 100).WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetByWebhookID(tt.ctx, 1, 100)
+
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestGetDueRetries() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	now := time.Now()
+	query := regexp.QuoteMeta("SELECT * FROM webhook_deliveries WHERE status IN (?, ?) AND next_retry_at <= ? ORDER BY next_retry_at ASC LIMIT ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "webhook_id", "status", "attempts", "next_retry_at"}).
+					AddRow(3, 1, "retrying", 2, now.Add(-time.Minute))
+				s.mock.ExpectQuery(query).
+					WithArgs(entity.WebhookDeliveryPending, entity.WebhookDeliveryRetrying, now, 50).
+					WillReturnRows(rows)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetDueRetries(tt.ctx, now, 50)
+
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestCreateOnce() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("INSERT IGNORE INTO `webhook_deliveries`")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func()
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "Created",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(5, 1))
+				s.mock.ExpectCommit()
+			},
+			wantCreated: true,
+		},
+		{
+			name: "Duplicate",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.mock.ExpectCommit()
+			},
+			wantCreated: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			eventID := "evt-1"
+			created, err := s.repo.CreateOnce(tt.ctx, &entity.WebhookDelivery{
+				WebhookID:   1,
+				EventID:     eventID,
+				EventType:   "todo_list.created",
+				DispatchKey: &eventID,
+				Payload:     `{"id":"evt-1"}`,
+				Status:      entity.WebhookDeliveryPending,
+			})
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.Equal(tt.wantCreated, created)
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/stretchr/testify/suite"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type WebhookRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *mysql.WebhookRepository
+}
+
+func TestWebhookRepository(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryTestSuite))
+}
+
+func (s *WebhookRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gmysql.New(gmysql.Config{
+		Conn:                      s.db,
+		SkipInitializeWithVersion: true,
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = mysql.NewWebhookRepository(&config.Mysql{DB: gormDB})
+}
+
+func (s *WebhookRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *WebhookRepositoryTestSuite) TestCreate() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	params := &entity.Webhook{
+		UserID:     1,
+		URL:        "https://example.com/hook",
+		Secret:     "whsec_test",
+		EventTypes: "todo_list.created",
+		IsActive:   true,
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `webhooks`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				s.mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `webhooks`")).
+					WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.Create(tt.ctx, params)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *WebhookRepositoryTestSuite) TestGetActiveByEventType() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("SELECT * FROM webhooks WHERE user_id = ? AND is_active = 1 AND FIND_IN_SET(?, event_types) > 0 ORDER BY id ASC")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "url", "event_types", "is_active"}).
+					AddRow(1, 1, "https://example.com/a", "todo_list.created,todo_list.deleted", true).
+					AddRow(2, 1, "https://example.com/b", "todo_list.created", true)
+				s.mock.ExpectQuery(query).WithArgs(1, "todo_list.created").WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			result, err := s.repo.GetActiveByEventType(tt.ctx, 1, "todo_list.created")
+
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *WebhookRepositoryTestSuite) TestDeleteByID() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	deleteDeliveries := regexp.QuoteMeta("DELETE FROM webhook_deliveries WHERE webhook_id = ?")
+	deleteWebhook := regexp.QuoteMeta("DELETE FROM webhooks WHERE id = ?")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(deleteDeliveries).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+				s.mock.ExpectExec(deleteWebhook).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+				s.mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Error DB (rollback deliveries)",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(deleteDeliveries).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+				s.mock.ExpectExec(deleteWebhook).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				s.mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.DeleteByID(tt.ctx, 1)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *WebhookRepositoryTestSuite) TestRecordFailure() {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	query := regexp.QuoteMeta("UPDATE webhooks SET failure_count = failure_count + 1")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WithArgs(20, sqlmock.AnyArg(), 20, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Error DB",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := s.repo.RecordFailure(tt.ctx, 1, 20)
+
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"gorm.io/gorm"
+)
+
+// ts_headline does not escape the text, matches are wrapped in private use characters then replaced by
+// <mark></mark> after the snippet is HTML escaped
+const (
+	highlightStartSel = "\ue000"
+	highlightStopSel  = "\ue001"
+)
+
+// TodoListSearchRepository is the PostgreSQL implementation of todo_list_usecase.TodoListSearcher.
+// It requires the tsvector column with GIN index on todo_lists table added by
+// database/migration/postgresql/000014_add_search_vector_to_todo_lists.up.sql
+type TodoListSearchRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoListSearchRepository(postgre *config.PostgreSQL) *TodoListSearchRepository {
+	return &TodoListSearchRepository{db: postgre.DB}
+}
+
+func (r *TodoListSearchRepository) Search(ctx context.Context, userID int64, query string, limit int, offset int) (result []*entity.TodoListSearchResult, err error) {
+	funcName := "TodoListSearchRepository.Search"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	err = r.db.WithContext(ctx).Raw(`SELECT t.*, ts_rank(t.search_vector, q) AS relevance,
+			ts_headline('simple', coalesce(t.title, ''), q, 'StartSel=`+highlightStartSel+`, StopSel=`+highlightStopSel+`, HighlightAll=true') AS title_snippet,
+			ts_headline('simple', coalesce(t.description, ''), q, 'StartSel=`+highlightStartSel+`, StopSel=`+highlightStopSel+`, MaxFragments=1') AS description_snippet
+		FROM todo_lists t, plainto_tsquery('simple', ?) q
+		WHERE t.user_id = ? AND t.search_vector @@ q
+		ORDER BY relevance DESC, t.id DESC
+		LIMIT ? OFFSET ?`, query, userID, limit, offset).
+		Scan(&result).Error
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	for _, v := range result {
+		v.TitleSnippet = escapeHeadline(v.TitleSnippet)
+		v.DescriptionSnippet = escapeHeadline(v.DescriptionSnippet)
+	}
+
+	return result, nil
+}
+
+// escapeHeadline HTML escapes a ts_headline snippet like helper.HighlightSnippet so it is safe to be rendered
+// by clients, only the matches are wrapped in <mark></mark>
+func escapeHeadline(snippet string) string {
+	return strings.NewReplacer(highlightStartSel, "<mark>", highlightStopSel, "</mark>").
+		Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,100 @@
+package postgresql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/config"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/postgresql"
+	"github.com/stretchr/testify/suite"
+	gpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type TodoListSearchRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	db   *sql.DB
+	repo *postgresql.TodoListSearchRepository
+}
+
+func TestTodoListSearchRepository(t *testing.T) {
+	suite.Run(t, new(TodoListSearchRepositoryTestSuite))
+}
+
+func (s *TodoListSearchRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := gpostgres.New(gpostgres.Config{Conn: s.db})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = postgresql.NewTodoListSearchRepository(&config.PostgreSQL{DB: gormDB})
+}
+
+func (s *TodoListSearchRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *TodoListSearchRepositoryTestSuite) TestSearch() {
+	query := regexp.QuoteMeta("FROM todo_lists t, plainto_tsquery('simple', $1) q")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		mockSetup func()
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				expectedRows := sqlmock.NewRows([]string{"id", "user_id", "title", "relevance", "title_snippet"}).
+					AddRow(1, 1, "<b>Weekly</b> meeting", 0.5, "<b>Weekly</b> \ue000meeting\ue001")
+				s.mock.ExpectQuery(query).
+					WithArgs("meeting", 1, 20, 0).
+					WillReturnRows(expectedRows)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "Error Query",
+			ctx:  context.Background(),
+			mockSetup: func() {
+				s.mock.ExpectQuery(query).
+					WithArgs("meeting", 1, 20, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Context Cancelled",
+			ctx:       cancelledCtx,
+			mockSetup: func() {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mockSetup()
+			result, err := s.repo.Search(tt.ctx, 1, "meeting", 20, 0)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Len(result, tt.wantLen)
+				s.Equal("&lt;b&gt;Weekly&lt;/b&gt; <mark>meeting</mark>", result[0].TitleSnippet)
+			}
+		})
+	}
+}
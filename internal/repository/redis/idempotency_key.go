@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const idempotencyKeyPrefix = "idempotency:"
+
+type IdempotencyKeyRepository struct {
+	client *goredis.Client
+}
+
+func NewIdempotencyKeyRepository(client *goredis.Client) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{client}
+}
+
+// Reserve stores in progress record with SET NX, it returns false when the key already exists
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, params *entity.IdempotencyRecord) (bool, error) {
+	funcName := "IdempotencyKeyRepository.Reserve"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	value, err := json.Marshal(params)
+	if err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	reserved, err := r.client.SetNX(ctx, idempotencyKeyPrefix+params.Key, value, time.Until(params.ExpiresAt)).Result()
+	if err != nil {
+		return false, errwrap.Wrap(err, funcName)
+	}
+
+	return reserved, nil
+}
+
+// Get returns record of the key, nil when not found or expired
+func (r *IdempotencyKeyRepository) Get(ctx context.Context, key string) (*entity.IdempotencyRecord, error) {
+	funcName := "IdempotencyKeyRepository.Get"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	value, err := r.client.Get(ctx, idempotencyKeyPrefix+key).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	var result entity.IdempotencyRecord
+	if err := json.Unmarshal(value, &result); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return &result, nil
+}
+
+// Save stores the response of reserved key until its expiry
+func (r *IdempotencyKeyRepository) Save(ctx context.Context, params *entity.IdempotencyRecord) error {
+	funcName := "IdempotencyKeyRepository.Save"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	value, err := json.Marshal(params)
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.client.Set(ctx, idempotencyKeyPrefix+params.Key, value, time.Until(params.ExpiresAt)).Err(); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+// Delete releases the key so the request can be retried
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	funcName := "IdempotencyKeyRepository.Delete"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.client.Del(ctx, idempotencyKeyPrefix+key).Err(); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const rateLimitKeyPrefix = "ratelimit:"
+
+// The scripts count a request atomically using the clock of Redis so every instance shares the same window,
+// they return {allowed, remaining, reset ms, retry after ms}. ARGV is {limit, window ms}
+var (
+	slidingWindowScript = goredis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local start = now - now % window
+
+local state = redis.call('HMGET', KEYS[1], 'start', 'current', 'previous')
+local current = tonumber(state[2]) or 0
+local previous = tonumber(state[3]) or 0
+if tonumber(state[1]) ~= start then
+	if tonumber(state[1]) == start - window then
+		previous = current
+	else
+		previous = 0
+	end
+	current = 0
+end
+
+local elapsed = now - start
+local count = previous * (window - elapsed) / window + current
+local reset = start + 2 * window - now
+
+if count + 1 > limit then
+	local retry
+	if current + 1 > limit then
+		retry = start + window - now + math.ceil(window * (1 - (limit - 1) / current))
+	else
+		retry = math.ceil(window * (1 - (limit - 1 - current) / previous)) - elapsed
+	end
+	return {0, 0, reset, math.max(retry, 0)}
+end
+
+redis.call('HSET', KEYS[1], 'start', start, 'current', current + 1, 'previous', previous)
+redis.call('PEXPIREAT', KEYS[1], start + 2 * window)
+
+return {1, math.floor(limit - count - 1), reset, 0}
+`)
+
+	tokenBucketScript = goredis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local rate = limit / window
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1]) or limit
+local updated = tonumber(state[2]) or now
+tokens = math.min(limit, tokens + math.max(now - updated, 0) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', now)
+redis.call('PEXPIRE', KEYS[1], window)
+
+return {allowed, math.floor(tokens), math.ceil((limit - tokens) / rate), retry}
+`)
+)
+
+type RateLimitRepository struct {
+	client *goredis.Client
+}
+
+func NewRateLimitRepository(client *goredis.Client) *RateLimitRepository {
+	return &RateLimitRepository{client}
+}
+
+// Take counts a request of key under policy with a Lua script and returns the state of the key after it
+func (r *RateLimitRepository) Take(ctx context.Context, key string, policy entity.RateLimitPolicy) (*entity.RateLimitResult, error) {
+	funcName := "RateLimitRepository.Take"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	window := policy.Window.Milliseconds()
+	if policy.Limit < 1 || window < 1 {
+		return nil, errwrap.Wrap(fmt.Errorf("invalid rate limit policy %q", policy.Name), funcName)
+	}
+
+	script := slidingWindowScript
+	if policy.Algorithm == entity.RateLimitTokenBucket {
+		script = tokenBucketScript
+	}
+
+	values, err := script.Run(ctx, r.client, []string{rateLimitKeyPrefix + key}, policy.Limit, window).Int64Slice()
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+	if len(values) != 4 {
+		return nil, errwrap.Wrap(fmt.Errorf("unexpected rate limit script result %v", values), funcName)
+	}
+
+	return &entity.RateLimitResult{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		Reset:      time.Duration(values[2]) * time.Millisecond,
+		RetryAfter: time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitRepositoryTestSuite struct {
+	suite.Suite
+	server *miniredis.Miniredis
+	repo   *redis.RateLimitRepository
+	now    time.Time
+}
+
+func TestRateLimitRepository(t *testing.T) {
+	suite.Run(t, new(RateLimitRepositoryTestSuite))
+}
+
+func (s *RateLimitRepositoryTestSuite) SetupTest() {
+	s.server = miniredis.RunT(s.T())
+	s.repo = redis.NewRateLimitRepository(goredis.NewClient(&goredis.Options{Addr: s.server.Addr()}))
+
+	// The scripts read the clock of Redis, windows start at multiples of the window
+	s.now = time.UnixMilli(1_700_000_040_000)
+	s.server.SetTime(s.now)
+}
+
+func (s *RateLimitRepositoryTestSuite) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	s.server.SetTime(s.now)
+	s.server.FastForward(d)
+}
+
+func (s *RateLimitRepositoryTestSuite) take(policy entity.RateLimitPolicy) *entity.RateLimitResult {
+	result, err := s.repo.Take(context.Background(), "user:1", policy)
+	s.Require().NoError(err)
+
+	return result
+}
+
+func (s *RateLimitRepositoryTestSuite) TestSlidingWindow() {
+	policy := entity.RateLimitPolicy{Name: "write", Limit: 2, Window: time.Minute, Algorithm: entity.RateLimitSlidingWindow}
+
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 1, Reset: 2 * time.Minute}, s.take(policy))
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 0, Reset: 2 * time.Minute}, s.take(policy))
+
+	// Half of both requests slides out of the last window 30 seconds after the next window starts
+	s.Equal(&entity.RateLimitResult{Reset: 2 * time.Minute, RetryAfter: 90 * time.Second}, s.take(policy))
+	s.Equal(2*time.Minute, s.server.TTL("ratelimit:user:1"))
+
+	// Half of the previous window overlaps the last window, it counts as a single request
+	s.advance(90 * time.Second)
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 0, Reset: 90 * time.Second}, s.take(policy))
+	s.Equal(&entity.RateLimitResult{Reset: 90 * time.Second, RetryAfter: 30 * time.Second}, s.take(policy))
+
+	// Counters of a window older than the previous one are dropped
+	s.advance(3 * time.Minute)
+	s.True(s.take(policy).Allowed)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestTokenBucket() {
+	policy := entity.RateLimitPolicy{Name: "read", Limit: 2, Window: time.Second, Algorithm: entity.RateLimitTokenBucket}
+
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 1, Reset: 500 * time.Millisecond}, s.take(policy))
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}, s.take(policy))
+	s.Equal(&entity.RateLimitResult{Reset: time.Second, RetryAfter: 500 * time.Millisecond}, s.take(policy))
+	s.Equal(time.Second, s.server.TTL("ratelimit:user:1"))
+
+	// A token is refilled every window / limit
+	s.advance(500 * time.Millisecond)
+	s.Equal(&entity.RateLimitResult{Allowed: true, Remaining: 0, Reset: time.Second}, s.take(policy))
+}
+
+func (s *RateLimitRepositoryTestSuite) TestKeys() {
+	policy := entity.RateLimitPolicy{Name: "auth", Limit: 1, Window: time.Minute}
+
+	s.True(s.take(policy).Allowed)
+
+	result, err := s.repo.Take(context.Background(), "user:2", policy)
+	s.NoError(err)
+	s.True(result.Allowed)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestError() {
+	_, err := s.repo.Take(context.Background(), "user:1", entity.RateLimitPolicy{Name: "invalid", Window: time.Minute})
+	s.Error(err)
+
+	s.server.Close()
+	_, err = s.repo.Take(context.Background(), "user:1", entity.RateLimitPolicy{Name: "write", Limit: 1, Window: time.Minute})
+	s.Error(err)
+}
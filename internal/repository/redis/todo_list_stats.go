@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errwrap "github.com/pkg/errors"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const todoListStatsKeyPrefix = "todo_list_stats:"
+
+// ITodoListStatsCache caches serialized statistics per user, every field of a user is invalidated at once
+type ITodoListStatsCache interface {
+	Get(ctx context.Context, userID int64, field string) ([]byte, error)
+	Set(ctx context.Context, userID int64, field string, value []byte) error
+	Invalidate(ctx context.Context, userID int64) error
+}
+
+// TodoListStatsCache stores user statistics in a hash (one field per request parameters)
+// so writes of the user can drop them with a single DEL
+type TodoListStatsCache struct {
+	client *goredis.Client
+	ttl    time.Duration
+}
+
+func NewTodoListStatsCache(client *goredis.Client, ttl time.Duration) *TodoListStatsCache {
+	return &TodoListStatsCache{client, ttl}
+}
+
+// Get returns cached value, nil when not found
+func (r *TodoListStatsCache) Get(ctx context.Context, userID int64, field string) ([]byte, error) {
+	funcName := "TodoListStatsCache.Get"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	value, err := r.client.HGet(ctx, todoListStatsKey(userID), field).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errwrap.Wrap(err, funcName)
+	}
+
+	return value, nil
+}
+
+// Set stores value, the TTL of the hash is reset so it expires ttl after the latest write
+func (r *TodoListStatsCache) Set(ctx context.Context, userID int64, field string, value []byte) error {
+	funcName := "TodoListStatsCache.Set"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	key := todoListStatsKey(userID)
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.HSet(ctx, key, field, value)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+func (r *TodoListStatsCache) Invalidate(ctx context.Context, userID int64) error {
+	funcName := "TodoListStatsCache.Invalidate"
+
+	if err := helper.CheckDeadline(ctx); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	if err := r.client.Del(ctx, todoListStatsKey(userID)).Err(); err != nil {
+		return errwrap.Wrap(err, funcName)
+	}
+
+	return nil
+}
+
+func todoListStatsKey(userID int64) string {
+	return todoListStatsKeyPrefix + helper.ToString(userID)
+}
@@ -0,0 +1,68 @@
+// Package requestid carries the X-Request-ID of a request through context.Context, so log lines and queue
+// messages produced while handling the request can be tied to it
+package requestid
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header is the HTTP header of the request ID, it is echoed in every response
+	Header = "X-Request-ID"
+	// AMQPHeader is the header of queue messages published with a request ID
+	AMQPHeader = "x-request-id"
+	// PayloadKey is the key of the request ID in the payload passed to queue consumers, see FromPayload
+	PayloadKey = "_request_id"
+)
+
+// validID limits request IDs sent by clients, anything else (ex. line breaks) is replaced with a new ID
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:\-]{1,128}$`)
+
+type contextKey struct{}
+
+// userValueSetter is implemented by *fasthttp.RequestCtx (fiber.Ctx.Context()) which is passed to usecases
+type userValueSetter interface {
+	SetUserValue(key interface{}, value interface{})
+}
+
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether a request ID sent by the client can be used as is
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// Store sets the request ID on a request context that stores values in place, ex. *fasthttp.RequestCtx
+func Store(ctx userValueSetter, id string) {
+	ctx.SetUserValue(contextKey{}, id)
+}
+
+// FromContext returns the request ID of ctx, empty when there is none
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// FromPayload returns ctx with the request ID of a consumed queue message, ctx is returned as is when the
+// message has none
+func FromPayload(ctx context.Context, payload map[string]interface{}) context.Context {
+	id, _ := payload[PayloadKey].(string)
+	if id == "" {
+		return ctx
+	}
+
+	return NewContext(ctx, id)
+}
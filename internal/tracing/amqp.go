@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// AMQPHeaders adapts the headers of a RabbitMQ message to propagation.TextMapCarrier, so the W3C trace context
+// (traceparent, tracestate) is sent along with published messages
+type AMQPHeaders amqp.Table
+
+func (h AMQPHeaders) Get(key string) string {
+	value, _ := h[key].(string)
+	return value
+}
+
+func (h AMQPHeaders) Set(key string, value string) {
+	h[key] = value
+}
+
+func (h AMQPHeaders) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
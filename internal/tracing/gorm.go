@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+type gormPlugin struct{}
+
+// NewGormPlugin returns a GORM plugin starting a client span for every query, the span is a child of the
+// span of the context passed with db.WithContext(ctx)
+func NewGormPlugin() gorm.Plugin {
+	return gormPlugin{}
+}
+
+func (gormPlugin) Name() string {
+	return "tracing"
+}
+
+func (p gormPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("tracing:before_create", p.before("create")),
+		callbacks.Create().After("gorm:create").Register("tracing:after_create", p.after),
+		callbacks.Query().Before("gorm:query").Register("tracing:before_query", p.before("query")),
+		callbacks.Query().After("gorm:query").Register("tracing:after_query", p.after),
+		callbacks.Update().Before("gorm:update").Register("tracing:before_update", p.before("update")),
+		callbacks.Update().After("gorm:update").Register("tracing:after_update", p.after),
+		callbacks.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before("delete")),
+		callbacks.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+		callbacks.Row().Before("gorm:row").Register("tracing:before_row", p.before("row")),
+		callbacks.Row().After("gorm:row").Register("tracing:after_row", p.after),
+		callbacks.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before("raw")),
+		callbacks.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+	)
+}
+
+func (p gormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx, span := Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(dbSystem(db), semconv.DBOperationName(operation)),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func (p gormPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBCollectionName(db.Statement.Table))
+	}
+	// Query text has placeholders, values are not recorded
+	span.SetAttributes(semconv.DBQueryText(db.Statement.SQL.String()))
+	if db.Statement.RowsAffected >= 0 {
+		span.SetAttributes(attribute.Int64("db.rows_affected", db.Statement.RowsAffected))
+	}
+
+	// Record not found is an expected result of a query, not a failure
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
+
+func dbSystem(db *gorm.DB) attribute.KeyValue {
+	switch db.Dialector.Name() {
+	case "mysql":
+		return semconv.DBSystemMySQL
+	case "postgres":
+		return semconv.DBSystemPostgreSQL
+	default:
+		return semconv.DBSystemKey.String(db.Dialector.Name())
+	}
+}
@@ -0,0 +1,86 @@
+// Package tracing instruments the API, database, queue and MongoDB calls with OpenTelemetry spans. Spans are
+// only recorded when a tracer provider is set up (see config.NewTracerProvider), otherwise they are no-op
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// InstrumentationName is the name of the tracer used by every span of the app
+	InstrumentationName = "github.com/rahmatrdn/go-skeleton"
+	// PayloadKey is the key of the trace context in the payload passed to queue consumers, see FromPayload
+	PayloadKey = "_trace_context"
+)
+
+type spanKey struct{}
+
+// userValueSetter is implemented by *fasthttp.RequestCtx (fiber.Ctx.Context()) which is passed to usecases
+type userValueSetter interface {
+	SetUserValue(key interface{}, value interface{})
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Start starts a span as child of the span of ctx, including the span stored with Store
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(parentContext(ctx), name, opts...)
+}
+
+// Store sets span on a request context that stores values in place, ex. *fasthttp.RequestCtx. The context
+// keys of OpenTelemetry are not exported so Start looks up the span stored here when ctx has none
+func Store(ctx userValueSetter, span trace.Span) {
+	ctx.SetUserValue(spanKey{}, span)
+}
+
+// End records err (if any) on span then ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Inject writes the trace context of ctx to carrier, ex. headers of outgoing messages
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(parentContext(ctx), carrier)
+}
+
+// Extract returns ctx with the trace context read from carrier, ex. headers of incoming requests
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// FromPayload returns ctx with the trace context of a consumed queue message, ctx is returned as is when the
+// message has none
+func FromPayload(ctx context.Context, payload map[string]interface{}) context.Context {
+	carrier, ok := payload[PayloadKey].(propagation.MapCarrier)
+	if !ok {
+		return ctx
+	}
+
+	return Extract(ctx, carrier)
+}
+
+func parentContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	if span, ok := ctx.Value(spanKey{}).(trace.Span); ok {
+		return trace.ContextWithSpan(ctx, span)
+	}
+
+	return ctx
+}
@@ -0,0 +1,120 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rahmatrdn/go-skeleton/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func setupExporter() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return exporter
+}
+
+// userValues imitates *fasthttp.RequestCtx which stores values in place
+type userValues struct {
+	context.Context
+	values map[interface{}]interface{}
+}
+
+func (u *userValues) SetUserValue(key interface{}, value interface{}) {
+	u.values[key] = value
+}
+
+func (u *userValues) Value(key interface{}) interface{} {
+	if value, ok := u.values[key]; ok {
+		return value
+	}
+
+	return u.Context.Value(key)
+}
+
+func TestStore(t *testing.T) {
+	exporter := setupExporter()
+
+	_, parent := tracing.Start(context.Background(), "parent")
+	ctx := &userValues{context.Background(), map[interface{}]interface{}{}}
+	tracing.Store(ctx, parent)
+
+	_, child := tracing.Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+}
+
+func TestPayload(t *testing.T) {
+	setupExporter()
+
+	ctx, span := tracing.Start(context.Background(), "publish")
+	defer span.End()
+
+	carrier := propagation.MapCarrier{}
+	tracing.Inject(ctx, carrier)
+
+	restored := tracing.FromPayload(context.Background(), map[string]interface{}{tracing.PayloadKey: carrier})
+	assert.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(restored).TraceID())
+
+	// Message without trace context
+	assert.False(t, trace.SpanContextFromContext(tracing.FromPayload(context.Background(), map[string]interface{}{})).IsValid())
+}
+
+func TestGormPlugin(t *testing.T) {
+	exporter := setupExporter()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(gmysql.New(gmysql.Config{Conn: db, SkipInitializeWithVersion: true}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, gormDB.Use(tracing.NewGormPlugin()))
+
+	ctx, parent := tracing.Start(context.Background(), "request")
+
+	t.Run("Success", func(t *testing.T) {
+		exporter.Reset()
+		mock.ExpectQuery("SELECT (.+) FROM todo_lists").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		var result []map[string]interface{}
+		require.NoError(t, gormDB.WithContext(ctx).Raw("SELECT * FROM todo_lists WHERE user_id = ?", 1).Scan(&result).Error)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "gorm.row", spans[0].Name)
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		assert.Contains(t, spans[0].Attributes, attribute.String("db.system", "mysql"))
+		assert.Contains(t, spans[0].Attributes, attribute.String("db.query.text", "SELECT * FROM todo_lists WHERE user_id = ?"))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		exporter.Reset()
+		mock.ExpectExec("DELETE FROM todo_lists").WillReturnError(errors.New("db error"))
+
+		require.Error(t, gormDB.WithContext(ctx).Exec("DELETE FROM todo_lists WHERE id = ?", 1).Error)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "gorm.raw", spans[0].Name)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+}
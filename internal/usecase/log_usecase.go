@@ -1,12 +1,14 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"os"
 
 	"github.com/rahmatrdn/go-skeleton/entity"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
 	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
 	"go.uber.org/zap"
 )
 
@@ -27,6 +29,9 @@ type LogUsecase interface {
 	Log(status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string)
 	Error(process string, funcName string, err error, logFields map[string]string)
 	Info(message string, funcName string, logFields map[string]string, processName string)
+	LogContext(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string)
+	ErrorContext(ctx context.Context, process string, funcName string, err error, logFields map[string]string)
+	InfoContext(ctx context.Context, message string, funcName string, logFields map[string]string, processName string)
 }
 
 // Process writing log to file.
@@ -38,6 +43,17 @@ type LogUsecase interface {
 //   - logFields: additional data to track error (Ex. Indetifier ID, User ID, etc.)
 //   - processName: name of process (optional, this can be use to track bug by process name) and make sure using Type Safety to write process name
 func (w *Log) Log(status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string) {
+	w.write(context.Background(), status, message, funcName, err, logFields, processName)
+}
+
+// LogContext is Log with the request ID of ctx (if any) written to the log and sent along with the queue message
+func (w *Log) LogContext(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string) {
+	w.write(ctx, status, message, funcName, err, logFields, processName)
+}
+
+func (w *Log) write(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string) {
+	requestID := requestid.FromContext(ctx)
+
 	logData := entity.Log{
 		Process:      processName,
 		FuncName:     funcName,
@@ -45,13 +61,14 @@ func (w *Log) Log(status entity.LogType, message string, funcName string, err er
 		ErrorMessage: err.Error(),
 		Status:       status,
 		LogFields:    logFields,
+		RequestID:    requestID,
 	}
 
 	payload, _ := helper.Serialize(logData)
-	errQueue := w.queue.Publish(queue.ProcessSyncLog, payload, 1)
+	errQueue := w.queue.PublishWithContext(ctx, queue.ProcessSyncLog, payload, 1)
 
 	// Writing Log with Zap Logger
-	logger := w.zapLogger.WithOptions(zap.AddCallerSkip(1))
+	logger := w.zapLogger.WithOptions(zap.AddCallerSkip(2))
 
 	fields := []zap.Field{
 		zap.String("process", processName),
@@ -60,6 +77,9 @@ func (w *Log) Log(status entity.LogType, message string, funcName string, err er
 		zap.String("errorMessage", err.Error()),
 		zap.Any("logFields", logFields),
 	}
+	if requestID != "" {
+		fields = append(fields, zap.String("requestId", requestID))
+	}
 
 	// If error when publish to queue, write log to file
 	if errQueue != nil || (helper.GetAppEnv() != entity.PRODUCTION_ENV && os.Getenv("DEBUG_MODE") == "true") {
@@ -74,9 +94,17 @@ func (w *Log) Log(status entity.LogType, message string, funcName string, err er
 }
 
 func (w *Log) Error(process string, funcName string, err error, logFields map[string]string) {
-	w.Log(entity.LogError, process, funcName, err, logFields, process)
+	w.write(context.Background(), entity.LogError, process, funcName, err, logFields, process)
 }
 
 func (w *Log) Info(message string, funcName string, logFields map[string]string, processName string) {
-	w.Log(entity.LogInfo, message, funcName, errors.New(""), logFields, processName)
+	w.write(context.Background(), entity.LogInfo, message, funcName, errors.New(""), logFields, processName)
+}
+
+func (w *Log) ErrorContext(ctx context.Context, process string, funcName string, err error, logFields map[string]string) {
+	w.write(ctx, entity.LogError, process, funcName, err, logFields, process)
+}
+
+func (w *Log) InfoContext(ctx context.Context, message string, funcName string, logFields map[string]string, processName string) {
+	w.write(ctx, entity.LogInfo, message, funcName, errors.New(""), logFields, processName)
 }
@@ -1,11 +1,15 @@
 package usecase_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/rahmatrdn/go-skeleton/config"
 	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/requestid"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase"
 	"github.com/rahmatrdn/go-skeleton/tests/mocks"
 	"github.com/stretchr/testify/mock"
@@ -43,13 +47,13 @@ func (s *LogUsecaseTestSuite) TestLog() {
 		{
 			name: "success",
 			mockFunc: func() {
-				s.queue.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.queue.On("PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 		},
 		{
 			name: "error publish queue",
 			mockFunc: func() {
-				s.queue.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
+				s.queue.On("PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("ERROR")).Once()
 			},
 			wantErr: true,
 		},
@@ -68,7 +72,7 @@ func (s *LogUsecaseTestSuite) TestError() {
 	captureFieldError := map[string]string{"test": "test"}
 
 	s.T().Run("Success", func(t *testing.T) {
-		s.queue.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.queue.On("PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 		s.usecase.Error("TestProcess", "TestFunc", fmt.Errorf("error"), captureFieldError)
 	})
 }
@@ -77,12 +81,29 @@ func (s *LogUsecaseTestSuite) TestInfo() {
 	captureFieldError := map[string]string{"test": "test"}
 
 	s.T().Run("Success", func(t *testing.T) {
-		s.queue.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.queue.On("PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 		s.usecase.Info("Test Message", "TestFunc", captureFieldError, "TestProcess")
 	})
 
 	s.T().Run("Queue Error", func(t *testing.T) {
-		s.queue.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("queue error")).Once()
+		s.queue.On("PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("queue error")).Once()
 		s.usecase.Info("Test Info Error", "TestFunc", captureFieldError, "TestProcess")
 	})
 }
+
+func (s *LogUsecaseTestSuite) TestErrorContext() {
+	captureFieldError := map[string]string{"test": "test"}
+	ctx := requestid.NewContext(context.Background(), "req-123")
+
+	s.T().Run("Success", func(t *testing.T) {
+		s.queue.On("PublishWithContext", ctx, queue.ProcessSyncLog, mock.MatchedBy(func(payload []byte) bool {
+			var log entity.Log
+			_ = json.Unmarshal(payload, &log)
+
+			return log.RequestID == "req-123" && log.FuncName == "TestFunc"
+		}), int32(1)).Return(nil).Once()
+		s.usecase.ErrorContext(ctx, "TestProcess", "TestFunc", fmt.Errorf("error"), captureFieldError)
+
+		s.queue.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "encoding/json"
+
+type NotificationListReq struct {
+	UserID int64 `query:"-" swaggerignore:"true"`
+	Page   int   `query:"page" validate:"omitempty,min=1" name:"Halaman" name_en:"Page"`
+	Limit  int   `query:"limit" validate:"omitempty,min=1,max=100" name:"Batas" name_en:"Limit"`
+}
+
+type NotificationResponse struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Title     string          `json:"title"`
+	Body      string          `json:"body"`
+	Data      json.RawMessage `json:"data" swaggertype:"object"`
+	IsRead    bool            `json:"is_read"`
+	ReadAt    string          `json:"read_at,omitempty"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Notifications []*NotificationResponse `json:"notifications"`
+	UnreadCount   int64                   `json:"unread_count"`
+	Total         int64                   `json:"total"`
+	Page          int                     `json:"page"`
+	Limit         int                     `json:"limit"`
+}
+
+type MarkAllReadResponse struct {
+	Updated int64 `json:"updated"`
+}
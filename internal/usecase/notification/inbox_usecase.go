@@ -0,0 +1,217 @@
+package notification_usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/notification/entity"
+)
+
+const (
+	defaultNotificationLimit = 20
+	notificationPruneBatch   = 1000
+)
+
+type InboxNotificationUsecase struct {
+	notificationRepo mysql.INotificationRepository
+	notifier         notification.Notifier
+}
+
+// NewInboxNotificationUsecase creates in-app notification usecase. Notify stores the message for the
+// notification bell then passes it to notifier (ex. notification.QueueNotifier for email), notifier can be nil
+func NewInboxNotificationUsecase(
+	notificationRepo mysql.INotificationRepository,
+	notifier notification.Notifier,
+) *InboxNotificationUsecase {
+	return &InboxNotificationUsecase{notificationRepo, notifier}
+}
+
+type IInboxNotificationUsecase interface {
+	Notify(ctx context.Context, message notification.Message) error
+	GetByUserID(ctx context.Context, req entity.NotificationListReq) (*entity.NotificationListResponse, error)
+	MarkRead(ctx context.Context, userID int64, ID int64) (*entity.NotificationResponse, error)
+	MarkAllRead(ctx context.Context, userID int64) (*entity.MarkAllReadResponse, error)
+	Prune(ctx context.Context, readBefore time.Time) (int64, error)
+}
+
+// Notify stores message as an unread in-app notification then passes it to the notifier, other usecases
+// (ex. reminders) use it as their notification.Notifier. The notifier error is returned so the caller can retry
+func (t *InboxNotificationUsecase) Notify(ctx context.Context, message notification.Message) error {
+	funcName := "InboxNotificationUsecase.Notify"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(message.UserID),
+		"type":    message.Type,
+	}
+
+	data := []byte("{}")
+	if len(message.Data) > 0 {
+		var err error
+		if data, err = json.Marshal(message.Data); err != nil {
+			return err
+		}
+	}
+
+	err := t.notificationRepo.Create(ctx, &mentity.Notification{
+		UserID:    message.UserID,
+		Type:      message.Type,
+		Title:     message.Subject,
+		Body:      message.Body,
+		Data:      string(data),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		helper.LogErrorContext(ctx, "notificationRepo.Create", funcName, err, captureFieldError, "")
+
+		return err
+	}
+
+	if t.notifier != nil {
+		if err := t.notifier.Notify(ctx, message); err != nil {
+			helper.LogErrorContext(ctx, "notifier.Notify", funcName, err, captureFieldError, "")
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByUserID returns a page of user notifications ordered from the newest with the unread count
+func (t *InboxNotificationUsecase) GetByUserID(ctx context.Context, req entity.NotificationListReq) (*entity.NotificationListResponse, error) {
+	funcName := "InboxNotificationUsecase.GetByUserID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(req.UserID),
+	}
+
+	if err := usecase.ValidateStruct(ctx, req); err != nil {
+		return nil, err
+	}
+
+	page := max(req.Page, 1)
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultNotificationLimit
+	}
+
+	result, err := t.notificationRepo.GetByUserID(ctx, req.UserID, limit, (page-1)*limit)
+	if err != nil {
+		helper.LogErrorContext(ctx, "notificationRepo.GetByUserID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	count, err := t.notificationRepo.CountByUserID(ctx, req.UserID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "notificationRepo.CountByUserID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res := &entity.NotificationListResponse{
+		Notifications: make([]*entity.NotificationResponse, 0, len(result)),
+		UnreadCount:   count.Unread,
+		Total:         count.Total,
+		Page:          page,
+		Limit:         limit,
+	}
+	for _, v := range result {
+		res.Notifications = append(res.Notifications, newNotificationResponse(v))
+	}
+
+	return res, nil
+}
+
+func (t *InboxNotificationUsecase) MarkRead(ctx context.Context, userID int64, ID int64) (*entity.NotificationResponse, error) {
+	funcName := "InboxNotificationUsecase.MarkRead"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(userID),
+		"id":      helper.ToString(ID),
+	}
+
+	data, err := t.notificationRepo.GetByID(ctx, ID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "notificationRepo.GetByID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+	if data == nil || data.UserID != userID {
+		return nil, apperr.ErrRecordNotFound()
+	}
+
+	if data.ReadAt == nil {
+		now := time.Now()
+		if err := t.notificationRepo.MarkRead(ctx, ID, now); err != nil {
+			helper.LogErrorContext(ctx, "notificationRepo.MarkRead", funcName, err, captureFieldError, "")
+
+			return nil, err
+		}
+		data.ReadAt = &now
+	}
+
+	return newNotificationResponse(data), nil
+}
+
+func (t *InboxNotificationUsecase) MarkAllRead(ctx context.Context, userID int64) (*entity.MarkAllReadResponse, error) {
+	funcName := "InboxNotificationUsecase.MarkAllRead"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(userID),
+	}
+
+	updated, err := t.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		helper.LogErrorContext(ctx, "notificationRepo.MarkAllRead", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	return &entity.MarkAllReadResponse{Updated: updated}, nil
+}
+
+// Prune deletes notifications read before readBefore in batches, called periodically by the scheduler.
+// Unread notifications are kept
+func (t *InboxNotificationUsecase) Prune(ctx context.Context, readBefore time.Time) (int64, error) {
+	funcName := "InboxNotificationUsecase.Prune"
+
+	var total int64
+	for {
+		deleted, err := t.notificationRepo.DeleteReadBefore(ctx, readBefore, notificationPruneBatch)
+		if err != nil {
+			helper.LogErrorContext(ctx, "notificationRepo.DeleteReadBefore", funcName, err, generalEntity.CaptureFields{}, "")
+
+			return total, err
+		}
+
+		total += deleted
+		if deleted < notificationPruneBatch {
+			return total, nil
+		}
+	}
+}
+
+func newNotificationResponse(v *mentity.Notification) *entity.NotificationResponse {
+	res := &entity.NotificationResponse{
+		ID:        v.ID,
+		Type:      v.Type,
+		Title:     v.Title,
+		Body:      v.Body,
+		Data:      json.RawMessage(v.Data),
+		IsRead:    v.ReadAt != nil,
+		CreatedAt: helper.ConvertToJakartaTime(v.CreatedAt),
+	}
+	if v.Data == "" {
+		res.Data = json.RawMessage("{}")
+	}
+	if v.ReadAt != nil {
+		res.ReadAt = helper.ConvertToJakartaTime(*v.ReadAt)
+	}
+
+	return res
+}
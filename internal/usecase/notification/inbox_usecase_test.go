@@ -0,0 +1,296 @@
+package notification_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/notification/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboxNotificationUsecaseTestSuite struct {
+	suite.Suite
+	usecase  *notification_usecase.InboxNotificationUsecase
+	repo     *mocks.INotificationRepository
+	notifier *mocks.Notifier
+}
+
+func (s *InboxNotificationUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.INotificationRepository{}
+	s.notifier = &mocks.Notifier{}
+
+	s.usecase = notification_usecase.NewInboxNotificationUsecase(s.repo, s.notifier)
+}
+
+func TestInboxNotificationUsecase(t *testing.T) {
+	suite.Run(t, new(InboxNotificationUsecaseTestSuite))
+}
+
+func (s *InboxNotificationUsecaseTestSuite) TestNotify() {
+	ctx := context.Background()
+	message := notification.Message{
+		UserID:  1,
+		Type:    notification.TypeTodoReminder,
+		Subject: "Reminder: Weekly meeting",
+		Body:    "Weekly meeting is due at 2024-01-10 09:00:00",
+		Data:    map[string]interface{}{"todo_list_id": 10},
+	}
+	matchNotification := mock.MatchedBy(func(n *mentity.Notification) bool {
+		return n.UserID == 1 && n.Title == message.Subject && n.Body == message.Body && n.Data == `{"todo_list_id":10}`
+	})
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("Create", ctx, matchNotification).Return(nil).Once()
+				s.notifier.On("Notify", ctx, message).Return(nil).Once()
+			},
+		},
+		{
+			name: "Error Create",
+			mockFunc: func() {
+				s.repo.On("Create", ctx, mock.Anything).Return(errors.New("connection refused")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error Notify",
+			mockFunc: func() {
+				s.repo.On("Create", ctx, mock.Anything).Return(nil).Once()
+				s.notifier.On("Notify", ctx, message).Return(errors.New("queue closed")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.mockFunc()
+
+			err := s.usecase.Notify(ctx, message)
+
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.repo.AssertExpectations(s.T())
+			s.notifier.AssertExpectations(s.T())
+		})
+	}
+
+	s.Run("Without Notifier", func() {
+		s.SetupTest()
+		s.repo.On("Create", ctx, mock.Anything).Return(nil).Once()
+
+		err := notification_usecase.NewInboxNotificationUsecase(s.repo, nil).Notify(ctx, notification.Message{UserID: 1})
+
+		s.NoError(err)
+		s.repo.AssertExpectations(s.T())
+	})
+}
+
+func (s *InboxNotificationUsecaseTestSuite) TestGetByUserID() {
+	ctx := context.Background()
+	readAt := time.Now()
+
+	testcases := []struct {
+		name       string
+		req        entity.NotificationListReq
+		mockFunc   func()
+		wantUnread int64
+		wantLen    int
+		wantErr    bool
+	}{
+		{
+			name: "Success",
+			req:  entity.NotificationListReq{UserID: 1, Page: 2, Limit: 10},
+			mockFunc: func() {
+				s.repo.On("GetByUserID", ctx, int64(1), 10, 10).Return([]*mentity.Notification{
+					{ID: 2, UserID: 1, Type: "todo.reminder", Data: `{"todo_list_id":10}`},
+					{ID: 1, UserID: 1, Type: "todo.reminder", Data: "{}", ReadAt: &readAt},
+				}, nil).Once()
+				s.repo.On("CountByUserID", ctx, int64(1)).Return(&mentity.NotificationCount{Total: 12, Unread: 1}, nil).Once()
+			},
+			wantUnread: 1,
+			wantLen:    2,
+		},
+		{
+			name: "Success Default Limit",
+			req:  entity.NotificationListReq{UserID: 1},
+			mockFunc: func() {
+				s.repo.On("GetByUserID", ctx, int64(1), 20, 0).Return([]*mentity.Notification{}, nil).Once()
+				s.repo.On("CountByUserID", ctx, int64(1)).Return(&mentity.NotificationCount{}, nil).Once()
+			},
+		},
+		{
+			name:     "Invalid Limit",
+			req:      entity.NotificationListReq{UserID: 1, Limit: 101},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error GetByUserID",
+			req:  entity.NotificationListReq{UserID: 1},
+			mockFunc: func() {
+				s.repo.On("GetByUserID", ctx, int64(1), 20, 0).Return(nil, errors.New("connection refused")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error CountByUserID",
+			req:  entity.NotificationListReq{UserID: 1},
+			mockFunc: func() {
+				s.repo.On("GetByUserID", ctx, int64(1), 20, 0).Return([]*mentity.Notification{}, nil).Once()
+				s.repo.On("CountByUserID", ctx, int64(1)).Return(nil, errors.New("connection refused")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.mockFunc()
+
+			res, err := s.usecase.GetByUserID(ctx, tc.req)
+
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.wantUnread, res.UnreadCount)
+				s.Len(res.Notifications, tc.wantLen)
+			}
+			s.repo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *InboxNotificationUsecaseTestSuite) TestMarkRead() {
+	ctx := context.Background()
+	readAt := time.Now()
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  error
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(2)).Return(&mentity.Notification{ID: 2, UserID: 1}, nil).Once()
+				s.repo.On("MarkRead", ctx, int64(2), mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "Success Already Read",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(2)).Return(&mentity.Notification{ID: 2, UserID: 1, ReadAt: &readAt}, nil).Once()
+			},
+		},
+		{
+			name: "Not Owner",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(2)).Return(&mentity.Notification{ID: 2, UserID: 3}, nil).Once()
+			},
+			wantErr: apperr.ErrRecordNotFound(),
+		},
+		{
+			name: "Not Found",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(2)).Return(nil, nil).Once()
+			},
+			wantErr: apperr.ErrRecordNotFound(),
+		},
+		{
+			name: "Error MarkRead",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(2)).Return(&mentity.Notification{ID: 2, UserID: 1}, nil).Once()
+				s.repo.On("MarkRead", ctx, int64(2), mock.Anything).Return(errors.New("connection refused")).Once()
+			},
+			wantErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.mockFunc()
+
+			res, err := s.usecase.MarkRead(ctx, 1, 2)
+
+			if tc.wantErr != nil {
+				s.EqualError(err, tc.wantErr.Error())
+			} else {
+				s.NoError(err)
+				s.True(res.IsRead)
+				s.NotEmpty(res.ReadAt)
+			}
+			s.repo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *InboxNotificationUsecaseTestSuite) TestMarkAllRead() {
+	ctx := context.Background()
+
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.repo.On("MarkAllRead", ctx, int64(1), mock.Anything).Return(int64(3), nil).Once()
+
+		res, err := s.usecase.MarkAllRead(ctx, 1)
+
+		s.NoError(err)
+		s.Equal(int64(3), res.Updated)
+	})
+
+	s.Run("Error MarkAllRead", func() {
+		s.SetupTest()
+		s.repo.On("MarkAllRead", ctx, int64(1), mock.Anything).Return(int64(0), errors.New("connection refused")).Once()
+
+		_, err := s.usecase.MarkAllRead(ctx, 1)
+
+		s.Error(err)
+	})
+}
+
+func (s *InboxNotificationUsecaseTestSuite) TestPrune() {
+	ctx := context.Background()
+	readBefore := time.Now().AddDate(0, 0, -30)
+
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.repo.On("DeleteReadBefore", ctx, readBefore, 1000).Return(int64(1000), nil).Once()
+		s.repo.On("DeleteReadBefore", ctx, readBefore, 1000).Return(int64(5), nil).Once()
+
+		deleted, err := s.usecase.Prune(ctx, readBefore)
+
+		s.NoError(err)
+		s.Equal(int64(1005), deleted)
+		s.repo.AssertExpectations(s.T())
+	})
+
+	s.Run("Error DeleteReadBefore", func() {
+		s.SetupTest()
+		s.repo.On("DeleteReadBefore", ctx, readBefore, 1000).Return(int64(0), errors.New("connection refused")).Once()
+
+		_, err := s.usecase.Prune(ctx, readBefore)
+
+		s.Error(err)
+	})
+}
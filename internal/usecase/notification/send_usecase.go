@@ -0,0 +1,60 @@
+package notification_usecase
+
+import (
+	"context"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+)
+
+type SendNotificationUsecase struct {
+	userRepo mysql.UserRepository
+	notifier notification.Notifier
+}
+
+// NewSendNotificationUsecase creates usecase used by the worker (topic notification.send) to send messages
+// with the configured driver (ex. SMTP)
+func NewSendNotificationUsecase(
+	userRepo mysql.UserRepository,
+	notifier notification.Notifier,
+) *SendNotificationUsecase {
+	return &SendNotificationUsecase{userRepo, notifier}
+}
+
+type ISendNotificationUsecase interface {
+	Send(ctx context.Context, message notification.Message) error
+}
+
+// Send fills the recipient email of the message from its user then sends it, message of a deleted user is dropped
+func (t *SendNotificationUsecase) Send(ctx context.Context, message notification.Message) error {
+	funcName := "SendNotificationUsecase.Send"
+	captureFieldError := entity.CaptureFields{
+		"user_id": helper.ToString(message.UserID),
+		"type":    message.Type,
+	}
+
+	if message.To == "" {
+		user, err := t.userRepo.GetByID(ctx, message.UserID)
+		if err != nil {
+			if _, ok := err.(apperr.CustomErrorResponse); ok {
+				return nil
+			}
+			helper.LogErrorContext(ctx, "userRepo.GetByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		message.To = user.Email
+	}
+
+	if err := t.notifier.Notify(ctx, message); err != nil {
+		helper.LogErrorContext(ctx, "notifier.Notify", funcName, err, captureFieldError, "")
+
+		return err
+	}
+
+	return nil
+}
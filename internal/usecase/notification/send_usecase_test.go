@@ -0,0 +1,103 @@
+package notification_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	notification_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/notification"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SendNotificationUsecaseTestSuite struct {
+	suite.Suite
+	usecase  *notification_usecase.SendNotificationUsecase
+	userRepo *mocks.UserRepository
+	notifier *mocks.Notifier
+}
+
+func (s *SendNotificationUsecaseTestSuite) SetupTest() {
+	s.userRepo = &mocks.UserRepository{}
+	s.notifier = &mocks.Notifier{}
+
+	s.usecase = notification_usecase.NewSendNotificationUsecase(s.userRepo, s.notifier)
+}
+
+func TestSendNotificationUsecase(t *testing.T) {
+	suite.Run(t, new(SendNotificationUsecaseTestSuite))
+}
+
+func (s *SendNotificationUsecaseTestSuite) TestSend() {
+	ctx := context.Background()
+	message := notification.Message{UserID: 1, Type: notification.TypeTodoReminder, Subject: "Reminder"}
+	withRecipient := message
+	withRecipient.To = "user@example.com"
+
+	testcases := []struct {
+		name     string
+		message  notification.Message
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name:    "Success",
+			message: message,
+			mockFunc: func() {
+				s.userRepo.On("GetByID", ctx, int64(1)).Return(&mentity.User{ID: 1, Email: "user@example.com"}, nil).Once()
+				s.notifier.On("Notify", ctx, withRecipient).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Success With Recipient",
+			message: withRecipient,
+			mockFunc: func() {
+				s.notifier.On("Notify", ctx, withRecipient).Return(nil).Once()
+			},
+		},
+		{
+			name:    "User Deleted",
+			message: message,
+			mockFunc: func() {
+				s.userRepo.On("GetByID", ctx, int64(1)).Return(nil, apperr.ErrUserNotFound()).Once()
+			},
+		},
+		{
+			name:    "Error GetByID",
+			message: message,
+			mockFunc: func() {
+				s.userRepo.On("GetByID", ctx, int64(1)).Return(nil, errors.New("connection refused")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error Notify",
+			message: withRecipient,
+			mockFunc: func() {
+				s.notifier.On("Notify", ctx, mock.Anything).Return(errors.New("smtp unavailable")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.mockFunc()
+
+			err := s.usecase.Send(ctx, tc.message)
+
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.userRepo.AssertExpectations(s.T())
+			s.notifier.AssertExpectations(s.T())
+		})
+	}
+}
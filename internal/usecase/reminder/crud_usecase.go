@@ -0,0 +1,131 @@
+package reminder_usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+)
+
+type CrudReminderUsecase struct {
+	todoListRepo         mysql.ITodoListRepository
+	todoListReminderRepo mysql.ITodoListReminderRepository
+	dueTime              time.Duration
+}
+
+// NewCrudReminderUsecase creates todo list reminder usecase, dueTime is the time of day (Asia/Jakarta)
+// a todo list is due on its doing date, reminders are sent their offset before it
+func NewCrudReminderUsecase(
+	todoListRepo mysql.ITodoListRepository,
+	todoListReminderRepo mysql.ITodoListReminderRepository,
+	dueTime time.Duration,
+) *CrudReminderUsecase {
+	return &CrudReminderUsecase{todoListRepo, todoListReminderRepo, dueTime}
+}
+
+type ICrudReminderUsecase interface {
+	GetByTodoListID(ctx context.Context, userID int64, todoListID int64) ([]*entity.ReminderResponse, error)
+	Set(ctx context.Context, reminderReq entity.SetReminderReq) ([]*entity.ReminderResponse, error)
+}
+
+func (t *CrudReminderUsecase) GetByTodoListID(ctx context.Context, userID int64, todoListID int64) ([]*entity.ReminderResponse, error) {
+	funcName := "CrudReminderUsecase.GetByTodoListID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":      helper.ToString(userID),
+		"todo_list_id": helper.ToString(todoListID),
+	}
+
+	todoList, err := t.getOwnedTodoList(ctx, funcName, userID, todoListID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.todoListReminderRepo.GetByTodoListID(ctx, todoListID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.GetByTodoListID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	return t.newReminderResponses(todoList, result), nil
+}
+
+func (t *CrudReminderUsecase) Set(ctx context.Context, reminderReq entity.SetReminderReq) ([]*entity.ReminderResponse, error) {
+	funcName := "CrudReminderUsecase.Set"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(reminderReq.UserID),
+		"payload": helper.ToString(reminderReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, reminderReq); err != nil {
+		return nil, err
+	}
+
+	todoList, err := t.getOwnedTodoList(ctx, funcName, reminderReq.UserID, reminderReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.todoListReminderRepo.ReplaceOffsets(ctx, todoList.ID, todoList.UserID, reminderReq.OffsetsMinutes); err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.ReplaceOffsets", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	result, err := t.todoListReminderRepo.GetByTodoListID(ctx, todoList.ID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.GetByTodoListID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	return t.newReminderResponses(todoList, result), nil
+}
+
+func (t *CrudReminderUsecase) getOwnedTodoList(ctx context.Context, funcName string, userID int64, todoListID int64) (*mentity.TodoList, error) {
+	data, err := t.todoListRepo.GetByID(ctx, todoListID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.GetByID", funcName, err, generalEntity.CaptureFields{
+			"user_id":      helper.ToString(userID),
+			"todo_list_id": helper.ToString(todoListID),
+		}, "")
+
+		return nil, err
+	}
+	if data == nil || data.UserID != userID {
+		return nil, apperr.ErrRecordNotFound()
+	}
+
+	return data, nil
+}
+
+// newReminderResponses returns reminders ordered by remind time
+func (t *CrudReminderUsecase) newReminderResponses(todoList *mentity.TodoList, reminders []*mentity.TodoListReminder) []*entity.ReminderResponse {
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].OffsetMinutes > reminders[j].OffsetMinutes
+	})
+
+	res := make([]*entity.ReminderResponse, 0, len(reminders))
+	for _, v := range reminders {
+		reminder := &entity.ReminderResponse{
+			ID:            v.ID,
+			TodoListID:    v.TodoListID,
+			OffsetMinutes: v.OffsetMinutes,
+			RemindAt:      helper.ConvertToJakartaTime(RemindAt(todoList.DoingAt, t.dueTime, v.OffsetMinutes)),
+		}
+		// Sent state of a previous doing date is not shown, the reminder is sent again for the new date
+		if v.SentAt != nil && v.SentFor != nil && v.SentFor.Format(dateLayout) == todoList.DoingAt.Format(dateLayout) {
+			reminder.SentAt = helper.ConvertToJakartaTime(*v.SentAt)
+		}
+		res = append(res, reminder)
+	}
+
+	return res
+}
@@ -0,0 +1,147 @@
+package reminder_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type CrudReminderUsecaseTestSuite struct {
+	suite.Suite
+	usecase      *reminder_usecase.CrudReminderUsecase
+	todoListRepo *mocks.ITodoListRepository
+	repo         *mocks.ITodoListReminderRepository
+	todoList     *mentity.TodoList
+}
+
+func (s *CrudReminderUsecaseTestSuite) SetupTest() {
+	s.todoListRepo = &mocks.ITodoListRepository{}
+	s.repo = &mocks.ITodoListReminderRepository{}
+	doingAt, _ := time.Parse("2006-01-02", "2024-01-10")
+	s.todoList = &mentity.TodoList{ID: 10, UserID: 1, Title: "Weekly meeting", DoingAt: doingAt}
+
+	s.usecase = reminder_usecase.NewCrudReminderUsecase(s.todoListRepo, s.repo, 9*time.Hour)
+}
+
+func TestCrudReminderUsecase(t *testing.T) {
+	suite.Run(t, new(CrudReminderUsecaseTestSuite))
+}
+
+func (s *CrudReminderUsecaseTestSuite) TestGetByTodoListID() {
+	ctx := context.Background()
+	sentFor := s.todoList.DoingAt
+	previousDate := s.todoList.DoingAt.AddDate(0, 0, -1)
+	sentAt := time.Date(2024, 1, 10, 1, 0, 0, 0, time.UTC)
+
+	s.todoListRepo.On("GetByID", ctx, int64(10)).Return(s.todoList, nil).Once()
+	s.repo.On("GetByTodoListID", ctx, int64(10)).Return([]*mentity.TodoListReminder{
+		{ID: 1, TodoListID: 10, OffsetMinutes: 60, SentFor: &sentFor, SentAt: &sentAt},
+		{ID: 2, TodoListID: 10, OffsetMinutes: 1440, SentFor: &previousDate, SentAt: &sentAt},
+	}, nil).Once()
+
+	res, err := s.usecase.GetByTodoListID(ctx, 1, 10)
+	s.Require().NoError(err)
+	s.Equal([]*entity.ReminderResponse{
+		// Sent for a previous doing date, it is sent again for the current one
+		{ID: 2, TodoListID: 10, OffsetMinutes: 1440, RemindAt: "2024-01-09 09:00:00"},
+		{ID: 1, TodoListID: 10, OffsetMinutes: 60, RemindAt: "2024-01-10 08:00:00", SentAt: "2024-01-10 08:00:00"},
+	}, res)
+
+	// Other user todo list
+	s.todoListRepo.On("GetByID", ctx, int64(10)).Return(s.todoList, nil).Once()
+	_, err = s.usecase.GetByTodoListID(ctx, 2, 10)
+	s.Equal(apperr.ErrRecordNotFound(), err)
+}
+
+func (s *CrudReminderUsecaseTestSuite) TestSet() {
+	ctx := context.Background()
+
+	testcases := []struct {
+		name     string
+		req      entity.SetReminderReq
+		mockFunc func()
+		wantLen  int
+		wantErr  error
+	}{
+		{
+			name: "Success",
+			req:  entity.SetReminderReq{ID: 10, UserID: 1, OffsetsMinutes: []int{60, 0}},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByID", ctx, int64(10)).Return(s.todoList, nil).Once()
+				s.repo.On("ReplaceOffsets", ctx, int64(10), int64(1), []int{60, 0}).Return(nil).Once()
+				s.repo.On("GetByTodoListID", ctx, int64(10)).Return([]*mentity.TodoListReminder{
+					{ID: 1, TodoListID: 10, OffsetMinutes: 0},
+					{ID: 2, TodoListID: 10, OffsetMinutes: 60},
+				}, nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Success remove all",
+			req:  entity.SetReminderReq{ID: 10, UserID: 1},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByID", ctx, int64(10)).Return(s.todoList, nil).Once()
+				s.repo.On("ReplaceOffsets", ctx, int64(10), int64(1), []int(nil)).Return(nil).Once()
+				s.repo.On("GetByTodoListID", ctx, int64(10)).Return(nil, nil).Once()
+			},
+			wantLen: 0,
+		},
+		{
+			name:     "Validation Error (duplicate offsets)",
+			req:      entity.SetReminderReq{ID: 10, UserID: 1, OffsetsMinutes: []int{60, 60}},
+			mockFunc: func() {},
+			wantErr:  errors.New("any"),
+		},
+		{
+			name:     "Validation Error (offset more than 7 days)",
+			req:      entity.SetReminderReq{ID: 10, UserID: 1, OffsetsMinutes: []int{10081}},
+			mockFunc: func() {},
+			wantErr:  errors.New("any"),
+		},
+		{
+			name: "Error not found",
+			req:  entity.SetReminderReq{ID: 10, UserID: 1, OffsetsMinutes: []int{60}},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByID", ctx, int64(10)).Return(nil, nil).Once()
+			},
+			wantErr: apperr.ErrRecordNotFound(),
+		},
+		{
+			name: "Error ReplaceOffsets",
+			req:  entity.SetReminderReq{ID: 10, UserID: 1, OffsetsMinutes: []int{60}},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByID", ctx, int64(10)).Return(s.todoList, nil).Once()
+				s.repo.On("ReplaceOffsets", ctx, int64(10), int64(1), []int{60}).Return(errors.New("db error")).Once()
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tc.mockFunc()
+
+			res, err := s.usecase.Set(ctx, tc.req)
+			if tc.wantErr != nil {
+				s.Error(err)
+				if _, ok := tc.wantErr.(apperr.CustomErrorResponse); ok {
+					s.Equal(tc.wantErr, err)
+				}
+			} else {
+				s.NoError(err)
+				s.Len(res, tc.wantLen)
+			}
+
+			s.todoListRepo.AssertExpectations(s.T())
+			s.repo.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package reminder_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	reminderEntity "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	reminderBatchLimit = 500
+	// reminderRequeueAfter is the time a published reminder waits to be sent before it is published again
+	// (ex. publish or worker failed), the consumer dedupes so a reminder published twice is sent once
+	reminderRequeueAfter = 10 * time.Minute
+)
+
+type DeliveryReminderUsecase struct {
+	todoListReminderRepo mysql.ITodoListReminderRepository
+	queue                queue.Queue
+	notifier             notification.Notifier
+	dueTime              time.Duration
+	window               time.Duration
+}
+
+// NewDeliveryReminderUsecase creates reminder delivery usecase used by the scheduler (PublishDue) and worker (Send).
+// Reminders whose time passed less than window ago are published, older ones (ex. scheduler was down) are skipped.
+// notifier is only used by Send so the scheduler can pass nil
+func NewDeliveryReminderUsecase(
+	todoListReminderRepo mysql.ITodoListReminderRepository,
+	queue queue.Queue,
+	notifier notification.Notifier,
+	dueTime time.Duration,
+	window time.Duration,
+) *DeliveryReminderUsecase {
+	return &DeliveryReminderUsecase{todoListReminderRepo, queue, notifier, dueTime, window}
+}
+
+type IDeliveryReminderUsecase interface {
+	PublishDue(ctx context.Context) error
+	Send(ctx context.Context, message reminderEntity.ReminderMessage) error
+}
+
+// PublishDue publishes due reminders to the worker (topic todo.reminder), called periodically by the scheduler
+func (t *DeliveryReminderUsecase) PublishDue(ctx context.Context) error {
+	funcName := "DeliveryReminderUsecase.PublishDue"
+
+	now := time.Now()
+	reminders, err := t.todoListReminderRepo.GetDue(ctx, t.dueTime, now.Add(-t.window), now, now.Add(-reminderRequeueAfter), reminderBatchLimit)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.GetDue", funcName, err, entity.CaptureFields{}, "")
+
+		return err
+	}
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	IDs := make([]int64, 0, len(reminders))
+	for _, reminder := range reminders {
+		IDs = append(IDs, reminder.ID)
+	}
+	// Marked before publishing so the next run does not publish them again while the worker sends them
+	if err := t.todoListReminderRepo.MarkQueued(ctx, IDs, now); err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.MarkQueued", funcName, err, entity.CaptureFields{}, "")
+
+		return err
+	}
+
+	for _, reminder := range reminders {
+		payload, _ := helper.Serialize(reminderEntity.ReminderMessage{
+			ReminderID: reminder.ID,
+			DoingAt:    reminder.DoingAt.Format(dateLayout),
+		})
+		if err := t.queue.PublishWithContext(ctx, queue.ProcessTodoReminder, payload, 1); err != nil {
+			helper.LogErrorContext(ctx, "queue.Publish", funcName, err, entity.CaptureFields{
+				"reminder_id": helper.ToString(reminder.ID),
+			}, "")
+		}
+	}
+
+	return nil
+}
+
+// Send notifies the user once per reminder and doing date. Reminder of a deleted, completed or rescheduled
+// todo list is skipped, the reminder is released when the notifier fails so the queue retries it
+func (t *DeliveryReminderUsecase) Send(ctx context.Context, message reminderEntity.ReminderMessage) error {
+	funcName := "DeliveryReminderUsecase.Send"
+	captureFieldError := entity.CaptureFields{
+		"reminder_id": helper.ToString(message.ReminderID),
+		"doing_at":    message.DoingAt,
+	}
+
+	reminder, err := t.todoListReminderRepo.GetDueByID(ctx, message.ReminderID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.GetDueByID", funcName, err, captureFieldError, "")
+
+		return err
+	}
+	if reminder == nil || reminder.CompletedAt != nil || reminder.DoingAt.Format(dateLayout) != message.DoingAt {
+		return nil
+	}
+
+	claimed, err := t.todoListReminderRepo.MarkSent(ctx, reminder.ID, reminder.DoingAt, time.Now())
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListReminderRepo.MarkSent", funcName, err, captureFieldError, "")
+
+		return err
+	}
+	// Already sent (ex. published again after the scheduler restarted)
+	if !claimed {
+		return nil
+	}
+
+	dueAt := RemindAt(reminder.DoingAt, t.dueTime, 0)
+	err = t.notifier.Notify(ctx, notification.Message{
+		UserID:  reminder.UserID,
+		Type:    notification.TypeTodoReminder,
+		Subject: fmt.Sprintf("Reminder: %s", reminder.Title),
+		Body:    fmt.Sprintf("%s is due at %s", reminder.Title, helper.ConvertToJakartaTime(dueAt)),
+		Data: map[string]interface{}{
+			"reminder_id":    reminder.ID,
+			"todo_list_id":   reminder.TodoListID,
+			"title":          reminder.Title,
+			"due_at":         helper.ConvertToJakartaTime(dueAt),
+			"offset_minutes": reminder.OffsetMinutes,
+		},
+	})
+	if err != nil {
+		helper.LogErrorContext(ctx, "notifier.Notify", funcName, err, captureFieldError, "")
+
+		if err := t.todoListReminderRepo.UnmarkSent(ctx, reminder.ID); err != nil {
+			helper.LogErrorContext(ctx, "todoListReminderRepo.UnmarkSent", funcName, err, captureFieldError, "")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// RemindAt returns remind time of a todo list, doingAt at dueTime (Asia/Jakarta) minus offsetMinutes
+func RemindAt(doingAt time.Time, dueTime time.Duration, offsetMinutes int) time.Time {
+	location, _ := time.LoadLocation("Asia/Jakarta")
+	year, month, day := doingAt.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, location).
+		Add(dueTime).
+		Add(-time.Duration(offsetMinutes) * time.Minute)
+}
@@ -0,0 +1,222 @@
+package reminder_usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/internal/notification"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	reminder_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/reminder"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/reminder/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeliveryReminderUsecaseTestSuite struct {
+	suite.Suite
+	usecase  *reminder_usecase.DeliveryReminderUsecase
+	repo     *mocks.ITodoListReminderRepository
+	queue    *mocks.Queue
+	notifier *mocks.Notifier
+	doingAt  time.Time
+}
+
+func (s *DeliveryReminderUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.ITodoListReminderRepository{}
+	s.queue = &mocks.Queue{}
+	s.notifier = &mocks.Notifier{}
+	s.doingAt, _ = time.Parse("2006-01-02", "2024-01-10")
+
+	s.usecase = reminder_usecase.NewDeliveryReminderUsecase(s.repo, s.queue, s.notifier, 9*time.Hour, time.Hour)
+}
+
+func TestDeliveryReminderUsecase(t *testing.T) {
+	suite.Run(t, new(DeliveryReminderUsecaseTestSuite))
+}
+
+func (s *DeliveryReminderUsecaseTestSuite) reminder() *mentity.TodoListReminderDue {
+	return &mentity.TodoListReminderDue{
+		TodoListReminder: mentity.TodoListReminder{ID: 1, TodoListID: 10, UserID: 2, OffsetMinutes: 60},
+		Title:            "Weekly meeting",
+		DoingAt:          s.doingAt,
+	}
+}
+
+func (s *DeliveryReminderUsecaseTestSuite) TestPublishDue() {
+	ctx := context.Background()
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("GetDue", ctx, 9*time.Hour, mock.Anything, mock.Anything, mock.Anything, 500).
+					Return([]*mentity.TodoListReminderDue{s.reminder()}, nil).Once()
+				s.repo.On("MarkQueued", ctx, []int64{1}, mock.Anything).Return(nil).Once()
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoReminder, mock.MatchedBy(func(payload []byte) bool {
+					var message entity.ReminderMessage
+					_ = json.Unmarshal(payload, &message)
+					return message == entity.ReminderMessage{ReminderID: 1, DoingAt: "2024-01-10"}
+				}), int32(1)).Return(nil).Once()
+			},
+		},
+		{
+			name: "Success nothing due",
+			mockFunc: func() {
+				s.repo.On("GetDue", ctx, 9*time.Hour, mock.Anything, mock.Anything, mock.Anything, 500).
+					Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "Success publish failed is picked up by the next run",
+			mockFunc: func() {
+				s.repo.On("GetDue", ctx, 9*time.Hour, mock.Anything, mock.Anything, mock.Anything, 500).
+					Return([]*mentity.TodoListReminderDue{s.reminder()}, nil).Once()
+				s.repo.On("MarkQueued", ctx, []int64{1}, mock.Anything).Return(nil).Once()
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoReminder, mock.Anything, int32(1)).Return(errors.New("queue error")).Once()
+			},
+		},
+		{
+			name: "Error GetDue",
+			mockFunc: func() {
+				s.repo.On("GetDue", ctx, 9*time.Hour, mock.Anything, mock.Anything, mock.Anything, 500).
+					Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error MarkQueued",
+			mockFunc: func() {
+				s.repo.On("GetDue", ctx, 9*time.Hour, mock.Anything, mock.Anything, mock.Anything, 500).
+					Return([]*mentity.TodoListReminderDue{s.reminder()}, nil).Once()
+				s.repo.On("MarkQueued", ctx, []int64{1}, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tc.mockFunc()
+
+			err := s.usecase.PublishDue(ctx)
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+
+			s.repo.AssertExpectations(s.T())
+			s.queue.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *DeliveryReminderUsecaseTestSuite) TestSend() {
+	ctx := context.Background()
+	message := entity.ReminderMessage{ReminderID: 1, DoingAt: "2024-01-10"}
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(s.reminder(), nil).Once()
+				s.repo.On("MarkSent", ctx, int64(1), s.doingAt, mock.Anything).Return(true, nil).Once()
+				s.notifier.On("Notify", ctx, mock.MatchedBy(func(message notification.Message) bool {
+					return message.UserID == 2 &&
+						message.Type == notification.TypeTodoReminder &&
+						message.Body == "Weekly meeting is due at 2024-01-10 09:00:00"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Success already sent",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(s.reminder(), nil).Once()
+				s.repo.On("MarkSent", ctx, int64(1), s.doingAt, mock.Anything).Return(false, nil).Once()
+			},
+		},
+		{
+			name: "Success todo list deleted",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "Success todo list completed",
+			mockFunc: func() {
+				reminder := s.reminder()
+				completedAt := time.Now()
+				reminder.CompletedAt = &completedAt
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(reminder, nil).Once()
+			},
+		},
+		{
+			name: "Success todo list rescheduled",
+			mockFunc: func() {
+				reminder := s.reminder()
+				reminder.DoingAt = s.doingAt.AddDate(0, 0, 1)
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(reminder, nil).Once()
+			},
+		},
+		{
+			name: "Error notifier releases the reminder",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(s.reminder(), nil).Once()
+				s.repo.On("MarkSent", ctx, int64(1), s.doingAt, mock.Anything).Return(true, nil).Once()
+				s.notifier.On("Notify", ctx, mock.Anything).Return(errors.New("smtp error")).Once()
+				s.repo.On("UnmarkSent", ctx, int64(1)).Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error MarkSent",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(s.reminder(), nil).Once()
+				s.repo.On("MarkSent", ctx, int64(1), s.doingAt, mock.Anything).Return(false, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error GetDueByID",
+			mockFunc: func() {
+				s.repo.On("GetDueByID", ctx, int64(1)).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tc.mockFunc()
+
+			err := s.usecase.Send(ctx, message)
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+
+			s.repo.AssertExpectations(s.T())
+			s.notifier.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *DeliveryReminderUsecaseTestSuite) TestRemindAt() {
+	remindAt := reminder_usecase.RemindAt(s.doingAt, 9*time.Hour, 90)
+
+	s.Equal("2024-01-10T07:30:00+07:00", remindAt.Format(time.RFC3339))
+}
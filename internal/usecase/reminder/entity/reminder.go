@@ -0,0 +1,42 @@
+package entity
+
+import "encoding/json"
+
+// SetReminderReq replaces reminders of a todo list, each offset is minutes before the due time of doing_at
+// (ex. 60 reminds an hour before), empty offsets removes every reminder
+type SetReminderReq struct {
+	ID             int64 `json:"id,omitempty" swaggerignore:"true"`
+	UserID         int64 `json:"user_id,omitempty" swaggerignore:"true"`
+	OffsetsMinutes []int `json:"offsets_minutes" validate:"max=5,unique,dive,min=0,max=10080" name:"Pengingat (menit)" name_en:"Reminders (minutes)"`
+}
+
+func (r *SetReminderReq) SetID(ID int64) {
+	r.ID = ID
+}
+
+func (r *SetReminderReq) SetUserID(UserID int64) {
+	r.UserID = UserID
+}
+
+type ReminderResponse struct {
+	ID            int64  `json:"id"`
+	TodoListID    int64  `json:"todo_list_id"`
+	OffsetMinutes int    `json:"offset_minutes"`
+	RemindAt      string `json:"remind_at"`
+	SentAt        string `json:"sent_at,omitempty"`
+}
+
+// ReminderMessage is the queue payload of a reminder (topic todo.reminder), DoingAt is the doing date
+// the reminder was published for so a reminder of a rescheduled todo list is not sent for the old date
+type ReminderMessage struct {
+	ReminderID int64  `json:"reminder_id"`
+	DoingAt    string `json:"doing_at"`
+}
+
+func (m *ReminderMessage) LoadFromMap(payload map[string]interface{}) error {
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, m)
+	}
+	return err
+}
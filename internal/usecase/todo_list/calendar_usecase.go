@@ -0,0 +1,158 @@
+package todo_list_usecase
+
+import (
+	"context"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const calendarMaxDays = 92
+
+type CalendarTodoListUsecase struct {
+	todoListRepo mysql.ITodoListRepository
+}
+
+func NewCalendarTodoListUsecase(
+	todoListRepo mysql.ITodoListRepository,
+) *CalendarTodoListUsecase {
+	return &CalendarTodoListUsecase{todoListRepo}
+}
+
+type ICalendarTodoListUsecase interface {
+	GetCalendar(ctx context.Context, calendarReq entity.CalendarTodoListReq) (*entity.TodoListCalendarResponse, error)
+}
+
+// GetCalendar groups user todo lists by doing date. Doing date has no time so a todo list stays on its date in
+// every timezone, the timezone decides today (overdue) and the timestamps of todo lists
+func (t *CalendarTodoListUsecase) GetCalendar(ctx context.Context, calendarReq entity.CalendarTodoListReq) (*entity.TodoListCalendarResponse, error) {
+	funcName := "CalendarTodoListUsecase.GetCalendar"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(calendarReq.UserID),
+		"payload": helper.ToString(calendarReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, calendarReq); err != nil {
+		return nil, err
+	}
+
+	location, err := helper.LoadLocation(calendarReq.TZ)
+	if err != nil {
+		return nil, apperr.InvalidField("TZ", "timezone", calendarReq.TZ, i18n.Message(ctx, i18n.MsgInvalidTimezone)).Wrap(err)
+	}
+
+	today, _ := helper.ParseDate(helper.ConvertToLocationDate(time.Now(), location))
+	from, to, err := calendarRange(ctx, calendarReq, today)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.todoListRepo.GetByDoingAtRange(ctx, calendarReq.UserID, from, to)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.GetByDoingAtRange", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	byDate := make(map[string]*entity.TodoListCalendarDay)
+	days := []*entity.TodoListCalendarDay{}
+	for _, v := range result {
+		date := v.DoingAt.Format(dateLayout)
+		day, ok := byDate[date]
+		if !ok {
+			day = newCalendarDay(date, today)
+			byDate[date] = day
+			days = append(days, day)
+		}
+
+		day.Total++
+		switch {
+		case v.CompletedAt != nil:
+			day.Completed++
+		case date < today.Format(dateLayout):
+			day.Overdue++
+		default:
+			day.Pending++
+		}
+		day.TodoLists = append(day.TodoLists, newCalendarTodoListResponse(v, location))
+	}
+
+	if calendarReq.IncludeEmpty {
+		days = make([]*entity.TodoListCalendarDay, 0, int(to.Sub(from).Hours()/24)+1)
+		for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+			day, ok := byDate[d.Format(dateLayout)]
+			if !ok {
+				day = newCalendarDay(d.Format(dateLayout), today)
+			}
+			days = append(days, day)
+		}
+	}
+
+	return &entity.TodoListCalendarResponse{
+		From:     from.Format(dateLayout),
+		To:       to.Format(dateLayout),
+		Timezone: location.String(),
+		Today:    today.Format(dateLayout),
+		Days:     days,
+	}, nil
+}
+
+// calendarRange returns the requested range, a missing From or To is one month before or after the other one,
+// both missing is the month of today
+func calendarRange(ctx context.Context, calendarReq entity.CalendarTodoListReq, today time.Time) (from time.Time, to time.Time, err error) {
+	from, _ = helper.ParseDate(calendarReq.From)
+	to, _ = helper.ParseDate(calendarReq.To)
+
+	switch {
+	case calendarReq.From == "" && calendarReq.To == "":
+		from = today.AddDate(0, 0, 1-today.Day())
+		to = from.AddDate(0, 1, -1)
+	case calendarReq.To == "":
+		to = from.AddDate(0, 1, -1)
+	case calendarReq.From == "":
+		from = to.AddDate(0, -1, 1)
+	}
+
+	switch {
+	case from.After(to):
+		err = apperr.InvalidField("From", "ltefield", "To", i18n.Message(ctx, i18n.MsgDateRangeOrder))
+	case int(to.Sub(from).Hours()/24)+1 > calendarMaxDays:
+		err = apperr.InvalidField("To", "max", helper.ToString(calendarMaxDays),
+			i18n.Message(ctx, i18n.MsgDateRangeMax, calendarMaxDays))
+	}
+
+	return from, to, err
+}
+
+func newCalendarDay(date string, today time.Time) *entity.TodoListCalendarDay {
+	return &entity.TodoListCalendarDay{
+		Date:      date,
+		IsToday:   date == today.Format(dateLayout),
+		TodoLists: []*entity.TodoListResponse{},
+	}
+}
+
+func newCalendarTodoListResponse(data *mentity.TodoList, location *time.Location) *entity.TodoListResponse {
+	res := &entity.TodoListResponse{
+		ID:          data.ID,
+		Title:       data.Title,
+		Description: data.Description,
+		DoingAt:     data.DoingAt.Format(dateLayout),
+		Position:    data.Position,
+		Version:     data.Version,
+		CreatedAt:   helper.ConvertToLocationTime(data.CreatedAt, location),
+		UpdatedAt:   helper.ConvertToLocationTime(data.UpdatedAt, location),
+	}
+	if data.CompletedAt != nil {
+		res.CompletedAt = helper.ConvertToLocationTime(*data.CompletedAt, location)
+	}
+
+	return res
+}
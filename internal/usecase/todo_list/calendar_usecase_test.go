@@ -0,0 +1,175 @@
+package todo_list_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarTodoListUsecaseTestSuite struct {
+	suite.Suite
+	usecase      *todo_list_usecase.CalendarTodoListUsecase
+	todoListRepo *mocks.ITodoListRepository
+}
+
+func (s *CalendarTodoListUsecaseTestSuite) SetupTest() {
+	s.todoListRepo = &mocks.ITodoListRepository{}
+	s.usecase = todo_list_usecase.NewCalendarTodoListUsecase(s.todoListRepo)
+}
+
+func TestCalendarTodoListUsecase(t *testing.T) {
+	suite.Run(t, new(CalendarTodoListUsecaseTestSuite))
+}
+
+func (s *CalendarTodoListUsecaseTestSuite) date(value string) time.Time {
+	result, _ := time.Parse("2006-01-02", value)
+
+	return result
+}
+
+func (s *CalendarTodoListUsecaseTestSuite) TestGetCalendar() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	// 2024-01-02 17:30 UTC is 2024-01-03 00:30 in Asia/Jakarta and 2024-01-02 12:30 in America/New_York
+	completedAt := time.Date(2024, 1, 2, 17, 30, 0, 0, time.UTC)
+	todoLists := []*mentity.TodoList{
+		{ID: 1, UserID: userID, Title: "Weekly meeting", DoingAt: s.date("2024-01-02"), CompletedAt: &completedAt, CreatedAt: completedAt, UpdatedAt: completedAt},
+		{ID: 2, UserID: userID, Title: "Monthly report", DoingAt: s.date("2024-01-02"), CreatedAt: completedAt, UpdatedAt: completedAt},
+		{ID: 3, UserID: userID, Title: "Code review", DoingAt: s.date("2024-01-04"), CreatedAt: completedAt, UpdatedAt: completedAt},
+	}
+
+	testcases := []struct {
+		name     string
+		req      entity.CalendarTodoListReq
+		mockFunc func()
+		want     func(res *entity.TodoListCalendarResponse)
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			req:  entity.CalendarTodoListReq{UserID: userID, From: "2024-01-01", To: "2024-01-07", TZ: "America/New_York"},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, s.date("2024-01-01"), s.date("2024-01-07")).Return(todoLists, nil).Once()
+			},
+			want: func(res *entity.TodoListCalendarResponse) {
+				s.Equal("2024-01-01", res.From)
+				s.Equal("2024-01-07", res.To)
+				s.Equal("America/New_York", res.Timezone)
+				s.Require().Len(res.Days, 2)
+
+				s.Equal("2024-01-02", res.Days[0].Date)
+				s.Equal(2, res.Days[0].Total)
+				s.Equal(1, res.Days[0].Completed)
+				s.Equal(1, res.Days[0].Overdue)
+				s.Require().Len(res.Days[0].TodoLists, 2)
+				s.Equal("2024-01-02", res.Days[0].TodoLists[0].DoingAt)
+				s.Equal("2024-01-02 12:30:00", res.Days[0].TodoLists[0].CompletedAt)
+
+				s.Equal("2024-01-04", res.Days[1].Date)
+				s.Equal(1, res.Days[1].Total)
+			},
+		},
+		{
+			name: "Success default timezone",
+			req:  entity.CalendarTodoListReq{UserID: userID, From: "2024-01-01", To: "2024-01-07"},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, s.date("2024-01-01"), s.date("2024-01-07")).Return(todoLists, nil).Once()
+			},
+			want: func(res *entity.TodoListCalendarResponse) {
+				s.Equal("Asia/Jakarta", res.Timezone)
+				s.Equal("2024-01-03 00:30:00", res.Days[0].TodoLists[0].CompletedAt)
+			},
+		},
+		{
+			name: "Success include empty days",
+			req:  entity.CalendarTodoListReq{UserID: userID, From: "2024-01-01", To: "2024-01-07", IncludeEmpty: true},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, s.date("2024-01-01"), s.date("2024-01-07")).Return(todoLists, nil).Once()
+			},
+			want: func(res *entity.TodoListCalendarResponse) {
+				s.Require().Len(res.Days, 7)
+				s.Equal("2024-01-01", res.Days[0].Date)
+				s.Equal(0, res.Days[0].Total)
+				s.Empty(res.Days[0].TodoLists)
+				s.Equal(2, res.Days[1].Total)
+				s.Equal("2024-01-07", res.Days[6].Date)
+			},
+		},
+		{
+			name: "Success default current month",
+			req:  entity.CalendarTodoListReq{UserID: userID, TZ: "UTC"},
+			mockFunc: func() {
+				today, _ := helper.ParseDate(time.Now().UTC().Format("2006-01-02"))
+				from := today.AddDate(0, 0, 1-today.Day())
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, from, from.AddDate(0, 1, -1)).Return([]*mentity.TodoList{}, nil).Once()
+			},
+			want: func(res *entity.TodoListCalendarResponse) {
+				s.Equal(time.Now().UTC().Format("2006-01-02"), res.Today)
+				s.Empty(res.Days)
+			},
+		},
+		{
+			name: "Success only from",
+			req:  entity.CalendarTodoListReq{UserID: userID, From: "2024-02-01"},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, s.date("2024-02-01"), s.date("2024-02-29")).Return([]*mentity.TodoList{}, nil).Once()
+			},
+			want: func(res *entity.TodoListCalendarResponse) {
+				s.Equal("2024-02-29", res.To)
+			},
+		},
+		{
+			name:     "Invalid timezone",
+			req:      entity.CalendarTodoListReq{UserID: userID, TZ: "Mars/Olympus"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Invalid range",
+			req:      entity.CalendarTodoListReq{UserID: userID, From: "2024-01-07", To: "2024-01-01"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Range too long",
+			req:      entity.CalendarTodoListReq{UserID: userID, From: "2024-01-01", To: "2024-06-01"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error GetByDoingAtRange",
+			req:  entity.CalendarTodoListReq{UserID: userID, From: "2024-01-01", To: "2024-01-07"},
+			mockFunc: func() {
+				s.todoListRepo.On("GetByDoingAtRange", ctx, userID, mock.Anything, mock.Anything).Return(nil, errors.New("connection refused")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.mockFunc()
+
+			res, err := s.usecase.GetCalendar(ctx, tc.req)
+
+			if tc.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				tc.want(res)
+			}
+			s.todoListRepo.AssertExpectations(s.T())
+		})
+	}
+}
@@ -2,12 +2,15 @@ package todo_list_usecase
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"time"
 
-	errwrap "github.com/pkg/errors"
+	"github.com/google/uuid"
 	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
 	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase"
@@ -15,21 +18,33 @@ import (
 )
 
 type CrudTodoListUsecase struct {
-	todoListRepo mysql.ITodoListRepository
+	todoListRepo        mysql.ITodoListRepository
+	todoListHistoryRepo mysql.ITodoListHistoryRepository
+	queue               queue.Queue
+	publisher           realtime.Publisher
 }
 
+// NewCrudTodoListUsecase creates crud usecase, changes are published as events after they are committed
+// to the queue (topic todo_list.event) and to the real-time publisher, nil queue or publisher is skipped
 func NewCrudTodoListUsecase(
 	todoListRepo mysql.ITodoListRepository,
+	todoListHistoryRepo mysql.ITodoListHistoryRepository,
+	queue queue.Queue,
+	publisher realtime.Publisher,
 ) *CrudTodoListUsecase {
-	return &CrudTodoListUsecase{todoListRepo}
+	return &CrudTodoListUsecase{todoListRepo, todoListHistoryRepo, queue, publisher}
 }
 
 type ICrudTodoListUsecase interface {
 	GetByUserID(ctx context.Context, userID int64) (res []*entity.TodoListResponse, err error)
-	GetByID(ctx context.Context, todoListID int64) (*entity.TodoListResponse, error)
+	GetByID(ctx context.Context, userID int64, todoListID int64) (*entity.TodoListResponse, error)
 	Create(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error)
-	UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) error
-	DeleteByID(ctx context.Context, todoListID int64) error
+	UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error)
+	PatchByID(ctx context.Context, patchReq entity.PatchTodoListReq) (res *entity.TodoListResponse, err error)
+	DeleteByID(ctx context.Context, userID int64, todoListID int64) error
+	GetHistory(ctx context.Context, userID int64, todoListID int64) (res []*entity.TodoListHistoryResponse, err error)
+	Bulk(ctx context.Context, bulkReq entity.BulkTodoListReq) (*entity.BulkTodoListResponse, error)
+	Move(ctx context.Context, moveReq entity.MoveTodoListReq) (*entity.TodoListResponse, error)
 }
 
 func (t *CrudTodoListUsecase) GetByUserID(ctx context.Context, userID int64) (res []*entity.TodoListResponse, err error) {
@@ -40,7 +55,7 @@ func (t *CrudTodoListUsecase) GetByUserID(ctx context.Context, userID int64) (re
 
 	result, err := t.todoListRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		helper.LogError("todoListRepo.GetByUserID", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "todoListRepo.GetByUserID", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
@@ -51,6 +66,9 @@ func (t *CrudTodoListUsecase) GetByUserID(ctx context.Context, userID int64) (re
 			Title:       v.Title,
 			Description: v.Description,
 			DoingAt:     helper.ConvertToJakartaDate(v.DoingAt),
+			CompletedAt: formatCompletedAt(v.CompletedAt),
+			Position:    v.Position,
+			Version:     v.Version,
 			CreatedAt:   helper.ConvertToJakartaTime(v.CreatedAt),
 			UpdatedAt:   helper.ConvertToJakartaTime(v.UpdatedAt),
 		})
@@ -59,19 +77,21 @@ func (t *CrudTodoListUsecase) GetByUserID(ctx context.Context, userID int64) (re
 	return res, nil
 }
 
-func (t *CrudTodoListUsecase) GetByID(ctx context.Context, todoListID int64) (*entity.TodoListResponse, error) {
+// GetByID returns a Todo List of the user, nil when it does not exist or belongs to another user
+func (t *CrudTodoListUsecase) GetByID(ctx context.Context, userID int64, todoListID int64) (*entity.TodoListResponse, error) {
 	funcName := "CrudTodoListUsecase.GetByID"
 	captureFieldError := generalEntity.CaptureFields{
-		"user_id": helper.ToString(todoListID),
+		"user_id":      helper.ToString(userID),
+		"todo_list_id": helper.ToString(todoListID),
 	}
 
 	data, err := t.todoListRepo.GetByID(ctx, todoListID)
 	if err != nil {
-		helper.LogError("todoListRepo.GetByID", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "todoListRepo.GetByID", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
-	if data == nil {
+	if data == nil || data.UserID != userID {
 		return nil, nil
 	}
 
@@ -80,6 +100,9 @@ func (t *CrudTodoListUsecase) GetByID(ctx context.Context, todoListID int64) (*e
 		Title:       data.Title,
 		Description: data.Description,
 		DoingAt:     helper.ConvertToJakartaDate(data.DoingAt),
+		CompletedAt: formatCompletedAt(data.CompletedAt),
+		Position:    data.Position,
+		Version:     data.Version,
 		CreatedAt:   helper.ConvertToJakartaTime(data.CreatedAt),
 		UpdatedAt:   helper.ConvertToJakartaTime(data.UpdatedAt),
 	}, nil
@@ -92,8 +115,8 @@ func (t *CrudTodoListUsecase) Create(ctx context.Context, todoListReq entity.Tod
 		"payload": helper.ToString(todoListReq),
 	}
 
-	if errMsg := usecase.ValidateStruct(todoListReq); errMsg != "" {
-		return nil, errwrap.Wrap(fmt.Errorf(generalEntity.INVALID_PAYLOAD_CODE), errMsg)
+	if err := usecase.ValidateStruct(ctx, todoListReq); err != nil {
+		return nil, err
 	}
 
 	doingAt, _ := helper.ParseDate(todoListReq.DoingAt)
@@ -103,26 +126,44 @@ func (t *CrudTodoListUsecase) Create(ctx context.Context, todoListReq entity.Tod
 		Title:       todoListReq.Title,
 		Description: todoListReq.Description,
 		DoingAt:     doingAt,
+		Version:     1,
 		CreatedAt:   time.Now(),
 	}
 
-	err := t.todoListRepo.Create(ctx, nil, todoListPayload, false)
-	if err != nil {
-		helper.LogError("todoListRepo.Create", funcName, err, captureFieldError, "")
+	// New Todo List is placed at the end of user list
+	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) (err error) {
+		if todoListPayload.Position, err = t.nextPosition(ctx, trx, todoListReq.UserID); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.GetLastPosition", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		if err := t.todoListRepo.Create(ctx, trx, todoListPayload, false); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.Create", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		history := newTodoListHistory(todoListReq.UserID, mentity.TodoListHistoryCreate, nil, todoListPayload)
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
 
+			return err
+		}
+
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
-	return &entity.TodoListResponse{
-		ID:          todoListPayload.ID,
-		Title:       todoListPayload.Title,
-		Description: todoListPayload.Description,
-		DoingAt:     helper.ConvertToJakartaDate(todoListPayload.DoingAt),
-		CreatedAt:   helper.ConvertToJakartaTime(todoListPayload.CreatedAt),
-	}, nil
+	res := newTodoListResponse(todoListPayload)
+	t.publishEvents(ctx, newEvent(generalEntity.EventTodoListCreated, todoListReq.UserID, res))
+
+	return res, nil
 }
 
-func (t *CrudTodoListUsecase) UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) error {
+// UpdateByID replaces a user Todo List and returns it with its new version
+func (t *CrudTodoListUsecase) UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error) {
 	funcName := "CrudTodoListUsecase.UpdateByID"
 	todoListID := todoListReq.ID
 
@@ -131,54 +172,669 @@ func (t *CrudTodoListUsecase) UpdateByID(ctx context.Context, todoListReq entity
 		"payload": helper.ToString(todoListReq),
 	}
 
+	var ownerID int64
+	var updated *entity.TodoListResponse
+
 	// Start DB Transaction
 	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
-		// Locking Data
+		// Locking Data, other users' data is treated as not exist
 		lockedData, err := t.todoListRepo.LockByID(ctx, trx, todoListID)
 		if err != nil {
-			helper.LogError("todoListRepo.LockByID", funcName, err, captureFieldError, "")
+			helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
 
 			return err
 		}
-		if lockedData == nil {
-			return fmt.Errorf("DATA IS NOT EXIST")
+		if lockedData == nil || lockedData.UserID != todoListReq.UserID {
+			return apperr.ErrRecordNotFound()
+		}
+
+		// Optimistic concurrency, reject when data is changed since client read it
+		if todoListReq.IfMatch != "" && !helper.MatchETag(todoListReq.IfMatch, helper.VersionETag(lockedData.Version)) {
+			return apperr.ErrPreconditionFailed()
 		}
 
 		// Process Update
 		doingAt, _ := helper.ParseDate(todoListReq.DoingAt)
-		if err := t.todoListRepo.Update(ctx, trx, lockedData, &mentity.TodoList{
+		changes := &mentity.TodoList{
 			Title:       todoListReq.Title,
 			Description: todoListReq.Description,
 			DoingAt:     doingAt,
+			Version:     lockedData.Version + 1,
 			UpdatedAt:   time.Now(),
-		}); err != nil {
-			helper.LogError("todoListRepo.Update", funcName, err, captureFieldError, "")
+		}
+		history := newTodoListHistory(todoListReq.UserID, mentity.TodoListHistoryUpdate, lockedData, changes)
+
+		if err := t.todoListRepo.Update(ctx, trx, lockedData, changes); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.Update", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
 
 			return err
 		}
 
+		// gorm writes the changes into lockedData
+		ownerID = lockedData.UserID
+		updated = newTodoListResponse(lockedData)
+
 		return nil
 	}); err != nil {
-		helper.LogError("todoListRepo.DBTransaction", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "todoListRepo.DBTransaction", funcName, err, captureFieldError, "")
 
-		return err
+		return nil, err
 	}
+	// Events are delivered to the streams and webhooks of the Todo List owner
+	t.publishEvents(ctx, newEvent(generalEntity.EventTodoListUpdated, ownerID, updated))
 
-	return nil
+	return updated, nil
 }
 
-func (t *CrudTodoListUsecase) DeleteByID(ctx context.Context, todoListID int64) error {
+func (t *CrudTodoListUsecase) DeleteByID(ctx context.Context, userID int64, todoListID int64) error {
 	funcName := "CrudTodoListUsecase.DeleteByID"
 	captureFieldError := generalEntity.CaptureFields{
+		"user_id":      helper.ToString(userID),
 		"todo_list_id": helper.ToString(todoListID),
 	}
 
-	err := t.todoListRepo.DeleteByID(ctx, nil, todoListID)
-	if err != nil {
-		helper.LogError("todoListRepo.DeleteByID", funcName, err, captureFieldError, "")
+	var deleted *entity.TodoListResponse
+	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
+		// Locking Data, other users' data is treated as not exist
+		lockedData, err := t.todoListRepo.LockByID(ctx, trx, todoListID)
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		if lockedData == nil || lockedData.UserID != userID {
+			return apperr.ErrRecordNotFound()
+		}
+
+		if err := t.todoListRepo.DeleteByID(ctx, trx, todoListID); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.DeleteByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		history := newTodoListHistory(userID, mentity.TodoListHistoryDelete, lockedData, nil)
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		deleted = newTodoListResponse(lockedData)
 
+		return nil
+	}); err != nil {
 		return err
 	}
+	t.publishEvents(ctx, newEvent(generalEntity.EventTodoListDeleted, userID, deleted))
 
 	return nil
 }
+
+// PatchByID applies JSON Merge Patch to a user Todo List, only supplied fields are validated and written
+// so a field can be cleared by setting it to null (ex. description)
+func (t *CrudTodoListUsecase) PatchByID(ctx context.Context, patchReq entity.PatchTodoListReq) (res *entity.TodoListResponse, err error) {
+	funcName := "CrudTodoListUsecase.PatchByID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":      helper.ToString(patchReq.UserID),
+		"todo_list_id": helper.ToString(patchReq.ID),
+		"payload":      helper.ToString(patchReq.Patch),
+	}
+
+	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
+		// Locking Data, other users' data is treated as not exist
+		lockedData, err := t.todoListRepo.LockByID(ctx, trx, patchReq.ID)
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		if lockedData == nil || lockedData.UserID != patchReq.UserID {
+			return apperr.ErrRecordNotFound()
+		}
+
+		if patchReq.IfMatch != "" && !helper.MatchETag(patchReq.IfMatch, helper.VersionETag(lockedData.Version)) {
+			return apperr.ErrPreconditionFailed()
+		}
+
+		// Apply patch on current data then validate supplied fields only
+		patched := entity.TodoListPatch{
+			Title:       lockedData.Title,
+			Description: lockedData.Description,
+			DoingAt:     helper.ConvertToJakartaDate(lockedData.DoingAt),
+		}
+		if err := patchReq.Patch.Apply(&patched); err != nil {
+			return apperr.ErrInvalidRequest()
+		}
+		if err := usecase.ValidateStructPartial(ctx, patched, patchReq.Patch.StructFields(patched)...); err != nil {
+			return err
+		}
+
+		after := *lockedData
+		if len(patchReq.Patch) > 0 {
+			doingAt, _ := helper.ParseDate(patched.DoingAt)
+			changes := &mentity.TodoList{
+				Title:       patched.Title,
+				Description: patched.Description,
+				DoingAt:     doingAt,
+				Version:     lockedData.Version + 1,
+				UpdatedAt:   time.Now(),
+			}
+			after.Title, after.Description, after.DoingAt = changes.Title, changes.Description, changes.DoingAt
+			after.Version, after.UpdatedAt = changes.Version, changes.UpdatedAt
+			history := newTodoListPatchHistory(patchReq.UserID, lockedData, &after)
+
+			// Patch members are named after the columns
+			columns := append(patchReq.Patch.Fields(), "version", "updated_at")
+			if err := t.todoListRepo.UpdateColumns(ctx, trx, lockedData, changes, columns...); err != nil {
+				helper.LogErrorContext(ctx, "todoListRepo.UpdateColumns", funcName, err, captureFieldError, "")
+
+				return err
+			}
+
+			if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+				helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+				return err
+			}
+		}
+
+		res = &entity.TodoListResponse{
+			ID:          after.ID,
+			Title:       after.Title,
+			Description: after.Description,
+			DoingAt:     helper.ConvertToJakartaDate(after.DoingAt),
+			CompletedAt: formatCompletedAt(after.CompletedAt),
+			Position:    after.Position,
+			Version:     after.Version,
+			CreatedAt:   helper.ConvertToJakartaTime(after.CreatedAt),
+			UpdatedAt:   helper.ConvertToJakartaTime(after.UpdatedAt),
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(patchReq.Patch) > 0 {
+		t.publishEvents(ctx, newEvent(generalEntity.EventTodoListUpdated, patchReq.UserID, res))
+	}
+
+	return res, nil
+}
+
+// GetHistory returns change log of a user Todo List (newest first), history is kept after the Todo List is deleted
+func (t *CrudTodoListUsecase) GetHistory(ctx context.Context, userID int64, todoListID int64) (res []*entity.TodoListHistoryResponse, err error) {
+	funcName := "CrudTodoListUsecase.GetHistory"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":      helper.ToString(userID),
+		"todo_list_id": helper.ToString(todoListID),
+	}
+
+	result, err := t.todoListHistoryRepo.GetByTodoListID(ctx, userID, todoListID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListHistoryRepo.GetByTodoListID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res = make([]*entity.TodoListHistoryResponse, 0, len(result))
+	for _, v := range result {
+		changes := map[string]generalEntity.FieldDiff{}
+		if v.Changes != "" {
+			_ = json.Unmarshal([]byte(v.Changes), &changes)
+		}
+
+		res = append(res, &entity.TodoListHistoryResponse{
+			ID:         v.ID,
+			TodoListID: v.TodoListID,
+			ActorID:    v.ActorID,
+			Action:     string(v.Action),
+			Changes:    changes,
+			CreatedAt:  helper.ConvertToJakartaTime(v.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// Bulk applies a batch of create/update/delete/complete operations for a single user.
+// In atomic mode (default) every operation runs inside one DB transaction and the whole
+// batch is rolled back when any operation fails. In best_effort mode each operation runs
+// in its own transaction and failures do not affect the other operations.
+func (t *CrudTodoListUsecase) Bulk(ctx context.Context, bulkReq entity.BulkTodoListReq) (*entity.BulkTodoListResponse, error) {
+	funcName := "CrudTodoListUsecase.Bulk"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(bulkReq.UserID),
+		"mode":    string(bulkReq.Mode),
+	}
+
+	if err := usecase.ValidateStruct(ctx, bulkReq); err != nil {
+		return nil, err
+	}
+
+	results := make([]entity.BulkTodoListResult, len(bulkReq.Operations))
+
+	if bulkReq.Mode == entity.BulkModeBestEffort {
+		for i, op := range bulkReq.Operations {
+			var data *entity.TodoListResponse
+			var event generalEntity.Event
+			err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) (err error) {
+				data, event, err = t.applyBulkOperation(ctx, trx, bulkReq.UserID, op)
+				return err
+			})
+			results[i] = newBulkResult(i, op, data, err)
+			if err == nil {
+				t.publishEvents(ctx, event)
+			}
+		}
+
+		return newBulkResponse(bulkReq.Mode, true, results), nil
+	}
+
+	failedIndex := -1
+	events := make([]generalEntity.Event, 0, len(bulkReq.Operations))
+	err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
+		for i, op := range bulkReq.Operations {
+			data, event, err := t.applyBulkOperation(ctx, trx, bulkReq.UserID, op)
+			results[i] = newBulkResult(i, op, data, err)
+			if err != nil {
+				failedIndex = i
+				return err
+			}
+			events = append(events, event)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if failedIndex < 0 {
+			helper.LogErrorContext(ctx, "todoListRepo.DBTransaction", funcName, err, captureFieldError, "")
+
+			return nil, err
+		}
+
+		rolledBack := apperr.ErrBulkRolledBack()
+		for i, op := range bulkReq.Operations {
+			if i != failedIndex {
+				results[i] = newBulkResult(i, op, nil, rolledBack)
+			}
+		}
+	} else {
+		t.publishEvents(ctx, events...)
+	}
+
+	return newBulkResponse(entity.BulkModeAtomic, err == nil, results), nil
+}
+
+// applyBulkOperation applies a single operation and returns its event, the event must only be published after commit
+func (t *CrudTodoListUsecase) applyBulkOperation(ctx context.Context, trx mysql.TrxObj, userID int64, op entity.BulkTodoListOperation) (*entity.TodoListResponse, generalEntity.Event, error) {
+	funcName := "CrudTodoListUsecase.applyBulkOperation"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(userID),
+		"payload": helper.ToString(op),
+	}
+
+	todoListReq := entity.TodoListReq{
+		ID:          op.ID,
+		UserID:      userID,
+		Title:       op.Title,
+		Description: op.Description,
+		DoingAt:     op.DoingAt,
+	}
+
+	if op.Op == entity.BulkOperationCreate || op.Op == entity.BulkOperationUpdate {
+		if errs := usecase.ValidateStructProcess(ctx, todoListReq); len(errs) > 0 {
+			return nil, generalEntity.Event{}, apperr.ErrInvalidPayload(errs)
+		}
+	}
+
+	doingAt, _ := helper.ParseDate(todoListReq.DoingAt)
+
+	if op.Op == entity.BulkOperationCreate {
+		todoListPayload := &mentity.TodoList{
+			UserID:      userID,
+			Title:       todoListReq.Title,
+			Description: todoListReq.Description,
+			DoingAt:     doingAt,
+			Version:     1,
+			CreatedAt:   time.Now(),
+		}
+
+		position, err := t.nextPosition(ctx, trx, userID)
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.GetLastPosition", funcName, err, captureFieldError, "")
+
+			return nil, generalEntity.Event{}, err
+		}
+		todoListPayload.Position = position
+
+		if err := t.todoListRepo.Create(ctx, trx, todoListPayload, false); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.Create", funcName, err, captureFieldError, "")
+
+			return nil, generalEntity.Event{}, err
+		}
+
+		history := newTodoListHistory(userID, mentity.TodoListHistoryCreate, nil, todoListPayload)
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+			return nil, generalEntity.Event{}, err
+		}
+
+		res := newTodoListResponse(todoListPayload)
+
+		return res, newEvent(generalEntity.EventTodoListCreated, userID, res), nil
+	}
+
+	// Locking Data, other users' data is treated as not exist
+	lockedData, err := t.todoListRepo.LockByID(ctx, trx, op.ID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
+
+		return nil, generalEntity.Event{}, err
+	}
+	if lockedData == nil || lockedData.UserID != userID {
+		return nil, generalEntity.Event{}, apperr.ErrRecordNotFound()
+	}
+
+	now := time.Now()
+	changes := &mentity.TodoList{Version: lockedData.Version + 1, UpdatedAt: now}
+
+	switch op.Op {
+	case entity.BulkOperationDelete:
+		if err := t.todoListRepo.DeleteByID(ctx, trx, op.ID); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.DeleteByID", funcName, err, captureFieldError, "")
+
+			return nil, generalEntity.Event{}, err
+		}
+
+		history := newTodoListHistory(userID, mentity.TodoListHistoryDelete, lockedData, nil)
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+			return nil, generalEntity.Event{}, err
+		}
+
+		return nil, newEvent(generalEntity.EventTodoListDeleted, userID, newTodoListResponse(lockedData)), nil
+	case entity.BulkOperationUpdate:
+		changes.Title = todoListReq.Title
+		changes.Description = todoListReq.Description
+		changes.DoingAt = doingAt
+	case entity.BulkOperationComplete:
+		changes.CompletedAt = &now
+	}
+	history := newTodoListHistory(userID, mentity.TodoListHistoryUpdate, lockedData, changes)
+
+	if err := t.todoListRepo.Update(ctx, trx, lockedData, changes); err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.Update", funcName, err, captureFieldError, "")
+
+		return nil, generalEntity.Event{}, err
+	}
+
+	if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+		helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+		return nil, generalEntity.Event{}, err
+	}
+
+	// gorm writes the changes into lockedData
+	res := newTodoListResponse(lockedData)
+	eventType := generalEntity.EventTodoListUpdated
+	if op.Op == entity.BulkOperationComplete {
+		eventType = generalEntity.EventTodoListCompleted
+	}
+
+	return res, newEvent(eventType, userID, res), nil
+}
+
+// Move places a Todo List right before BeforeID or right after AfterID. Only the moved row is updated,
+// its new position is generated between the target and the target neighbour. Moved and target rows
+// are locked so concurrent moves on the same rows are applied one after another
+func (t *CrudTodoListUsecase) Move(ctx context.Context, moveReq entity.MoveTodoListReq) (*entity.TodoListResponse, error) {
+	funcName := "CrudTodoListUsecase.Move"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(moveReq.UserID),
+		"payload": helper.ToString(moveReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, moveReq); err != nil {
+		return nil, err
+	}
+
+	var res *entity.TodoListResponse
+	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
+		// Locking Data, other users' data is treated as not exist
+		lockedData, err := t.todoListRepo.LockByID(ctx, trx, moveReq.ID)
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		if lockedData == nil || lockedData.UserID != moveReq.UserID {
+			return apperr.ErrRecordNotFound()
+		}
+
+		targetID := moveReq.AfterID
+		if moveReq.BeforeID != 0 {
+			targetID = moveReq.BeforeID
+		}
+
+		target, err := t.todoListRepo.LockByID(ctx, trx, targetID)
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.LockByID", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		if target == nil || target.UserID != moveReq.UserID {
+			return apperr.ErrRecordNotFound()
+		}
+
+		var prev, next string
+		if moveReq.BeforeID != 0 {
+			next = target.Position
+			prev, err = t.todoListRepo.GetPrevPosition(ctx, trx, moveReq.UserID, target.Position, lockedData.ID)
+		} else {
+			prev = target.Position
+			next, err = t.todoListRepo.GetNextPosition(ctx, trx, moveReq.UserID, target.Position, lockedData.ID)
+		}
+		if err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.GetPosition", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		position, err := helper.RankBetween(prev, next)
+		if err != nil {
+			helper.LogErrorContext(ctx, "helper.RankBetween", funcName, err, captureFieldError, "")
+
+			return apperr.ErrMoveConflict()
+		}
+
+		now := time.Now()
+		changes := &mentity.TodoList{
+			Position:  position,
+			Version:   lockedData.Version + 1,
+			UpdatedAt: now,
+		}
+		history := newTodoListHistory(moveReq.UserID, mentity.TodoListHistoryUpdate, lockedData, changes)
+
+		if err := t.todoListRepo.Update(ctx, trx, lockedData, changes); err != nil {
+			helper.LogErrorContext(ctx, "todoListRepo.Update", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		if err := t.todoListHistoryRepo.Create(ctx, trx, history); err != nil {
+			helper.LogErrorContext(ctx, "todoListHistoryRepo.Create", funcName, err, captureFieldError, "")
+
+			return err
+		}
+
+		res = &entity.TodoListResponse{
+			ID:          lockedData.ID,
+			Title:       lockedData.Title,
+			Description: lockedData.Description,
+			DoingAt:     helper.ConvertToJakartaDate(lockedData.DoingAt),
+			CompletedAt: formatCompletedAt(lockedData.CompletedAt),
+			Position:    position,
+			Version:     changes.Version,
+			CreatedAt:   helper.ConvertToJakartaTime(lockedData.CreatedAt),
+			UpdatedAt:   helper.ConvertToJakartaTime(now),
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	t.publishEvents(ctx, newEvent(generalEntity.EventTodoListUpdated, moveReq.UserID, res))
+
+	return res, nil
+}
+
+// nextPosition returns the position after the last user Todo List, must be called inside DB transaction
+func (t *CrudTodoListUsecase) nextPosition(ctx context.Context, trx mysql.TrxObj, userID int64) (string, error) {
+	last, err := t.todoListRepo.GetLastPosition(ctx, trx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return helper.RankBetween(last, "")
+}
+
+// newTodoListHistory builds history entry with field diff of before and after, before is nil on create
+// and after is nil on delete. On update after is the changes struct, only its non-zero fields are compared.
+// Build it before calling Update because gorm writes the changes into the updated model
+func newTodoListHistory(actorID int64, action mentity.TodoListHistoryAction, before *mentity.TodoList, after *mentity.TodoList) *mentity.TodoListHistory {
+	return buildTodoListHistory(actorID, action, before, after, true)
+}
+
+// newTodoListPatchHistory builds update history from before and the whole patched Todo List,
+// so fields cleared by the patch (zero value) are recorded too
+func newTodoListPatchHistory(actorID int64, before *mentity.TodoList, after *mentity.TodoList) *mentity.TodoListHistory {
+	return buildTodoListHistory(actorID, mentity.TodoListHistoryUpdate, before, after, false)
+}
+
+func buildTodoListHistory(actorID int64, action mentity.TodoListHistoryAction, before *mentity.TodoList, after *mentity.TodoList, onlyNonZeroAfter bool) *mentity.TodoListHistory {
+	data := before
+	if data == nil {
+		data = after
+	}
+
+	diff := helper.StructDiff(before, after, onlyNonZeroAfter, "ID", "UserID", "Version", "CreatedAt", "UpdatedAt")
+	changes, _ := json.Marshal(diff)
+
+	return &mentity.TodoListHistory{
+		TodoListID: data.ID,
+		UserID:     data.UserID,
+		ActorID:    actorID,
+		Action:     action,
+		Changes:    string(changes),
+		CreatedAt:  time.Now(),
+	}
+}
+
+func (t *CrudTodoListUsecase) publishEvents(ctx context.Context, events ...generalEntity.Event) {
+	publishTodoListEvents(ctx, t.queue, t.publisher, events...)
+}
+
+// publishTodoListEvents publishes committed changes to the real-time publisher and the queue, failures are only logged
+// because the change is already committed. nil queue or publisher is skipped
+func publishTodoListEvents(ctx context.Context, q queue.Queue, publisher realtime.Publisher, events ...generalEntity.Event) {
+	for _, event := range events {
+		if publisher != nil {
+			publisher.Publish(event)
+		}
+		if q == nil {
+			continue
+		}
+
+		payload, _ := helper.Serialize(event)
+		if err := q.PublishWithContext(ctx, queue.ProcessTodoListEvent, payload, 1); err != nil {
+			helper.LogErrorContext(ctx, "queue.Publish", "publishTodoListEvents", err, generalEntity.CaptureFields{
+				"event_id":   event.ID,
+				"event_type": event.Type,
+				"user_id":    helper.ToString(event.UserID),
+			}, "")
+		}
+	}
+}
+
+func newEvent(eventType string, userID int64, data interface{}) generalEntity.Event {
+	return generalEntity.Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		UserID:     userID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+// newTodoListResponse builds the response of a single Todo List, updated_at is empty until it is updated
+func newTodoListResponse(data *mentity.TodoList) *entity.TodoListResponse {
+	res := &entity.TodoListResponse{
+		ID:          data.ID,
+		Title:       data.Title,
+		Description: data.Description,
+		DoingAt:     helper.ConvertToJakartaDate(data.DoingAt),
+		CompletedAt: formatCompletedAt(data.CompletedAt),
+		Position:    data.Position,
+		Version:     data.Version,
+		CreatedAt:   helper.ConvertToJakartaTime(data.CreatedAt),
+	}
+	if !data.UpdatedAt.IsZero() {
+		res.UpdatedAt = helper.ConvertToJakartaTime(data.UpdatedAt)
+	}
+
+	return res
+}
+
+func newBulkResult(index int, op entity.BulkTodoListOperation, data *entity.TodoListResponse, err error) entity.BulkTodoListResult {
+	result := entity.BulkTodoListResult{
+		Index:   index,
+		Op:      op.Op,
+		ID:      op.ID,
+		Success: err == nil,
+		Data:    data,
+	}
+	if data != nil {
+		result.ID = data.ID
+	}
+
+	if err != nil {
+		// Unknown errors (ex. database errors) are internal, their message must not be sent to clients
+		errResponse := apperr.ResponseOf(err, false)
+		result.Error = &errResponse
+	}
+
+	return result
+}
+
+func newBulkResponse(mode entity.BulkMode, committed bool, results []entity.BulkTodoListResult) *entity.BulkTodoListResponse {
+	res := &entity.BulkTodoListResponse{
+		Mode:      mode,
+		Committed: committed,
+		Results:   results,
+	}
+
+	for _, r := range results {
+		if r.Success {
+			res.Succeeded++
+		} else {
+			res.Failed++
+		}
+	}
+
+	return res
+}
+
+func formatCompletedAt(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return helper.ConvertToJakartaTime(*t)
+}
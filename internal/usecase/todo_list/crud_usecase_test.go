@@ -2,9 +2,17 @@ package todo_list_usecase_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
 
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/parser"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
 	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
 	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
@@ -15,15 +23,45 @@ import (
 
 type CrudTodoListUsecaseTestSuite struct {
 	suite.Suite
-	usecase *todo_list_usecase.CrudTodoListUsecase
-	repo    *mocks.ITodoListRepository
-	trxObj  *mocks.TrxObj
+	usecase     *todo_list_usecase.CrudTodoListUsecase
+	repo        *mocks.ITodoListRepository
+	historyRepo *mocks.ITodoListHistoryRepository
+	queue       *mocks.Queue
+	publisher   *mocks.Publisher
+	trxObj      *mocks.TrxObj
 }
 
 func (s *CrudTodoListUsecaseTestSuite) SetupTest() {
 	s.repo = &mocks.ITodoListRepository{}
+	s.historyRepo = &mocks.ITodoListHistoryRepository{}
+	s.queue = &mocks.Queue{}
+	s.publisher = &mocks.Publisher{}
 	s.trxObj = &mocks.TrxObj{}
-	s.usecase = todo_list_usecase.NewCrudTodoListUsecase(s.repo)
+	s.usecase = todo_list_usecase.NewCrudTodoListUsecase(s.repo, s.historyRepo, s.queue, s.publisher)
+
+	s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoListEvent, mock.Anything, int32(1)).Return(nil).Maybe()
+	s.publisher.On("Publish", mock.Anything).Return().Maybe()
+}
+
+// publishedEvents returns types of events published since the last call,
+// the same events must be published to the queue and the real-time publisher
+func (s *CrudTodoListUsecaseTestSuite) publishedEvents() []string {
+	var types []string
+	for _, call := range s.queue.Calls {
+		var event generalEntity.Event
+		_ = json.Unmarshal(call.Arguments.Get(2).([]byte), &event)
+		types = append(types, event.Type)
+	}
+	s.queue.Calls = nil
+
+	var realtimeTypes []string
+	for _, call := range s.publisher.Calls {
+		realtimeTypes = append(realtimeTypes, call.Arguments.Get(0).(generalEntity.Event).Type)
+	}
+	s.publisher.Calls = nil
+	s.Equal(types, realtimeTypes)
+
+	return types
 }
 
 func TestCrudTodoListUsecase(t *testing.T) {
@@ -77,6 +115,7 @@ func (s *CrudTodoListUsecaseTestSuite) TestGetByUserID() {
 func (s *CrudTodoListUsecaseTestSuite) TestGetByID() {
 	ctx := context.Background()
 	id := int64(1)
+	userID := int64(1)
 
 	testcases := []struct {
 		name     string
@@ -87,11 +126,19 @@ func (s *CrudTodoListUsecaseTestSuite) TestGetByID() {
 		{
 			name: "Success",
 			mockFunc: func() {
-				s.repo.On("GetByID", ctx, id).Return(&mentity.TodoList{ID: 1, Title: "Test"}, nil).Once()
+				s.repo.On("GetByID", ctx, id).Return(&mentity.TodoList{ID: 1, UserID: userID, Title: "Test"}, nil).Once()
 			},
 			wantNil: false,
 			wantErr: false,
 		},
+		{
+			name: "Not Found (Todo List of another user)",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, id).Return(&mentity.TodoList{ID: 1, UserID: 2, Title: "Test"}, nil).Once()
+			},
+			wantNil: true,
+			wantErr: false,
+		},
 		{
 			name: "Not Found (Repo returns nil data, nil error)",
 			// Assuming repository pattern might return nil, nil if using Take() with error handling
@@ -119,7 +166,7 @@ func (s *CrudTodoListUsecaseTestSuite) TestGetByID() {
 	for _, tt := range testcases {
 		s.Run(tt.name, func() {
 			tt.mockFunc()
-			res, err := s.usecase.GetByID(ctx, id)
+			res, err := s.usecase.GetByID(ctx, userID, id)
 			if tt.wantErr {
 				s.Error(err)
 			} else {
@@ -155,8 +202,31 @@ func (s *CrudTodoListUsecaseTestSuite) TestCreate() {
 			mockFunc: func() {
 				// usecase.ValidateStruct -> ok
 				// ParseDate -> ok
-				// repo.Create -> ok
-				s.repo.On("Create", ctx, mock.Anything, mock.Anything, false).Return(nil).Once()
+				// repo.Create -> ok (placed after last position inside transaction)
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, req.UserID).Return("U00000", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoList) bool {
+					return params.Position == "U00100"
+				}), false).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoListHistory) bool {
+					return params.Action == mentity.TodoListHistoryCreate && params.ActorID == req.UserID &&
+						strings.Contains(params.Changes, `"title":{"old":null,"new":"Test"}`)
+				})).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success first Todo List",
+			req:  req,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, req.UserID).Return("", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoList) bool {
+					return params.Position == "U00000"
+				}), false).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
 			},
 			wantErr: false,
 		},
@@ -172,7 +242,32 @@ func (s *CrudTodoListUsecaseTestSuite) TestCreate() {
 			name: "Repo Error",
 			req:  req,
 			mockFunc: func() {
-				s.repo.On("Create", ctx, mock.Anything, mock.Anything, false).Return(errors.New("db error")).Once()
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, req.UserID).Return("U00000", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.Anything, false).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "History Error",
+			req:  req,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, req.UserID).Return("U00000", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.Anything, false).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "GetLastPosition Error",
+			req:  req,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, req.UserID).Return("", errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
 			},
 			wantErr: true,
 		},
@@ -185,9 +280,11 @@ func (s *CrudTodoListUsecaseTestSuite) TestCreate() {
 			if tt.wantErr {
 				s.Error(err)
 				s.Nil(res)
+				s.Empty(s.publishedEvents())
 			} else {
 				s.NoError(err)
 				s.NotNil(res)
+				s.Equal([]string{generalEntity.EventTodoListCreated}, s.publishedEvents())
 			}
 		})
 	}
@@ -202,11 +299,18 @@ func (s *CrudTodoListUsecaseTestSuite) TestUpdateByID() {
 		Description: "Updated Desc",
 		DoingAt:     "2023-10-28",
 	}
+	// gorm writes the changes into the updated model
+	applyChanges := func(args mock.Arguments) {
+		args.Get(2).(*mentity.TodoList).Version = args.Get(3).(*mentity.TodoList).Version
+	}
 
 	testcases := []struct {
-		name     string
-		mockFunc func()
-		wantErr  bool
+		name        string
+		ifMatch     string
+		mockFunc    func()
+		wantErr     bool
+		errIs       error
+		wantVersion int64
 	}{
 		{
 			name: "Success",
@@ -217,16 +321,52 @@ func (s *CrudTodoListUsecaseTestSuite) TestUpdateByID() {
 				// Inside callback:
 				// LockByID
 				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
-					Return(&mentity.TodoList{ID: 1, Title: "Old"}, nil).Once()
+					Return(&mentity.TodoList{ID: 1, UserID: 1, Title: "Old"}, nil).Once()
 
 				// Update
 				s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.Anything).
-					Return(nil).Once()
+					Run(applyChanges).Return(nil).Once()
+
+				// History only contains changed fields
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoListHistory) bool {
+					return params.Action == mentity.TodoListHistoryUpdate && params.TodoListID == 1 &&
+						strings.Contains(params.Changes, `"title":{"old":"Old","new":"Updated"}`) &&
+						!strings.Contains(params.Changes, "updated_at")
+				})).Return(nil).Once()
 
 				// Commit
 				s.trxObj.On("Commit").Return(nil).Once()
 			},
-			wantErr: false,
+			wantErr:     false,
+			wantVersion: 1,
+		},
+		{
+			name:    "Precondition Failed (If-Match mismatch)",
+			ifMatch: `"1"`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
+					Return(&mentity.TodoList{ID: 1, UserID: 1, Title: "Old", Version: 2}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrPreconditionFailed(),
+		},
+		{
+			name:    "Success (If-Match match)",
+			ifMatch: `"1", "2"`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
+					Return(&mentity.TodoList{ID: 1, UserID: 1, Title: "Old", Version: 2}, nil).Once()
+				s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.MatchedBy(func(changes *mentity.TodoList) bool {
+					return changes.Version == 3
+				})).Run(applyChanges).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantErr:     false,
+			wantVersion: 3,
 		},
 		{
 			name: "Begin Error",
@@ -256,12 +396,24 @@ func (s *CrudTodoListUsecaseTestSuite) TestUpdateByID() {
 			},
 			wantErr: true,
 		},
+		{
+			name:    "Not Found (other user data)",
+			ifMatch: `"2"`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
+					Return(&mentity.TodoList{ID: 1, UserID: 99, Title: "Old", Version: 2}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRecordNotFound(),
+		},
 		{
 			name: "Update Error",
 			mockFunc: func() {
 				s.repo.On("Begin").Return(s.trxObj, nil).Once()
 				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
-					Return(&mentity.TodoList{ID: 1}, nil).Once()
+					Return(&mentity.TodoList{ID: 1, UserID: 1}, nil).Once()
 				s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.Anything).
 					Return(errors.New("update error")).Once()
 				s.trxObj.On("Rollback").Return(nil).Once()
@@ -273,9 +425,10 @@ func (s *CrudTodoListUsecaseTestSuite) TestUpdateByID() {
 			mockFunc: func() {
 				s.repo.On("Begin").Return(s.trxObj, nil).Once()
 				s.repo.On("LockByID", ctx, s.trxObj, req.ID).
-					Return(&mentity.TodoList{ID: 1}, nil).Once()
+					Return(&mentity.TodoList{ID: 1, UserID: 1}, nil).Once()
 				s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.Anything).
 					Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
 				s.trxObj.On("Commit").Return(errors.New("commit error")).Once()
 				// If commit fails, rollback might be called depending on implementation logic,
 				// checking db transaction implementation:
@@ -290,19 +443,191 @@ func (s *CrudTodoListUsecaseTestSuite) TestUpdateByID() {
 	for _, tt := range testcases {
 		s.Run(tt.name, func() {
 			tt.mockFunc()
-			err := s.usecase.UpdateByID(ctx, req)
+			updateReq := req
+			updateReq.IfMatch = tt.ifMatch
+
+			res, err := s.usecase.UpdateByID(ctx, updateReq)
 			if tt.wantErr {
 				s.Error(err)
+				s.Nil(res)
+				if tt.errIs != nil {
+					s.Equal(tt.errIs, err)
+				}
+				s.Empty(s.publisher.Calls)
 			} else {
 				s.NoError(err)
+				s.Equal(tt.wantVersion, res.Version)
+				// The event is published to the owner of the Todo List
+				s.Require().Len(s.publisher.Calls, 1)
+				s.Equal(int64(1), s.publisher.Calls[0].Arguments.Get(0).(generalEntity.Event).UserID)
+				s.Equal([]string{generalEntity.EventTodoListUpdated}, s.publishedEvents())
+			}
+		})
+	}
+}
+
+func (s *CrudTodoListUsecaseTestSuite) TestPatchByID() {
+	ctx := context.Background()
+	userID := int64(1)
+	id := int64(1)
+	doingAt, _ := helper.ParseDate("2023-10-27")
+	current := func() *mentity.TodoList {
+		return &mentity.TodoList{ID: id, UserID: userID, Title: "Test", Description: "Desc", DoingAt: doingAt, Version: 2}
+	}
+
+	testcases := []struct {
+		name            string
+		patch           string
+		ifMatch         string
+		mockFunc        func()
+		wantErr         bool
+		errIs           error
+		wantDescription string
+		wantVersion     int64
+	}{
+		{
+			name:  "Success clear description",
+			patch: `{"description": null}`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.repo.On("UpdateColumns", ctx, s.trxObj, mock.Anything, mock.MatchedBy(func(changes *mentity.TodoList) bool {
+					return changes.Description == "" && changes.Title == "Test" && changes.Version == 3
+				}), []string{"description", "version", "updated_at"}).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoListHistory) bool {
+					return params.Changes == `{"description":{"old":"Desc","new":""}}`
+				})).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantDescription: "",
+			wantVersion:     3,
+		},
+		{
+			name:  "Success JSON Patch",
+			patch: `[{"op": "replace", "path": "/title", "value": "New"}, {"op": "remove", "path": "/description"}]`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.repo.On("UpdateColumns", ctx, s.trxObj, mock.Anything, mock.MatchedBy(func(changes *mentity.TodoList) bool {
+					return changes.Description == "" && changes.Title == "New"
+				}), []string{"description", "title", "version", "updated_at"}).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantDescription: "",
+			wantVersion:     3,
+		},
+		{
+			name:    "Success empty patch",
+			patch:   `{}`,
+			ifMatch: `"2"`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantDescription: "Desc",
+			wantVersion:     2,
+		},
+		{
+			name:  "Validation Error (supplied field)",
+			patch: `{"title": null}`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Invalid Request (unknown field)",
+			patch: `{"completed": true}`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRequest(),
+		},
+		{
+			name:  "Invalid Request (JSON Patch replace with null)",
+			patch: `[{"op": "replace", "path": "/description", "value": null}]`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRequest(),
+		},
+		{
+			name:    "Precondition Failed",
+			patch:   `{"title": "New"}`,
+			ifMatch: `"1"`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrPreconditionFailed(),
+		},
+		{
+			name:  "Not Found (other user data)",
+			patch: `{"title": "New"}`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(&mentity.TodoList{ID: id, UserID: 99}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRecordNotFound(),
+		},
+		{
+			name:  "Error UpdateColumns",
+			patch: `{"title": "New"}`,
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(current(), nil).Once()
+				s.repo.On("UpdateColumns", ctx, s.trxObj, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			decode := parser.DecodeMergePatch
+			if strings.HasPrefix(tt.patch, "[") {
+				decode = parser.DecodeJSONPatch
+			}
+			patch, err := decode([]byte(tt.patch))
+			s.Require().NoError(err)
+
+			res, err := s.usecase.PatchByID(ctx, entity.PatchTodoListReq{ID: id, UserID: userID, IfMatch: tt.ifMatch, Patch: patch})
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				if tt.errIs != nil {
+					s.Equal(tt.errIs, err)
+				}
+				return
 			}
+			s.NoError(err)
+			s.Equal(tt.wantDescription, res.Description)
+			s.Equal(tt.wantVersion, res.Version)
 		})
 	}
 }
 
 func (s *CrudTodoListUsecaseTestSuite) TestDeleteByID() {
 	ctx := context.Background()
+	userID := int64(1)
 	id := int64(1)
+	data := &mentity.TodoList{ID: id, UserID: userID, Title: "Test"}
 
 	testcases := []struct {
 		name     string
@@ -312,14 +637,33 @@ func (s *CrudTodoListUsecaseTestSuite) TestDeleteByID() {
 		{
 			name: "Success",
 			mockFunc: func() {
-				s.repo.On("DeleteByID", ctx, mock.Anything, id).Return(nil).Once()
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(data, nil).Once()
+				s.repo.On("DeleteByID", ctx, s.trxObj, id).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.MatchedBy(func(params *mentity.TodoListHistory) bool {
+					return params.Action == mentity.TodoListHistoryDelete && params.TodoListID == id &&
+						strings.Contains(params.Changes, `"title":{"old":"Test","new":null}`)
+				})).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
 			},
 			wantErr: false,
 		},
+		{
+			name: "Not Found (other user data)",
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(&mentity.TodoList{ID: id, UserID: 99}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
 		{
 			name: "Error",
 			mockFunc: func() {
-				s.repo.On("DeleteByID", ctx, mock.Anything, id).Return(errors.New("db error")).Once()
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, id).Return(data, nil).Once()
+				s.repo.On("DeleteByID", ctx, s.trxObj, id).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
 			},
 			wantErr: true,
 		},
@@ -328,7 +672,7 @@ func (s *CrudTodoListUsecaseTestSuite) TestDeleteByID() {
 	for _, tt := range testcases {
 		s.Run(tt.name, func() {
 			tt.mockFunc()
-			err := s.usecase.DeleteByID(ctx, id)
+			err := s.usecase.DeleteByID(ctx, userID, id)
 			if tt.wantErr {
 				s.Error(err)
 			} else {
@@ -337,3 +681,390 @@ func (s *CrudTodoListUsecaseTestSuite) TestDeleteByID() {
 		})
 	}
 }
+
+func (s *CrudTodoListUsecaseTestSuite) TestBulk() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	createOp := entity.BulkTodoListOperation{
+		Op:          entity.BulkOperationCreate,
+		Title:       "Test",
+		Description: "Desc",
+		DoingAt:     "2023-10-27",
+	}
+	completeOp := entity.BulkTodoListOperation{Op: entity.BulkOperationComplete, ID: 2}
+	deleteOp := entity.BulkTodoListOperation{Op: entity.BulkOperationDelete, ID: 3}
+
+	testcases := []struct {
+		name          string
+		req           entity.BulkTodoListReq
+		mockFunc      func()
+		wantErr       bool
+		wantCommitted bool
+		wantSucceeded int
+		wantFailed    int
+		wantEvents    []string
+	}{
+		{
+			name: "Validation Error",
+			req:  entity.BulkTodoListReq{UserID: userID},
+			mockFunc: func() {
+				// ValidateStruct will fail. Repo not called.
+			},
+			wantErr: true,
+		},
+		{
+			name: "Atomic Success",
+			req: entity.BulkTodoListReq{
+				UserID:     userID,
+				Operations: []entity.BulkTodoListOperation{createOp, completeOp, deleteOp},
+			},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, userID).Return("", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.Anything, false).Return(nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, completeOp.ID).
+					Return(&mentity.TodoList{ID: completeOp.ID, UserID: userID}, nil).Once()
+				s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.Anything).Return(nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, deleteOp.ID).
+					Return(&mentity.TodoList{ID: deleteOp.ID, UserID: userID}, nil).Once()
+				s.repo.On("DeleteByID", ctx, s.trxObj, deleteOp.ID).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Times(3)
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantCommitted: true,
+			wantSucceeded: 3,
+			wantEvents: []string{
+				generalEntity.EventTodoListCreated,
+				generalEntity.EventTodoListCompleted,
+				generalEntity.EventTodoListDeleted,
+			},
+		},
+		{
+			name: "Atomic Rolled Back (other user's data)",
+			req: entity.BulkTodoListReq{
+				UserID:     userID,
+				Operations: []entity.BulkTodoListOperation{createOp, completeOp},
+			},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, userID).Return("", nil).Once()
+				s.repo.On("Create", ctx, s.trxObj, mock.Anything, false).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, completeOp.ID).
+					Return(&mentity.TodoList{ID: completeOp.ID, UserID: 99}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantCommitted: false,
+			wantFailed:    2,
+		},
+		{
+			name: "Atomic Begin Error",
+			req: entity.BulkTodoListReq{
+				UserID:     userID,
+				Operations: []entity.BulkTodoListOperation{createOp},
+			},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(nil, errors.New("begin error")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Best Effort Partial Failure",
+			req: entity.BulkTodoListReq{
+				UserID:     userID,
+				Mode:       entity.BulkModeBestEffort,
+				Operations: []entity.BulkTodoListOperation{{Op: entity.BulkOperationCreate}, deleteOp},
+			},
+			mockFunc: func() {
+				// First operation fails on validation, second one is still applied
+				s.repo.On("Begin").Return(s.trxObj, nil).Twice()
+				s.trxObj.On("Rollback").Return(nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, deleteOp.ID).
+					Return(&mentity.TodoList{ID: deleteOp.ID, UserID: userID}, nil).Once()
+				s.repo.On("DeleteByID", ctx, s.trxObj, deleteOp.ID).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantCommitted: true,
+			wantSucceeded: 1,
+			wantFailed:    1,
+			wantEvents:    []string{generalEntity.EventTodoListDeleted},
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Bulk(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.wantCommitted, res.Committed)
+				s.Equal(tt.wantSucceeded, res.Succeeded)
+				s.Equal(tt.wantFailed, res.Failed)
+			}
+			s.Equal(tt.wantEvents, s.publishedEvents())
+		})
+	}
+
+	s.Run("Same Data As Single Operations", func() {
+		s.repo.On("Begin").Return(s.trxObj, nil).Once()
+		s.repo.On("GetLastPosition", ctx, s.trxObj, userID).Return("", nil).Once()
+		s.repo.On("Create", ctx, s.trxObj, mock.Anything, false).Return(nil).Once()
+		s.repo.On("LockByID", ctx, s.trxObj, completeOp.ID).
+			Return(&mentity.TodoList{ID: completeOp.ID, UserID: userID, Position: "a0", Version: 1}, nil).Once()
+		// gorm writes the changes into the updated model
+		s.repo.On("Update", ctx, s.trxObj, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			data, changes := args.Get(2).(*mentity.TodoList), args.Get(3).(*mentity.TodoList)
+			data.CompletedAt, data.Version, data.UpdatedAt = changes.CompletedAt, changes.Version, changes.UpdatedAt
+		}).Return(nil).Once()
+		s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Twice()
+		s.trxObj.On("Commit").Return(nil).Once()
+
+		res, err := s.usecase.Bulk(ctx, entity.BulkTodoListReq{
+			UserID:     userID,
+			Operations: []entity.BulkTodoListOperation{createOp, completeOp},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Results, 2)
+
+		created := res.Results[0].Data
+		s.Equal(createOp.Title, created.Title)
+		s.NotEmpty(created.Position)
+		s.Equal(int64(1), created.Version)
+		s.Empty(created.UpdatedAt)
+
+		completed := res.Results[1].Data
+		s.Equal("a0", completed.Position)
+		s.Equal(int64(2), completed.Version)
+		s.NotEmpty(completed.CompletedAt)
+		s.NotEmpty(completed.UpdatedAt)
+		s.publishedEvents()
+	})
+
+	errorCases := []struct {
+		name         string
+		err          error
+		wantHTTPCode int
+		wantMessage  string
+	}{
+		{
+			name:         "Best Effort Internal Error",
+			err:          errors.New("connection refused"),
+			wantHTTPCode: http.StatusInternalServerError,
+			wantMessage:  generalEntity.GENERAL_ERROR_MESSAGE,
+		},
+		{
+			name:         "Best Effort Conflict Error",
+			err:          apperr.Conflict("todo list is locked"),
+			wantHTTPCode: http.StatusConflict,
+			wantMessage:  "todo list is locked",
+		},
+	}
+
+	for _, tt := range errorCases {
+		s.Run(tt.name, func() {
+			s.repo.On("Begin").Return(s.trxObj, nil).Once()
+			s.repo.On("LockByID", ctx, s.trxObj, deleteOp.ID).Return(nil, tt.err).Once()
+			s.trxObj.On("Rollback").Return(nil).Once()
+
+			res, err := s.usecase.Bulk(ctx, entity.BulkTodoListReq{
+				UserID:     userID,
+				Mode:       entity.BulkModeBestEffort,
+				Operations: []entity.BulkTodoListOperation{deleteOp},
+			})
+
+			s.NoError(err)
+			s.Require().NotNil(res.Results[0].Error)
+			s.Equal(tt.wantHTTPCode, res.Results[0].Error.HTTPCode)
+			s.Equal(tt.wantMessage, res.Results[0].Error.Message)
+		})
+	}
+}
+
+func (s *CrudTodoListUsecaseTestSuite) TestMove() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	moved := &mentity.TodoList{ID: 1, UserID: userID, Position: "U00000"}
+	target := &mentity.TodoList{ID: 2, UserID: userID, Position: "U00200"}
+	positionIs := func(position string) interface{} {
+		return mock.MatchedBy(func(changes *mentity.TodoList) bool {
+			return changes.Position == position
+		})
+	}
+
+	testcases := []struct {
+		name         string
+		req          entity.MoveTodoListReq
+		mockFunc     func()
+		wantPosition string
+		wantErr      bool
+	}{
+		{
+			name:     "Validation Error (no target)",
+			req:      entity.MoveTodoListReq{ID: 1, UserID: userID},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Validation Error (both targets)",
+			req:      entity.MoveTodoListReq{ID: 1, UserID: userID, BeforeID: 2, AfterID: 3},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Validation Error (move to itself)",
+			req:      entity.MoveTodoListReq{ID: 1, UserID: userID, BeforeID: 1},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Success Before",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, BeforeID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(moved, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(2)).Return(target, nil).Once()
+				s.repo.On("GetPrevPosition", ctx, s.trxObj, userID, "U00200", int64(1)).Return("U00100", nil).Once()
+				s.repo.On("Update", ctx, s.trxObj, moved, positionIs("U001V")).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantPosition: "U001V",
+		},
+		{
+			name: "Success After Last",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, AfterID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(moved, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(2)).Return(target, nil).Once()
+				s.repo.On("GetNextPosition", ctx, s.trxObj, userID, "U00200", int64(1)).Return("", nil).Once()
+				s.repo.On("Update", ctx, s.trxObj, moved, positionIs("U00300")).Return(nil).Once()
+				s.historyRepo.On("Create", ctx, s.trxObj, mock.Anything).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+			},
+			wantPosition: "U00300",
+		},
+		{
+			name: "Not Found (other user's data)",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, AfterID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(moved, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(2)).Return(&mentity.TodoList{ID: 2, UserID: 99}, nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error LockByID",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, AfterID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(nil, errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Conflict (no position available)",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, BeforeID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(moved, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(2)).Return(&mentity.TodoList{ID: 2, UserID: userID, Position: "U0010"}, nil).Once()
+				s.repo.On("GetPrevPosition", ctx, s.trxObj, userID, "U0010", int64(1)).Return("U001", nil).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error Update",
+			req:  entity.MoveTodoListReq{ID: 1, UserID: userID, BeforeID: 2},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(1)).Return(moved, nil).Once()
+				s.repo.On("LockByID", ctx, s.trxObj, int64(2)).Return(target, nil).Once()
+				s.repo.On("GetPrevPosition", ctx, s.trxObj, userID, "U00200", int64(1)).Return("", nil).Once()
+				s.repo.On("Update", ctx, s.trxObj, moved, mock.Anything).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Move(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+			} else {
+				s.NoError(err)
+				s.Equal(tt.wantPosition, res.Position)
+			}
+		})
+	}
+}
+
+func (s *CrudTodoListUsecaseTestSuite) TestGetHistory() {
+	ctx := context.Background()
+	userID := int64(1)
+	id := int64(1)
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.historyRepo.On("GetByTodoListID", ctx, userID, id).Return([]*mentity.TodoListHistory{
+					{ID: 2, TodoListID: id, UserID: userID, ActorID: userID, Action: mentity.TodoListHistoryUpdate, Changes: `{"title":{"old":"Old","new":"New"}}`},
+					{ID: 1, TodoListID: id, UserID: userID, ActorID: userID, Action: mentity.TodoListHistoryCreate, Changes: `{"title":{"old":null,"new":"Old"}}`},
+				}, nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name: "Success Empty",
+			mockFunc: func() {
+				s.historyRepo.On("GetByTodoListID", ctx, userID, id).Return(nil, nil).Once()
+			},
+			wantLen: 0,
+		},
+		{
+			name: "Error",
+			mockFunc: func() {
+				s.historyRepo.On("GetByTodoListID", ctx, userID, id).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.GetHistory(ctx, userID, id)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				return
+			}
+			s.NoError(err)
+			s.Len(res, tt.wantLen)
+			if tt.wantLen > 0 {
+				s.Equal("update", res[0].Action)
+				s.Equal("New", res[0].Changes["title"].New)
+			}
+		})
+	}
+}
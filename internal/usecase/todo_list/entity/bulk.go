@@ -0,0 +1,54 @@
+package entity
+
+import apperr "github.com/rahmatrdn/go-skeleton/error"
+
+type BulkMode string
+
+const (
+	BulkModeAtomic     BulkMode = "atomic"
+	BulkModeBestEffort BulkMode = "best_effort"
+)
+
+type BulkOperationType string
+
+const (
+	BulkOperationCreate   BulkOperationType = "create"
+	BulkOperationUpdate   BulkOperationType = "update"
+	BulkOperationDelete   BulkOperationType = "delete"
+	BulkOperationComplete BulkOperationType = "complete"
+)
+
+type BulkTodoListReq struct {
+	UserID     int64                   `json:"user_id,omitempty" swaggerignore:"true"`
+	Mode       BulkMode                `json:"mode" validate:"omitempty,oneof=atomic best_effort" name:"Mode" name_en:"Mode"`
+	Operations []BulkTodoListOperation `json:"operations" validate:"required,min=1,max=100,dive" name:"Operasi" name_en:"Operations"`
+}
+
+type BulkTodoListOperation struct {
+	Op          BulkOperationType `json:"op" validate:"required,oneof=create update delete complete" name:"Operasi" name_en:"Operation"`
+	ID          int64             `json:"id,omitempty" validate:"required_unless=Op create" name:"ID" name_en:"ID"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	DoingAt     string            `json:"doing_at,omitempty"`
+}
+
+type BulkTodoListResult struct {
+	Index   int                                 `json:"index"`
+	Op      BulkOperationType                   `json:"op"`
+	ID      int64                               `json:"id,omitempty"`
+	Success bool                                `json:"success"`
+	Data    *TodoListResponse                   `json:"data,omitempty"`
+	Error   *apperr.CustomErrorResponseWithMeta `json:"error,omitempty"`
+}
+
+type BulkTodoListResponse struct {
+	Mode      BulkMode             `json:"mode"`
+	Committed bool                 `json:"committed"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []BulkTodoListResult `json:"results"`
+}
+
+func (r *BulkTodoListReq) SetUserID(UserID int64) {
+	r.UserID = UserID
+}
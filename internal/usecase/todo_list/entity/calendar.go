@@ -0,0 +1,32 @@
+package entity
+
+// CalendarTodoListReq filters todo lists by doing date range (inclusive), the current month is used when empty.
+// TZ is an IANA timezone (default Asia/Jakarta) deciding today and the timestamps of the response,
+// IncludeEmpty returns days without todo lists as well
+type CalendarTodoListReq struct {
+	UserID       int64  `query:"-" swaggerignore:"true"`
+	From         string `query:"from" validate:"omitempty,datetime=2006-01-02" name:"Tanggal Awal" name_en:"Start Date"`
+	To           string `query:"to" validate:"omitempty,datetime=2006-01-02" name:"Tanggal Akhir" name_en:"End Date"`
+	TZ           string `query:"tz" validate:"omitempty,timezone" name:"Zona Waktu" name_en:"Time Zone"`
+	IncludeEmpty bool   `query:"include_empty"`
+}
+
+// TodoListCalendarDay contains todo lists of a doing date ordered by position, Overdue counts the open ones
+// before today
+type TodoListCalendarDay struct {
+	Date      string              `json:"date"`
+	IsToday   bool                `json:"is_today"`
+	Total     int                 `json:"total"`
+	Completed int                 `json:"completed"`
+	Pending   int                 `json:"pending"`
+	Overdue   int                 `json:"overdue"`
+	TodoLists []*TodoListResponse `json:"todo_lists"`
+}
+
+type TodoListCalendarResponse struct {
+	From     string                 `json:"from"`
+	To       string                 `json:"to"`
+	Timezone string                 `json:"timezone"`
+	Today    string                 `json:"today"`
+	Days     []*TodoListCalendarDay `json:"days"`
+}
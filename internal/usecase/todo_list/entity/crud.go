@@ -1,11 +1,29 @@
 package entity
 
+import "github.com/rahmatrdn/go-skeleton/internal/parser"
+
 type TodoListReq struct {
 	ID          int64  `json:"id,omitempty" swaggerignore:"true"`
 	UserID      int64  `json:"user_id,omitempty" validate:"required"`
-	Title       string `json:"title,omitempty" validate:"required" name:"Judul"`
-	Description string `json:"description" validate:"required" name:"Deskripsi"`
-	DoingAt     string `json:"doing_at" validate:"required" name:"Tanggal Aktifitas"`
+	Title       string `json:"title,omitempty" validate:"required" name:"Judul" name_en:"Title"`
+	Description string `json:"description" validate:"required" name:"Deskripsi" name_en:"Description"`
+	DoingAt     string `json:"doing_at" validate:"required" name:"Tanggal Aktifitas" name_en:"Activity Date"`
+	IfMatch     string `json:"-" swaggerignore:"true"` // If-Match header, empty means no version check
+}
+
+// TodoListPatch is the patchable fields of a Todo List, a field set to null in the patch is cleared.
+// Only fields supplied in the patch are validated
+type TodoListPatch struct {
+	Title       string `json:"title" validate:"required,max=200" name:"Judul" name_en:"Title"`
+	Description string `json:"description" name:"Deskripsi" name_en:"Description"`
+	DoingAt     string `json:"doing_at" validate:"required,datetime=2006-01-02" name:"Tanggal Aktifitas" name_en:"Activity Date"`
+}
+
+type PatchTodoListReq struct {
+	ID      int64
+	UserID  int64
+	IfMatch string // If-Match header, empty means no version check
+	Patch   parser.MergePatch
 }
 
 type TodoListResponse struct {
@@ -13,6 +31,9 @@ type TodoListResponse struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	DoingAt     string `json:"doing_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	Position    string `json:"position,omitempty"`
+	Version     int64  `json:"version,omitempty"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -0,0 +1,12 @@
+package entity
+
+import generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+
+type TodoListHistoryResponse struct {
+	ID         int64                              `json:"id"`
+	TodoListID int64                              `json:"todo_list_id"`
+	ActorID    int64                              `json:"actor_id"`
+	Action     string                             `json:"action"`
+	Changes    map[string]generalEntity.FieldDiff `json:"changes"`
+	CreatedAt  string                             `json:"created_at"`
+}
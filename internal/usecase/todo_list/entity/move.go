@@ -0,0 +1,17 @@
+package entity
+
+// MoveTodoListReq moves a todo list right before BeforeID or right after AfterID, only one of them must be filled
+type MoveTodoListReq struct {
+	ID       int64 `json:"id,omitempty" swaggerignore:"true"`
+	UserID   int64 `json:"user_id,omitempty" swaggerignore:"true"`
+	BeforeID int64 `json:"before_id,omitempty" validate:"required_without=AfterID,excluded_with=AfterID,nefield=ID" name:"Sebelum ID" name_en:"Before ID"`
+	AfterID  int64 `json:"after_id,omitempty" validate:"required_without=BeforeID,excluded_with=BeforeID,nefield=ID" name:"Sesudah ID" name_en:"After ID"`
+}
+
+func (r *MoveTodoListReq) SetID(ID int64) {
+	r.ID = ID
+}
+
+func (r *MoveTodoListReq) SetUserID(UserID int64) {
+	r.UserID = UserID
+}
@@ -0,0 +1,19 @@
+package entity
+
+type SearchTodoListReq struct {
+	UserID int64  `query:"-" swaggerignore:"true"`
+	Query  string `query:"q" validate:"required,min=3,max=200" name:"Kata Kunci" name_en:"Keyword"`
+	Page   int    `query:"page" validate:"omitempty,min=1" name:"Halaman" name_en:"Page"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100" name:"Batas" name_en:"Limit"`
+}
+
+type TodoListHighlight struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type TodoListSearchResponse struct {
+	TodoListResponse
+	Relevance float64           `json:"relevance"`
+	Highlight TodoListHighlight `json:"highlight"`
+}
@@ -0,0 +1,39 @@
+package entity
+
+// TodoListStatsReq filters statistics by doing date range (inclusive), the last 30 days are used when empty.
+// GroupBy is day or week (weeks start on Monday)
+type TodoListStatsReq struct {
+	UserID  int64  `query:"-" swaggerignore:"true"`
+	From    string `query:"from" validate:"omitempty,datetime=2006-01-02" name:"Tanggal Awal" name_en:"Start Date"`
+	To      string `query:"to" validate:"omitempty,datetime=2006-01-02" name:"Tanggal Akhir" name_en:"End Date"`
+	GroupBy string `query:"group_by" validate:"omitempty,oneof=day week" name:"Pengelompokan" name_en:"Group By"`
+}
+
+type TodoListStatusStats struct {
+	Completed int64 `json:"completed"`
+	Pending   int64 `json:"pending"`
+	Overdue   int64 `json:"overdue"`
+}
+
+// TodoListPeriodStats counts todo lists scheduled (doing date) and completed within a period,
+// Period is the date of the day or the Monday of the week
+type TodoListPeriodStats struct {
+	Period    string `json:"period"`
+	Scheduled int64  `json:"scheduled"`
+	Completed int64  `json:"completed"`
+}
+
+// TodoListStatsResponse contains statistics of the range except Overdue and streaks which count all todo lists of the user.
+// CompletionRate is completed divided by total (0 to 1), streaks are consecutive days with at least one completed todo list
+type TodoListStatsResponse struct {
+	From           string                 `json:"from"`
+	To             string                 `json:"to"`
+	GroupBy        string                 `json:"group_by"`
+	Total          int64                  `json:"total"`
+	ByStatus       TodoListStatusStats    `json:"by_status"`
+	Overdue        int64                  `json:"overdue"`
+	CompletionRate float64                `json:"completion_rate"`
+	CurrentStreak  int                    `json:"current_streak"`
+	LongestStreak  int                    `json:"longest_streak"`
+	Series         []*TodoListPeriodStats `json:"series"`
+}
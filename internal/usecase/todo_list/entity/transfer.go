@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"encoding/json"
+	"io"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+)
+
+type TransferFormat string
+
+const (
+	TransferFormatCSV  TransferFormat = "csv"
+	TransferFormatJSON TransferFormat = "json"
+	TransferFormatICS  TransferFormat = "ics"
+)
+
+type CalendarComponent string
+
+const (
+	CalendarComponentTodo  CalendarComponent = "vtodo"
+	CalendarComponentEvent CalendarComponent = "vevent"
+)
+
+type ExportTodoListReq struct {
+	UserID    int64             `query:"-" swaggerignore:"true"`
+	Format    TransferFormat    `query:"format" validate:"required,oneof=csv json ics" name:"Format" name_en:"Format"`
+	Component CalendarComponent `query:"component" validate:"omitempty,oneof=vtodo vevent" name:"Komponen Kalender" name_en:"Calendar Component"`
+}
+
+// ExportTodoListFile describes an export result, body is written by calling Write
+// so it can be streamed straight into the response
+type ExportTodoListFile struct {
+	Filename    string
+	ContentType string
+	Write       func(w io.Writer) error
+}
+
+type ImportTodoListReq struct {
+	UserID   int64          `query:"-" swaggerignore:"true"`
+	Format   TransferFormat `query:"format" validate:"required,oneof=csv json ics" name:"Format" name_en:"Format"`
+	DryRun   bool           `query:"dry_run"`
+	Filename string         `query:"-" swaggerignore:"true"`
+	Content  []byte         `query:"-" validate:"required" name:"File" name_en:"File" swaggerignore:"true"`
+}
+
+// ImportTodoListRow is a single todo list decoded from an import file
+type ImportTodoListRow struct {
+	Title       string `json:"title" validate:"required,max=200" name:"Judul" name_en:"Title"`
+	Description string `json:"description" validate:"required" name:"Deskripsi" name_en:"Description"`
+	DoingAt     string `json:"doing_at" validate:"required,datetime=2006-01-02" name:"Tanggal Aktifitas" name_en:"Activity Date"`
+}
+
+type ImportRowError struct {
+	// Row is the position of the record in the file starting from 1 (CSV header excluded)
+	Row    int                           `json:"row"`
+	Errors []generalEntity.ErrorResponse `json:"errors"`
+}
+
+type ImportTodoListResponse struct {
+	Format    TransferFormat   `json:"format"`
+	DryRun    bool             `json:"dry_run"`
+	Queued    bool             `json:"queued"`
+	TotalRows int              `json:"total_rows"`
+	ValidRows int              `json:"valid_rows"`
+	Imported  int              `json:"imported"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+}
+
+// ImportTodoListMessage is the queue payload of a large import processed by the worker
+type ImportTodoListMessage struct {
+	UserID int64               `json:"user_id"`
+	Rows   []ImportTodoListRow `json:"rows"`
+}
+
+func (m *ImportTodoListMessage) LoadFromMap(payload map[string]interface{}) error {
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, m)
+	}
+	return err
+}
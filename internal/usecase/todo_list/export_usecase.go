@@ -0,0 +1,69 @@
+package todo_list_usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const exportBatchSize = 500
+
+type ExportTodoListUsecase struct {
+	todoListRepo mysql.ITodoListRepository
+}
+
+func NewExportTodoListUsecase(
+	todoListRepo mysql.ITodoListRepository,
+) *ExportTodoListUsecase {
+	return &ExportTodoListUsecase{todoListRepo}
+}
+
+type IExportTodoListUsecase interface {
+	Export(ctx context.Context, exportReq entity.ExportTodoListReq) (*entity.ExportTodoListFile, error)
+}
+
+// Export validates the request and returns the export file, rows are read in batches
+// and written only when ExportTodoListFile.Write is called
+func (t *ExportTodoListUsecase) Export(ctx context.Context, exportReq entity.ExportTodoListReq) (*entity.ExportTodoListFile, error) {
+	funcName := "ExportTodoListUsecase.Export"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(exportReq.UserID),
+		"format":  string(exportReq.Format),
+	}
+
+	if err := usecase.ValidateStruct(ctx, exportReq); err != nil {
+		return nil, err
+	}
+
+	return &entity.ExportTodoListFile{
+		Filename:    fmt.Sprintf("todo-lists-%s.%s", helper.DateFilename(), exportReq.Format),
+		ContentType: exportContentType(exportReq.Format),
+		Write: func(w io.Writer) error {
+			encoder := newTodoListEncoder(w, exportReq)
+
+			err := encoder.Begin()
+			if err == nil {
+				err = t.todoListRepo.ChunkByUserID(ctx, exportReq.UserID, exportBatchSize, func(result []*mentity.TodoList) error {
+					return encoder.Encode(result)
+				})
+			}
+			if err == nil {
+				err = encoder.End()
+			}
+			if err != nil {
+				helper.LogErrorContext(ctx, "todoListRepo.ChunkByUserID", funcName, err, captureFieldError, "")
+
+				return err
+			}
+
+			return nil
+		},
+	}, nil
+}
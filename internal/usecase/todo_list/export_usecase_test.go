@@ -0,0 +1,148 @@
+package todo_list_usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportTodoListUsecaseTestSuite struct {
+	suite.Suite
+	usecase *todo_list_usecase.ExportTodoListUsecase
+	repo    *mocks.ITodoListRepository
+}
+
+func (s *ExportTodoListUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.ITodoListRepository{}
+	s.usecase = todo_list_usecase.NewExportTodoListUsecase(s.repo)
+}
+
+func TestExportTodoListUsecase(t *testing.T) {
+	suite.Run(t, new(ExportTodoListUsecaseTestSuite))
+}
+
+func (s *ExportTodoListUsecaseTestSuite) TestExport() {
+	ctx := context.Background()
+	userID := int64(1)
+	doingAt := time.Date(2025, 6, 10, 0, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	completedAt := time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC)
+
+	todoLists := []*mentity.TodoList{
+		{ID: 1, UserID: userID, Title: "Meeting", Description: "Weekly, sync; with team", DoingAt: doingAt},
+		{ID: 2, UserID: userID, Title: "Presentasi", Description: "Membuat PPT", DoingAt: doingAt, CompletedAt: &completedAt},
+		{ID: 3, UserID: userID, Title: "=SUM(A1:A2)", Description: "'+1 sudah di-escape", DoingAt: doingAt},
+	}
+	chunk := func(args mock.Arguments) {
+		fn := args.Get(3).(func([]*mentity.TodoList) error)
+		_ = fn(todoLists)
+	}
+
+	testcases := []struct {
+		name            string
+		req             entity.ExportTodoListReq
+		mockFunc        func()
+		wantContentType string
+		wantContains    []string
+		wantErr         bool
+		wantWriteErr    bool
+	}{
+		{
+			name: "Success CSV",
+			req:  entity.ExportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV},
+			mockFunc: func() {
+				s.repo.On("ChunkByUserID", ctx, userID, 500, mock.Anything).Run(chunk).Return(nil).Once()
+			},
+			wantContentType: "text/csv; charset=utf-8",
+			wantContains: []string{
+				"id,title,description,doing_at,completed_at,created_at,updated_at\n",
+				`1,Meeting,"Weekly, sync; with team",2025-06-10,`,
+				"2,Presentasi,Membuat PPT,2025-06-10,2025-06-10 15:00:00,",
+				"3,'=SUM(A1:A2),''+1 sudah di-escape,2025-06-10,",
+			},
+		},
+		{
+			name: "Success JSON",
+			req:  entity.ExportTodoListReq{UserID: userID, Format: entity.TransferFormatJSON},
+			mockFunc: func() {
+				s.repo.On("ChunkByUserID", ctx, userID, 500, mock.Anything).Run(chunk).Return(nil).Once()
+			},
+			wantContentType: "application/json",
+			wantContains:    []string{`[{"id":1,"title":"Meeting"`, `},{"id":2,`, `"completed_at":"2025-06-10 15:00:00"`, "}]"},
+		},
+		{
+			name: "Success ICS VTODO",
+			req:  entity.ExportTodoListReq{UserID: userID, Format: entity.TransferFormatICS},
+			mockFunc: func() {
+				s.repo.On("ChunkByUserID", ctx, userID, 500, mock.Anything).Run(chunk).Return(nil).Once()
+			},
+			wantContentType: "text/calendar; charset=utf-8",
+			wantContains: []string{
+				"BEGIN:VCALENDAR\r\n",
+				"BEGIN:VTODO\r\nUID:todo-list-1@go-skeleton\r\n",
+				"DESCRIPTION:Weekly\\, sync\\; with team\r\n",
+				"DTSTART;VALUE=DATE:20250610\r\nDUE;VALUE=DATE:20250611\r\nSTATUS:NEEDS-ACTION\r\n",
+				"STATUS:COMPLETED\r\nCOMPLETED:20250610T080000Z\r\n",
+				"END:VCALENDAR\r\n",
+			},
+		},
+		{
+			name: "Success ICS VEVENT",
+			req:  entity.ExportTodoListReq{UserID: userID, Format: entity.TransferFormatICS, Component: entity.CalendarComponentEvent},
+			mockFunc: func() {
+				s.repo.On("ChunkByUserID", ctx, userID, 500, mock.Anything).Run(chunk).Return(nil).Once()
+			},
+			wantContentType: "text/calendar; charset=utf-8",
+			wantContains:    []string{"BEGIN:VEVENT\r\n", "DTSTART;VALUE=DATE:20250610\r\nDTEND;VALUE=DATE:20250611\r\n"},
+		},
+		{
+			name:     "Validation Error (invalid format)",
+			req:      entity.ExportTodoListReq{UserID: userID, Format: "xml"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error Repo",
+			req:  entity.ExportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV},
+			mockFunc: func() {
+				s.repo.On("ChunkByUserID", ctx, userID, 500, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			wantContentType: "text/csv; charset=utf-8",
+			wantWriteErr:    true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			file, err := s.usecase.Export(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(file)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tt.wantContentType, file.ContentType)
+			s.Contains(file.Filename, "."+string(tt.req.Format))
+
+			var buf bytes.Buffer
+			err = file.Write(&buf)
+			if tt.wantWriteErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+			for _, want := range tt.wantContains {
+				s.Contains(buf.String(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package todo_list_usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/realtime"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/redis"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const (
+	importMaxRows        = 10000
+	importQueueThreshold = 500
+	importBatchSize      = 100
+)
+
+type ImportTodoListUsecase struct {
+	todoListRepo        mysql.ITodoListRepository
+	todoListHistoryRepo mysql.ITodoListHistoryRepository
+	queue               queue.Queue
+	publisher           realtime.Publisher
+	statsCache          redis.ITodoListStatsCache
+}
+
+// NewImportTodoListUsecase creates import usecase, when queue is nil large imports are processed synchronously.
+// Imported Todo Lists get create history and are published as created events like a single create, nil publisher is skipped.
+// Cached statistics of the user are invalidated after rows are inserted, statsCache can be nil (caching disabled)
+func NewImportTodoListUsecase(
+	todoListRepo mysql.ITodoListRepository,
+	todoListHistoryRepo mysql.ITodoListHistoryRepository,
+	queue queue.Queue,
+	publisher realtime.Publisher,
+	statsCache redis.ITodoListStatsCache,
+) *ImportTodoListUsecase {
+	return &ImportTodoListUsecase{todoListRepo, todoListHistoryRepo, queue, publisher, statsCache}
+}
+
+type IImportTodoListUsecase interface {
+	Import(ctx context.Context, importReq entity.ImportTodoListReq) (*entity.ImportTodoListResponse, error)
+	ProcessQueuedImport(ctx context.Context, message entity.ImportTodoListMessage) error
+}
+
+// Import decodes and validates every row of the file. Valid rows are imported and invalid rows are
+// reported with their errors, nothing is written on dry-run. Imports larger than importQueueThreshold
+// rows are published to the queue and inserted by the worker
+func (t *ImportTodoListUsecase) Import(ctx context.Context, importReq entity.ImportTodoListReq) (*entity.ImportTodoListResponse, error) {
+	funcName := "ImportTodoListUsecase.Import"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":  helper.ToString(importReq.UserID),
+		"format":   string(importReq.Format),
+		"filename": importReq.Filename,
+	}
+
+	if importReq.Format == "" && importReq.Filename != "" {
+		importReq.Format = entity.TransferFormat(strings.ToLower(strings.TrimPrefix(filepath.Ext(importReq.Filename), ".")))
+	}
+
+	if err := usecase.ValidateStruct(ctx, importReq); err != nil {
+		return nil, err
+	}
+
+	rows, err := decodeImportRows(importReq.Format, bytes.NewReader(importReq.Content))
+	if err != nil {
+		return nil, apperr.ErrInvalidImportFile(err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, apperr.ErrInvalidImportFile("no todo list found")
+	}
+	if len(rows) > importMaxRows {
+		return nil, apperr.ErrInvalidImportFile(fmt.Sprintf("maximum %d rows per file", importMaxRows))
+	}
+
+	res := &entity.ImportTodoListResponse{
+		Format:    importReq.Format,
+		DryRun:    importReq.DryRun,
+		TotalRows: len(rows),
+		Errors:    []entity.ImportRowError{},
+	}
+
+	validRows := make([]entity.ImportTodoListRow, 0, len(rows))
+	for i, row := range rows {
+		if errs := usecase.ValidateStructProcess(ctx, row); len(errs) > 0 {
+			res.Errors = append(res.Errors, entity.ImportRowError{Row: i + 1, Errors: errs})
+			continue
+		}
+		validRows = append(validRows, row)
+	}
+	res.ValidRows = len(validRows)
+	res.Failed = len(res.Errors)
+
+	if importReq.DryRun || len(validRows) == 0 {
+		return res, nil
+	}
+
+	if t.queue != nil && len(validRows) > importQueueThreshold {
+		payload, _ := helper.Serialize(entity.ImportTodoListMessage{
+			UserID: importReq.UserID,
+			Rows:   validRows,
+		})
+		if err := t.queue.PublishWithContext(ctx, queue.ProcessTodoListImport, payload, 1); err != nil {
+			helper.LogErrorContext(ctx, "queue.Publish", funcName, err, captureFieldError, "")
+
+			return nil, err
+		}
+		res.Queued = true
+
+		return res, nil
+	}
+
+	created, err := t.insertRows(ctx, importReq.UserID, validRows)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.BulkCreate", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+	res.Imported = len(validRows)
+	t.publishCreated(ctx, importReq.UserID, created)
+	t.invalidateStats(ctx, importReq.UserID)
+
+	return res, nil
+}
+
+// ProcessQueuedImport inserts rows of a queued import, called by the queue consumer
+func (t *ImportTodoListUsecase) ProcessQueuedImport(ctx context.Context, message entity.ImportTodoListMessage) error {
+	funcName := "ImportTodoListUsecase.ProcessQueuedImport"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(message.UserID),
+		"rows":    helper.ToString(len(message.Rows)),
+	}
+
+	created, err := t.insertRows(ctx, message.UserID, message.Rows)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListRepo.BulkCreate", funcName, err, captureFieldError, "")
+
+		return err
+	}
+	t.publishCreated(ctx, message.UserID, created)
+	t.invalidateStats(ctx, message.UserID)
+
+	return nil
+}
+
+// invalidateStats drops cached statistics of the user, the rows are already inserted so cache errors are logged only
+func (t *ImportTodoListUsecase) invalidateStats(ctx context.Context, userID int64) {
+	if t.statsCache == nil {
+		return
+	}
+
+	if err := t.statsCache.Invalidate(ctx, userID); err != nil {
+		helper.LogErrorContext(ctx, "statsCache.Invalidate", "ImportTodoListUsecase.invalidateStats", err, generalEntity.CaptureFields{
+			"user_id": helper.ToString(userID),
+		}, "")
+	}
+}
+
+// publishCreated publishes a created event for every imported Todo List after the import is committed
+func (t *ImportTodoListUsecase) publishCreated(ctx context.Context, userID int64, todoLists []*mentity.TodoList) {
+	events := make([]generalEntity.Event, 0, len(todoLists))
+	for _, v := range todoLists {
+		events = append(events, newEvent(generalEntity.EventTodoListCreated, userID, newTodoListResponse(v)))
+	}
+	publishTodoListEvents(ctx, t.queue, t.publisher, events...)
+}
+
+// insertRows inserts rows with their create history in one transaction and returns the inserted Todo Lists
+func (t *ImportTodoListUsecase) insertRows(ctx context.Context, userID int64, rows []entity.ImportTodoListRow) ([]*mentity.TodoList, error) {
+	now := time.Now()
+
+	todoLists := make([]*mentity.TodoList, 0, len(rows))
+	for _, row := range rows {
+		doingAt, _ := helper.ParseDate(row.DoingAt)
+
+		todoLists = append(todoLists, &mentity.TodoList{
+			UserID:      userID,
+			Title:       row.Title,
+			Description: row.Description,
+			DoingAt:     doingAt,
+			Version:     1,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+
+	// Imported Todo Lists are appended to the end of user list in file order
+	if err := mysql.DBTransaction(t.todoListRepo, func(trx mysql.TrxObj) error {
+		position, err := t.todoListRepo.GetLastPosition(ctx, trx, userID)
+		if err != nil {
+			return err
+		}
+		for _, v := range todoLists {
+			if position, err = helper.RankBetween(position, ""); err != nil {
+				return err
+			}
+			v.Position = position
+		}
+
+		if err := t.todoListRepo.BulkCreate(ctx, trx, todoLists, importBatchSize); err != nil {
+			return err
+		}
+
+		// IDs are set by BulkCreate
+		histories := make([]*mentity.TodoListHistory, 0, len(todoLists))
+		for _, v := range todoLists {
+			histories = append(histories, newTodoListHistory(userID, mentity.TodoListHistoryCreate, nil, v))
+		}
+
+		return t.todoListHistoryRepo.BulkCreate(ctx, trx, histories, importBatchSize)
+	}); err != nil {
+		return nil, err
+	}
+
+	return todoLists, nil
+}
@@ -0,0 +1,317 @@
+package todo_list_usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportTodoListUsecaseTestSuite struct {
+	suite.Suite
+	usecase     *todo_list_usecase.ImportTodoListUsecase
+	repo        *mocks.ITodoListRepository
+	historyRepo *mocks.ITodoListHistoryRepository
+	trxObj      *mocks.TrxObj
+	queue       *mocks.Queue
+	publisher   *mocks.Publisher
+	statsCache  *mocks.ITodoListStatsCache
+}
+
+func (s *ImportTodoListUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.ITodoListRepository{}
+	s.historyRepo = &mocks.ITodoListHistoryRepository{}
+	s.trxObj = &mocks.TrxObj{}
+	s.queue = &mocks.Queue{}
+	s.publisher = &mocks.Publisher{}
+	s.statsCache = &mocks.ITodoListStatsCache{}
+	s.usecase = todo_list_usecase.NewImportTodoListUsecase(s.repo, s.historyRepo, s.queue, s.publisher, s.statsCache)
+}
+
+// expectCreatedEvents expects a created event per imported Todo List on the queue and the real-time publisher
+func (s *ImportTodoListUsecaseTestSuite) expectCreatedEvents(userID int64, count int) {
+	s.publisher.On("Publish", mock.MatchedBy(func(event generalEntity.Event) bool {
+		return event.Type == generalEntity.EventTodoListCreated && event.UserID == userID
+	})).Return().Times(count)
+	s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoListEvent, mock.Anything, int32(1)).Return(nil).Times(count)
+}
+
+func TestImportTodoListUsecase(t *testing.T) {
+	suite.Run(t, new(ImportTodoListUsecaseTestSuite))
+}
+
+func (s *ImportTodoListUsecaseTestSuite) TestImport() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	csvContent := "title,description,doing_at\n" +
+		"Meeting,Weekly sync,2025-06-10\n" +
+		",Tanpa judul,2025-06-11\n" +
+		"Presentasi,Membuat PPT,10-06-2025\n"
+	jsonContent := `[{"title":"Meeting","description":"Weekly sync","doing_at":"2025-06-10"}]`
+	icsContent := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+		"BEGIN:VTODO\r\nSUMMARY:Meeting\r\nDESCRIPTION:Weekly\\, sync\r\nDTSTART;VALUE=DATE:20250610\r\nEND:VTODO\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:Presentasi dengan deskripsi yang sangat panjang sehingga baris harus\r\n  dilipat\r\nDESCRIPTION:Membuat PPT\r\nDTSTART:20250610T200000Z\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	var largeCSV strings.Builder
+	largeCSV.WriteString("title,description,doing_at\n")
+	for i := 0; i < 501; i++ {
+		largeCSV.WriteString(fmt.Sprintf("Todo %d,Deskripsi,2025-06-10\n", i))
+	}
+
+	testcases := []struct {
+		name     string
+		req      entity.ImportTodoListReq
+		mockFunc func()
+		want     *entity.ImportTodoListResponse
+		wantErr  bool
+	}{
+		{
+			name: "Success CSV with invalid rows",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV, Content: []byte(csvContent)},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.MatchedBy(func(params []*mentity.TodoList) bool {
+					return len(params) == 1 && params[0].Title == "Meeting" && params[0].UserID == userID &&
+						params[0].Position == "U00100"
+				}), 100).Run(func(args mock.Arguments) {
+					// gorm sets the IDs of inserted rows
+					args.Get(2).([]*mentity.TodoList)[0].ID = 10
+				}).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.MatchedBy(func(params []*mentity.TodoListHistory) bool {
+					return len(params) == 1 && params[0].TodoListID == 10 && params[0].UserID == userID &&
+						params[0].Action == mentity.TodoListHistoryCreate && strings.Contains(params[0].Changes, `"title":{"old":null,"new":"Meeting"}`)
+				}), 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(userID, 1)
+				s.statsCache.On("Invalidate", ctx, userID).Return(nil).Once()
+			},
+			want: &entity.ImportTodoListResponse{Format: entity.TransferFormatCSV, TotalRows: 3, ValidRows: 1, Imported: 1, Failed: 2},
+		},
+		{
+			name: "Success CSV with escaped formulas",
+			req: entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV, Content: []byte(
+				"title,description,doing_at\n'=SUM(A1:A2),''+1 sudah di-escape,2025-06-10\n'Catatan,-,2025-06-10\n")},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.MatchedBy(func(params []*mentity.TodoList) bool {
+					return len(params) == 2 &&
+						params[0].Title == "=SUM(A1:A2)" && params[0].Description == "'+1 sudah di-escape" &&
+						params[1].Title == "'Catatan" && params[1].Description == "-"
+				}), 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(userID, 2)
+				s.statsCache.On("Invalidate", ctx, userID).Return(nil).Once()
+			},
+			want: &entity.ImportTodoListResponse{Format: entity.TransferFormatCSV, TotalRows: 2, ValidRows: 2, Imported: 2},
+		},
+		{
+			name: "Success JSON with format detected from filename",
+			req:  entity.ImportTodoListReq{UserID: userID, Filename: "backup.JSON", Content: []byte(jsonContent)},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(userID, 1)
+				s.statsCache.On("Invalidate", ctx, userID).Return(nil).Once()
+			},
+			want: &entity.ImportTodoListResponse{Format: entity.TransferFormatJSON, TotalRows: 1, ValidRows: 1, Imported: 1},
+		},
+		{
+			name: "Success ICS",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatICS, Content: []byte(icsContent)},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.MatchedBy(func(params []*mentity.TodoList) bool {
+					return len(params) == 2 &&
+						params[0].Description == "Weekly, sync" &&
+						params[1].Title == "Presentasi dengan deskripsi yang sangat panjang sehingga baris harus dilipat" &&
+						params[1].DoingAt.Format("2006-01-02") == "2025-06-11"
+				}), 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(userID, 2)
+				s.statsCache.On("Invalidate", ctx, userID).Return(nil).Once()
+			},
+			want: &entity.ImportTodoListResponse{Format: entity.TransferFormatICS, TotalRows: 2, ValidRows: 2, Imported: 2},
+		},
+		{
+			name:     "Dry Run",
+			req:      entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV, DryRun: true, Content: []byte(csvContent)},
+			mockFunc: func() {},
+			want:     &entity.ImportTodoListResponse{Format: entity.TransferFormatCSV, DryRun: true, TotalRows: 3, ValidRows: 1, Failed: 2},
+		},
+		{
+			name: "Large import is queued",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV, Content: []byte(largeCSV.String())},
+			mockFunc: func() {
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoListImport, mock.Anything, int32(1)).Return(nil).Once()
+			},
+			want: &entity.ImportTodoListResponse{Format: entity.TransferFormatCSV, Queued: true, TotalRows: 501, ValidRows: 501},
+		},
+		{
+			name: "Error Publish",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatCSV, Content: []byte(largeCSV.String())},
+			mockFunc: func() {
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessTodoListImport, mock.Anything, int32(1)).Return(errors.New("queue error")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Validation Error (unknown format)",
+			req:      entity.ImportTodoListReq{UserID: userID, Filename: "backup.xlsx", Content: []byte(csvContent)},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Invalid File",
+			req:      entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatJSON, Content: []byte(`{"title":`)},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Invalid ICS File",
+			req:      entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatICS, Content: []byte("SUMMARY:Meeting")},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Empty File",
+			req:      entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatJSON, Content: []byte(`[]`)},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error Repo",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatJSON, Content: []byte(jsonContent)},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error History",
+			req:  entity.ImportTodoListReq{UserID: userID, Format: entity.TransferFormatJSON, Content: []byte(jsonContent)},
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Import(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tt.want.Format, res.Format)
+			s.Equal(tt.want.DryRun, res.DryRun)
+			s.Equal(tt.want.Queued, res.Queued)
+			s.Equal(tt.want.TotalRows, res.TotalRows)
+			s.Equal(tt.want.ValidRows, res.ValidRows)
+			s.Equal(tt.want.Imported, res.Imported)
+			s.Equal(tt.want.Failed, res.Failed)
+			s.Len(res.Errors, tt.want.Failed)
+		})
+	}
+
+	s.repo.AssertExpectations(s.T())
+	s.historyRepo.AssertExpectations(s.T())
+	s.queue.AssertExpectations(s.T())
+	s.publisher.AssertExpectations(s.T())
+	s.statsCache.AssertExpectations(s.T())
+}
+
+func (s *ImportTodoListUsecaseTestSuite) TestProcessQueuedImport() {
+	ctx := context.Background()
+	message := entity.ImportTodoListMessage{
+		UserID: 1,
+		Rows:   []entity.ImportTodoListRow{{Title: "Meeting", Description: "Weekly sync", DoingAt: "2025-06-10"}},
+	}
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(message.UserID, 1)
+				s.statsCache.On("Invalidate", ctx, message.UserID).Return(nil).Once()
+			},
+		},
+		{
+			name: "Success (invalidate error is logged only)",
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.historyRepo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(nil).Once()
+				s.trxObj.On("Commit").Return(nil).Once()
+				s.expectCreatedEvents(message.UserID, 1)
+				s.statsCache.On("Invalidate", ctx, message.UserID).Return(errors.New("redis down")).Once()
+			},
+		},
+		{
+			name: "Error Repo",
+			mockFunc: func() {
+				s.repo.On("Begin").Return(s.trxObj, nil).Once()
+				s.repo.On("GetLastPosition", ctx, s.trxObj, mock.Anything).Return("U00000", nil).Once()
+				s.repo.On("BulkCreate", ctx, s.trxObj, mock.Anything, 100).Return(errors.New("db error")).Once()
+				s.trxObj.On("Rollback").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			err := s.usecase.ProcessQueuedImport(ctx, message)
+			if tt.wantErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+
+	s.historyRepo.AssertExpectations(s.T())
+	s.publisher.AssertExpectations(s.T())
+	s.statsCache.AssertExpectations(s.T())
+}
@@ -0,0 +1,86 @@
+package todo_list_usecase
+
+import (
+	"context"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const defaultSearchLimit = 20
+
+// TodoListSearcher is a full-text search over user todo lists.
+// Results must be ordered by relevance (highest first) and contain highlighted snippets.
+// MySQL implementation is provided by mysql.TodoListRepository (FULLTEXT index),
+// PostgreSQL implementation is provided by postgresql.TodoListSearchRepository (tsvector).
+type TodoListSearcher interface {
+	Search(ctx context.Context, userID int64, query string, limit int, offset int) (result []*generalEntity.TodoListSearchResult, err error)
+}
+
+type SearchTodoListUsecase struct {
+	todoListSearcher TodoListSearcher
+}
+
+// NewSearchTodoListUsecase accepts any full-text search implementation,
+// ex. mysql.TodoListRepository (MySQL) or postgresql.TodoListSearchRepository (PostgreSQL)
+func NewSearchTodoListUsecase(
+	todoListSearcher TodoListSearcher,
+) *SearchTodoListUsecase {
+	return &SearchTodoListUsecase{todoListSearcher}
+}
+
+type ISearchTodoListUsecase interface {
+	Search(ctx context.Context, searchReq entity.SearchTodoListReq) (res []*entity.TodoListSearchResponse, err error)
+}
+
+func (t *SearchTodoListUsecase) Search(ctx context.Context, searchReq entity.SearchTodoListReq) (res []*entity.TodoListSearchResponse, err error) {
+	funcName := "SearchTodoListUsecase.Search"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(searchReq.UserID),
+		"query":   searchReq.Query,
+	}
+
+	if err := usecase.ValidateStruct(ctx, searchReq); err != nil {
+		return nil, err
+	}
+
+	limit := searchReq.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	offset := 0
+	if searchReq.Page > 1 {
+		offset = (searchReq.Page - 1) * limit
+	}
+
+	result, err := t.todoListSearcher.Search(ctx, searchReq.UserID, searchReq.Query, limit, offset)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListSearcher.Search", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res = make([]*entity.TodoListSearchResponse, 0, len(result))
+	for _, v := range result {
+		res = append(res, &entity.TodoListSearchResponse{
+			TodoListResponse: entity.TodoListResponse{
+				ID:          v.ID,
+				Title:       v.Title,
+				Description: v.Description,
+				DoingAt:     helper.ConvertToJakartaDate(v.DoingAt),
+				CompletedAt: formatCompletedAt(v.CompletedAt),
+				CreatedAt:   helper.ConvertToJakartaTime(v.CreatedAt),
+				UpdatedAt:   helper.ConvertToJakartaTime(v.UpdatedAt),
+			},
+			Relevance: v.Relevance,
+			Highlight: entity.TodoListHighlight{
+				Title:       v.TitleSnippet,
+				Description: v.DescriptionSnippet,
+			},
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package todo_list_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchTodoListUsecaseTestSuite struct {
+	suite.Suite
+	usecase  *todo_list_usecase.SearchTodoListUsecase
+	searcher *mocks.TodoListSearcher
+}
+
+func (s *SearchTodoListUsecaseTestSuite) SetupTest() {
+	s.searcher = &mocks.TodoListSearcher{}
+	s.usecase = todo_list_usecase.NewSearchTodoListUsecase(s.searcher)
+}
+
+func TestSearchTodoListUsecase(t *testing.T) {
+	suite.Run(t, new(SearchTodoListUsecaseTestSuite))
+}
+
+func (s *SearchTodoListUsecaseTestSuite) TestSearch() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	testcases := []struct {
+		name     string
+		req      entity.SearchTodoListReq
+		mockFunc func()
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			name: "Success with default pagination",
+			req:  entity.SearchTodoListReq{UserID: userID, Query: "meeting"},
+			mockFunc: func() {
+				s.searcher.On("Search", ctx, userID, "meeting", 20, 0).Return([]*generalEntity.TodoListSearchResult{
+					{
+						ID:           1,
+						UserID:       userID,
+						Title:        "Weekly meeting",
+						Relevance:    0.9,
+						TitleSnippet: "Weekly <mark>meeting</mark>",
+					},
+				}, nil).Once()
+			},
+			wantLen: 1,
+		},
+		{
+			name: "Success with page and limit",
+			req:  entity.SearchTodoListReq{UserID: userID, Query: "meeting", Page: 3, Limit: 10},
+			mockFunc: func() {
+				s.searcher.On("Search", ctx, userID, "meeting", 10, 20).Return([]*generalEntity.TodoListSearchResult{}, nil).Once()
+			},
+			wantLen: 0,
+		},
+		{
+			name: "Validation Error (query too short)",
+			req:  entity.SearchTodoListReq{UserID: userID, Query: "me"},
+			mockFunc: func() {
+				// ValidateStruct will fail. Repo not called.
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error Repo",
+			req:  entity.SearchTodoListReq{UserID: userID, Query: "meeting"},
+			mockFunc: func() {
+				s.searcher.On("Search", ctx, userID, "meeting", 20, 0).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Search(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+			} else {
+				s.NoError(err)
+				s.Len(res, tt.wantLen)
+			}
+		})
+	}
+}
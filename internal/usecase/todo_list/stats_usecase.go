@@ -0,0 +1,261 @@
+package todo_list_usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/redis"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const (
+	statsDefaultDays = 30
+	statsMaxDays     = 366
+	statsGroupByDay  = "day"
+	statsGroupByWeek = "week"
+	dateLayout       = "2006-01-02"
+)
+
+type StatsTodoListUsecase struct {
+	todoListStatsReader mysql.TodoListStatsReader
+	statsCache          redis.ITodoListStatsCache
+}
+
+// NewStatsTodoListUsecase creates statistics usecase, statsCache is optional (nil disables caching).
+// Cached statistics must be invalidated on todo list writes, see Invalidate
+func NewStatsTodoListUsecase(
+	todoListStatsReader mysql.TodoListStatsReader,
+	statsCache redis.ITodoListStatsCache,
+) *StatsTodoListUsecase {
+	return &StatsTodoListUsecase{todoListStatsReader, statsCache}
+}
+
+type IStatsTodoListUsecase interface {
+	GetStats(ctx context.Context, statsReq entity.TodoListStatsReq) (*entity.TodoListStatsResponse, error)
+	Invalidate(ctx context.Context, userID int64)
+}
+
+func (t *StatsTodoListUsecase) GetStats(ctx context.Context, statsReq entity.TodoListStatsReq) (*entity.TodoListStatsResponse, error) {
+	funcName := "StatsTodoListUsecase.GetStats"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(statsReq.UserID),
+		"payload": helper.ToString(statsReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, statsReq); err != nil {
+		return nil, err
+	}
+
+	today, _ := helper.ParseDate(helper.DateNowJakarta())
+	from, to, err := statsRange(ctx, statsReq, today)
+	if err != nil {
+		return nil, err
+	}
+	groupBy := statsReq.GroupBy
+	if groupBy == "" {
+		groupBy = statsGroupByDay
+	}
+
+	// Today is part of the field because overdue and streaks change every day even without writes
+	cacheField := fmt.Sprintf("%s|%s|%s|%s", today.Format(dateLayout), from.Format(dateLayout), to.Format(dateLayout), groupBy)
+	if cached := t.getCache(ctx, statsReq.UserID, cacheField); cached != nil {
+		return cached, nil
+	}
+
+	statusCount, err := t.todoListStatsReader.GetStatusCount(ctx, statsReq.UserID, from, to, today)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListStatsReader.GetStatusCount", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	dailyCount, err := t.todoListStatsReader.GetDailyCount(ctx, statsReq.UserID, from, to)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListStatsReader.GetDailyCount", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	completionDays, err := t.todoListStatsReader.GetCompletionDays(ctx, statsReq.UserID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "todoListStatsReader.GetCompletionDays", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res := &entity.TodoListStatsResponse{
+		From:    from.Format(dateLayout),
+		To:      to.Format(dateLayout),
+		GroupBy: groupBy,
+		Series:  statsSeries(dailyCount, from, to, groupBy),
+	}
+	if statusCount != nil {
+		res.Total = statusCount.Total
+		res.ByStatus = entity.TodoListStatusStats{
+			Completed: statusCount.Completed,
+			Pending:   statusCount.Pending,
+			Overdue:   statusCount.Overdue,
+		}
+		res.Overdue = statusCount.OverdueAll
+		if statusCount.Total > 0 {
+			res.CompletionRate = float64(statusCount.Completed) / float64(statusCount.Total)
+		}
+	}
+	res.CurrentStreak, res.LongestStreak = completionStreaks(completionDays, today)
+
+	t.setCache(ctx, statsReq.UserID, cacheField, res)
+
+	return res, nil
+}
+
+// Invalidate drops cached statistics of the user, cache errors are logged only
+// because statistics expire by TTL anyway
+func (t *StatsTodoListUsecase) Invalidate(ctx context.Context, userID int64) {
+	if t.statsCache == nil {
+		return
+	}
+
+	if err := t.statsCache.Invalidate(ctx, userID); err != nil {
+		helper.LogErrorContext(ctx, "statsCache.Invalidate", "StatsTodoListUsecase.Invalidate", err, generalEntity.CaptureFields{
+			"user_id": helper.ToString(userID),
+		}, "")
+	}
+}
+
+func (t *StatsTodoListUsecase) getCache(ctx context.Context, userID int64, field string) *entity.TodoListStatsResponse {
+	if t.statsCache == nil {
+		return nil
+	}
+
+	value, err := t.statsCache.Get(ctx, userID, field)
+	if err != nil {
+		helper.LogErrorContext(ctx, "statsCache.Get", "StatsTodoListUsecase.GetStats", err, generalEntity.CaptureFields{
+			"user_id": helper.ToString(userID),
+		}, "")
+
+		return nil
+	}
+	if value == nil {
+		return nil
+	}
+
+	var res entity.TodoListStatsResponse
+	if err := json.Unmarshal(value, &res); err != nil {
+		return nil
+	}
+
+	return &res
+}
+
+func (t *StatsTodoListUsecase) setCache(ctx context.Context, userID int64, field string, res *entity.TodoListStatsResponse) {
+	if t.statsCache == nil {
+		return
+	}
+
+	value, _ := json.Marshal(res)
+	if err := t.statsCache.Set(ctx, userID, field, value); err != nil {
+		helper.LogErrorContext(ctx, "statsCache.Set", "StatsTodoListUsecase.GetStats", err, generalEntity.CaptureFields{
+			"user_id": helper.ToString(userID),
+		}, "")
+	}
+}
+
+// statsRange returns the inclusive date range of the request, To defaults to today and
+// From defaults to statsDefaultDays days ending at To
+func statsRange(ctx context.Context, statsReq entity.TodoListStatsReq, today time.Time) (from time.Time, to time.Time, err error) {
+	to = today
+	if statsReq.To != "" {
+		to, _ = helper.ParseDate(statsReq.To)
+	}
+	from = to.AddDate(0, 0, -(statsDefaultDays - 1))
+	if statsReq.From != "" {
+		from, _ = helper.ParseDate(statsReq.From)
+	}
+
+	switch {
+	case from.After(to):
+		err = apperr.InvalidField("From", "ltefield", "To", i18n.Message(ctx, i18n.MsgDateRangeOrder))
+	case int(to.Sub(from).Hours()/24)+1 > statsMaxDays:
+		err = apperr.InvalidField("To", "max", helper.ToString(statsMaxDays),
+			i18n.Message(ctx, i18n.MsgDateRangeMax, statsMaxDays))
+	}
+
+	return from, to, err
+}
+
+// statsSeries fills every period of the range including the ones without todo lists,
+// a week period is its Monday and the first and last weeks only count days inside the range
+func statsSeries(dailyCount []*mentity.TodoListDailyCount, from time.Time, to time.Time, groupBy string) []*entity.TodoListPeriodStats {
+	byDay := make(map[string]*mentity.TodoListDailyCount, len(dailyCount))
+	for _, v := range dailyCount {
+		byDay[v.Day.Format(dateLayout)] = v
+	}
+
+	series := []*entity.TodoListPeriodStats{}
+	var current *entity.TodoListPeriodStats
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		period := day
+		if groupBy == statsGroupByWeek {
+			period = startOfWeek(day)
+		}
+
+		if current == nil || current.Period != period.Format(dateLayout) {
+			current = &entity.TodoListPeriodStats{Period: period.Format(dateLayout)}
+			series = append(series, current)
+		}
+		if v, ok := byDay[day.Format(dateLayout)]; ok {
+			current.Scheduled += v.Scheduled
+			current.Completed += v.Completed
+		}
+	}
+
+	return series
+}
+
+func startOfWeek(day time.Time) time.Time {
+	// Sunday is the last day of the week
+	offset := (int(day.Weekday()) + 6) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
+
+// completionStreaks counts consecutive completion days, completionDays must be ordered latest first. The current
+// streak is still running when the latest completion day is yesterday, so it is not reset before the user completes today
+func completionStreaks(completionDays []time.Time, today time.Time) (current int, longest int) {
+	if len(completionDays) == 0 {
+		return 0, 0
+	}
+
+	run, latestRun := 0, 0
+	var previous time.Time
+	for i, v := range completionDays {
+		day, _ := helper.ParseDate(v.Format(dateLayout))
+		if i == 0 || !previous.AddDate(0, 0, -1).Equal(day) {
+			run = 0
+		}
+		run++
+		previous = day
+
+		// Still in the run of the latest completion day
+		if run == i+1 {
+			latestRun = run
+		}
+		longest = max(longest, run)
+	}
+
+	latest, _ := helper.ParseDate(completionDays[0].Format(dateLayout))
+	if !latest.Before(today.AddDate(0, 0, -1)) {
+		current = latestRun
+	}
+
+	return current, longest
+}
@@ -0,0 +1,216 @@
+package todo_list_usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	todo_list_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsTodoListUsecaseTestSuite struct {
+	suite.Suite
+	usecase     *todo_list_usecase.StatsTodoListUsecase
+	statsReader *mocks.TodoListStatsReader
+	statsCache  *mocks.ITodoListStatsCache
+	today       time.Time
+}
+
+func (s *StatsTodoListUsecaseTestSuite) SetupTest() {
+	s.statsReader = &mocks.TodoListStatsReader{}
+	s.statsCache = &mocks.ITodoListStatsCache{}
+	s.usecase = todo_list_usecase.NewStatsTodoListUsecase(s.statsReader, s.statsCache)
+	s.today, _ = helper.ParseDate(helper.DateNowJakarta())
+}
+
+func TestStatsTodoListUsecase(t *testing.T) {
+	suite.Run(t, new(StatsTodoListUsecaseTestSuite))
+}
+
+func (s *StatsTodoListUsecaseTestSuite) date(value string) time.Time {
+	result, _ := time.Parse("2006-01-02", value)
+
+	return result
+}
+
+func (s *StatsTodoListUsecaseTestSuite) TestGetStats() {
+	ctx := context.Background()
+	userID := int64(1)
+
+	// 2024-01-03 is a Wednesday, weeks start on Monday 2024-01-01 and 2024-01-08
+	from, to := s.date("2024-01-03"), s.date("2024-01-09")
+	dailyCount := []*mentity.TodoListDailyCount{
+		{Day: s.date("2024-01-03"), Scheduled: 2, Completed: 1},
+		{Day: s.date("2024-01-07"), Scheduled: 1, Completed: 2},
+		{Day: s.date("2024-01-08"), Scheduled: 3, Completed: 0},
+	}
+	statusCount := &mentity.TodoListStatusCount{Total: 6, Completed: 3, Pending: 2, Overdue: 1, OverdueAll: 4}
+	completionDays := []time.Time{
+		s.today.AddDate(0, 0, -1),
+		s.today.AddDate(0, 0, -2),
+		s.today.AddDate(0, 0, -10),
+		s.today.AddDate(0, 0, -11),
+		s.today.AddDate(0, 0, -12),
+	}
+
+	mockReader := func() {
+		s.statsReader.On("GetStatusCount", ctx, userID, from, to, s.today).Return(statusCount, nil).Once()
+		s.statsReader.On("GetDailyCount", ctx, userID, from, to).Return(dailyCount, nil).Once()
+		s.statsReader.On("GetCompletionDays", ctx, userID).Return(completionDays, nil).Once()
+	}
+
+	testcases := []struct {
+		name     string
+		req      entity.TodoListStatsReq
+		mockFunc func()
+		want     func(res *entity.TodoListStatsResponse)
+		wantErr  bool
+	}{
+		{
+			name: "Success group by day",
+			req:  entity.TodoListStatsReq{UserID: userID, From: "2024-01-03", To: "2024-01-09"},
+			mockFunc: func() {
+				s.statsCache.On("Get", ctx, userID, mock.Anything).Return(nil, nil).Once()
+				mockReader()
+				s.statsCache.On("Set", ctx, userID, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			want: func(res *entity.TodoListStatsResponse) {
+				s.Equal("day", res.GroupBy)
+				s.Equal(int64(6), res.Total)
+				s.Equal(entity.TodoListStatusStats{Completed: 3, Pending: 2, Overdue: 1}, res.ByStatus)
+				s.Equal(int64(4), res.Overdue)
+				s.Equal(0.5, res.CompletionRate)
+				s.Equal(2, res.CurrentStreak)
+				s.Equal(3, res.LongestStreak)
+				s.Require().Len(res.Series, 7)
+				s.Equal(entity.TodoListPeriodStats{Period: "2024-01-03", Scheduled: 2, Completed: 1}, *res.Series[0])
+				s.Equal(entity.TodoListPeriodStats{Period: "2024-01-04"}, *res.Series[1])
+				s.Equal(entity.TodoListPeriodStats{Period: "2024-01-09"}, *res.Series[6])
+			},
+		},
+		{
+			name: "Success group by week",
+			req:  entity.TodoListStatsReq{UserID: userID, From: "2024-01-03", To: "2024-01-09", GroupBy: "week"},
+			mockFunc: func() {
+				s.statsCache.On("Get", ctx, userID, mock.Anything).Return(nil, nil).Once()
+				mockReader()
+				s.statsCache.On("Set", ctx, userID, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			want: func(res *entity.TodoListStatsResponse) {
+				s.Require().Len(res.Series, 2)
+				s.Equal(entity.TodoListPeriodStats{Period: "2024-01-01", Scheduled: 3, Completed: 3}, *res.Series[0])
+				s.Equal(entity.TodoListPeriodStats{Period: "2024-01-08", Scheduled: 3, Completed: 0}, *res.Series[1])
+			},
+		},
+		{
+			name: "Success from cache",
+			req:  entity.TodoListStatsReq{UserID: userID, From: "2024-01-03", To: "2024-01-09"},
+			mockFunc: func() {
+				cached, _ := json.Marshal(entity.TodoListStatsResponse{Total: 9})
+				s.statsCache.On("Get", ctx, userID, s.today.Format("2006-01-02")+"|2024-01-03|2024-01-09|day").Return(cached, nil).Once()
+			},
+			want: func(res *entity.TodoListStatsResponse) {
+				s.Equal(int64(9), res.Total)
+			},
+		},
+		{
+			name: "Success when cache fails",
+			req:  entity.TodoListStatsReq{UserID: userID, From: "2024-01-03", To: "2024-01-09"},
+			mockFunc: func() {
+				s.statsCache.On("Get", ctx, userID, mock.Anything).Return(nil, errors.New("redis error")).Once()
+				mockReader()
+				s.statsCache.On("Set", ctx, userID, mock.Anything, mock.Anything).Return(errors.New("redis error")).Once()
+			},
+			want: func(res *entity.TodoListStatsResponse) {
+				s.Equal(int64(6), res.Total)
+			},
+		},
+		{
+			name:     "Validation Error (invalid group by)",
+			req:      entity.TodoListStatsReq{UserID: userID, GroupBy: "month"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Validation Error (from after to)",
+			req:      entity.TodoListStatsReq{UserID: userID, From: "2024-01-10", To: "2024-01-09"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name:     "Validation Error (range too long)",
+			req:      entity.TodoListStatsReq{UserID: userID, From: "2023-01-01", To: "2024-01-09"},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error Repo",
+			req:  entity.TodoListStatsReq{UserID: userID, From: "2024-01-03", To: "2024-01-09"},
+			mockFunc: func() {
+				s.statsCache.On("Get", ctx, userID, mock.Anything).Return(nil, nil).Once()
+				s.statsReader.On("GetStatusCount", ctx, userID, from, to, s.today).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tc.mockFunc()
+
+			res, err := s.usecase.GetStats(ctx, tc.req)
+			if tc.wantErr {
+				s.Error(err)
+				s.Nil(res)
+			} else {
+				s.NoError(err)
+				tc.want(res)
+			}
+
+			s.statsReader.AssertExpectations(s.T())
+			s.statsCache.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *StatsTodoListUsecaseTestSuite) TestGetStatsDefaultRange() {
+	ctx := context.Background()
+	usecase := todo_list_usecase.NewStatsTodoListUsecase(s.statsReader, nil)
+	from := s.today.AddDate(0, 0, -29)
+
+	s.statsReader.On("GetStatusCount", ctx, int64(1), from, s.today, s.today).Return(&mentity.TodoListStatusCount{}, nil).Once()
+	s.statsReader.On("GetDailyCount", ctx, int64(1), from, s.today).Return(nil, nil).Once()
+	s.statsReader.On("GetCompletionDays", ctx, int64(1)).Return([]time.Time{s.today.AddDate(0, 0, -2)}, nil).Once()
+
+	res, err := usecase.GetStats(ctx, entity.TodoListStatsReq{UserID: 1})
+	s.Require().NoError(err)
+	s.Equal(from.Format("2006-01-02"), res.From)
+	s.Equal(s.today.Format("2006-01-02"), res.To)
+	s.Len(res.Series, 30)
+	s.Zero(res.CompletionRate)
+	// Latest completion was 2 days ago so the current streak is broken
+	s.Equal(0, res.CurrentStreak)
+	s.Equal(1, res.LongestStreak)
+}
+
+func (s *StatsTodoListUsecaseTestSuite) TestInvalidate() {
+	ctx := context.Background()
+
+	s.statsCache.On("Invalidate", ctx, int64(1)).Return(nil).Once()
+	s.statsCache.On("Invalidate", ctx, int64(2)).Return(errors.New("redis error")).Once()
+
+	s.usecase.Invalidate(ctx, 1)
+	s.usecase.Invalidate(ctx, 2)
+	// Cache is optional
+	todo_list_usecase.NewStatsTodoListUsecase(s.statsReader, nil).Invalidate(ctx, 1)
+
+	s.statsCache.AssertExpectations(s.T())
+}
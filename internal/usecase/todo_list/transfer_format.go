@@ -0,0 +1,412 @@
+package todo_list_usecase
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/todo_list/entity"
+)
+
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405Z"
+	icsLineLimit      = 75
+)
+
+var csvHeader = []string{"id", "title", "description", "doing_at", "completed_at", "created_at", "updated_at"}
+
+// csvFormulaPrefixes are the first characters of a cell that spreadsheets evaluate as formula (CSV injection)
+const csvFormulaPrefixes = "=+-@"
+
+// todoListEncoder writes todo lists into an export file one batch at a time
+type todoListEncoder interface {
+	Begin() error
+	Encode(data []*mentity.TodoList) error
+	End() error
+}
+
+func newTodoListEncoder(w io.Writer, exportReq entity.ExportTodoListReq) todoListEncoder {
+	switch exportReq.Format {
+	case entity.TransferFormatCSV:
+		return &csvEncoder{w: csv.NewWriter(w)}
+	case entity.TransferFormatICS:
+		component := exportReq.Component
+		if component == "" {
+			component = entity.CalendarComponentTodo
+		}
+		return &icsEncoder{w: w, component: strings.ToUpper(string(component))}
+	default:
+		return &jsonEncoder{w: w, first: true}
+	}
+}
+
+func exportContentType(format entity.TransferFormat) string {
+	switch format {
+	case entity.TransferFormatCSV:
+		return "text/csv; charset=utf-8"
+	case entity.TransferFormatICS:
+		return "text/calendar; charset=utf-8"
+	default:
+		return "application/json"
+	}
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) Begin() error {
+	return e.w.Write(csvHeader)
+}
+
+func (e *csvEncoder) Encode(data []*mentity.TodoList) error {
+	for _, v := range data {
+		record := []string{
+			helper.ToString(v.ID),
+			escapeCSVFormula(v.Title),
+			escapeCSVFormula(v.Description),
+			helper.ConvertToJakartaDate(v.DoingAt),
+			formatCompletedAt(v.CompletedAt),
+			helper.ConvertToJakartaTime(v.CreatedAt),
+			helper.ConvertToJakartaTime(v.UpdatedAt),
+		}
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func (e *csvEncoder) End() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// escapeCSVFormula prefixes a cell that would be evaluated as formula with ' so spreadsheets show it as text.
+// A cell that is already escaped (ex. '=1) gets another ' so it is imported back unchanged
+func escapeCSVFormula(s string) string {
+	if isCSVFormula(s) {
+		return "'" + s
+	}
+
+	return s
+}
+
+// unescapeCSVFormula removes the ' added by escapeCSVFormula
+func unescapeCSVFormula(s string) string {
+	if strings.HasPrefix(s, "'") && isCSVFormula(s) {
+		return s[1:]
+	}
+
+	return s
+}
+
+func isCSVFormula(s string) bool {
+	s = strings.TrimLeft(s, "'")
+	return s != "" && strings.ContainsRune(csvFormulaPrefixes, rune(s[0]))
+}
+
+type jsonEncoder struct {
+	w     io.Writer
+	first bool
+}
+
+func (e *jsonEncoder) Begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonEncoder) Encode(data []*mentity.TodoList) error {
+	for _, v := range data {
+		item, err := json.Marshal(entity.TodoListResponse{
+			ID:          v.ID,
+			Title:       v.Title,
+			Description: v.Description,
+			DoingAt:     helper.ConvertToJakartaDate(v.DoingAt),
+			CompletedAt: formatCompletedAt(v.CompletedAt),
+			CreatedAt:   helper.ConvertToJakartaTime(v.CreatedAt),
+			UpdatedAt:   helper.ConvertToJakartaTime(v.UpdatedAt),
+		})
+		if err != nil {
+			return err
+		}
+		if !e.first {
+			if _, err := io.WriteString(e.w, ","); err != nil {
+				return err
+			}
+		}
+		e.first = false
+
+		if _, err := e.w.Write(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *jsonEncoder) End() error {
+	_, err := io.WriteString(e.w, "]")
+	return err
+}
+
+// icsEncoder writes an iCalendar (RFC 5545) file, doing_at is written as an all-day
+// DTSTART with DUE (VTODO) or DTEND (VEVENT) on the next day
+type icsEncoder struct {
+	w         io.Writer
+	component string
+}
+
+func (e *icsEncoder) Begin() error {
+	return e.writeLines(
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Go Skeleton//Todo List//EN",
+		"CALSCALE:GREGORIAN",
+	)
+}
+
+func (e *icsEncoder) Encode(data []*mentity.TodoList) error {
+	for _, v := range data {
+		doingAt, _ := helper.ParseDate(helper.ConvertToJakartaDate(v.DoingAt))
+
+		lines := []string{
+			"BEGIN:" + e.component,
+			fmt.Sprintf("UID:todo-list-%d@go-skeleton", v.ID),
+			"DTSTAMP:" + v.CreatedAt.UTC().Format(icsDateTimeLayout),
+			"SUMMARY:" + escapeICSText(v.Title),
+			"DESCRIPTION:" + escapeICSText(v.Description),
+			"DTSTART;VALUE=DATE:" + doingAt.Format(icsDateLayout),
+		}
+
+		nextDay := doingAt.AddDate(0, 0, 1).Format(icsDateLayout)
+		if e.component == "VEVENT" {
+			lines = append(lines, "DTEND;VALUE=DATE:"+nextDay)
+		} else {
+			lines = append(lines, "DUE;VALUE=DATE:"+nextDay)
+			if v.CompletedAt != nil {
+				lines = append(lines,
+					"STATUS:COMPLETED",
+					"COMPLETED:"+v.CompletedAt.UTC().Format(icsDateTimeLayout),
+				)
+			} else {
+				lines = append(lines, "STATUS:NEEDS-ACTION")
+			}
+		}
+		lines = append(lines, "END:"+e.component)
+
+		if err := e.writeLines(lines...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *icsEncoder) End() error {
+	return e.writeLines("END:VCALENDAR")
+}
+
+func (e *icsEncoder) writeLines(lines ...string) error {
+	for _, line := range lines {
+		if _, err := io.WriteString(e.w, foldICSLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// foldICSLine splits content lines longer than 75 octets, continuation lines start with a space
+func foldICSLine(line string) string {
+	if len(line) <= icsLineLimit {
+		return line
+	}
+
+	var b strings.Builder
+	size := 0
+	for _, r := range line {
+		runeLen := len(string(r))
+		if size+runeLen > icsLineLimit {
+			b.WriteString("\r\n ")
+			size = 1
+		}
+		b.WriteRune(r)
+		size += runeLen
+	}
+
+	return b.String()
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+var icsTextUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+func decodeImportRows(format entity.TransferFormat, r io.Reader) ([]entity.ImportTodoListRow, error) {
+	switch format {
+	case entity.TransferFormatCSV:
+		return decodeCSVRows(r)
+	case entity.TransferFormatICS:
+		return decodeICSRows(r)
+	default:
+		return decodeJSONRows(r)
+	}
+}
+
+// decodeCSVRows reads rows by header name, so exported files (with id, created_at, etc.) can be imported back.
+// Cells escaped against formula evaluation on export are unescaped
+func decodeCSVRows(r io.Reader) ([]entity.ImportTodoListRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("column title not found in header")
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return unescapeCSVFormula(strings.TrimSpace(record[i]))
+	}
+
+	var rows []entity.ImportTodoListRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, entity.ImportTodoListRow{
+			Title:       value(record, "title"),
+			Description: value(record, "description"),
+			DoingAt:     value(record, "doing_at"),
+		})
+	}
+
+	return rows, nil
+}
+
+func decodeJSONRows(r io.Reader) ([]entity.ImportTodoListRow, error) {
+	var rows []entity.ImportTodoListRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// decodeICSRows reads every VTODO and VEVENT component, doing_at is taken from DTSTART (or DUE when DTSTART is missing)
+func decodeICSRows(r io.Reader) ([]entity.ImportTodoListRow, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("BEGIN:VCALENDAR not found")
+	}
+
+	var rows []entity.ImportTodoListRow
+	var current *entity.ImportTodoListRow
+	var due string
+
+	for _, line := range lines {
+		name, params, value := parseICSLine(line)
+
+		switch {
+		case name == "BEGIN" && (value == "VTODO" || value == "VEVENT"):
+			current = &entity.ImportTodoListRow{}
+			due = ""
+		case name == "END" && (value == "VTODO" || value == "VEVENT") && current != nil:
+			if current.DoingAt == "" {
+				current.DoingAt = due
+			}
+			rows = append(rows, *current)
+			current = nil
+		case current == nil:
+			continue
+		case name == "SUMMARY":
+			current.Title = strings.TrimSpace(icsTextUnescaper.Replace(value))
+		case name == "DESCRIPTION":
+			current.Description = strings.TrimSpace(icsTextUnescaper.Replace(value))
+		case name == "DTSTART":
+			current.DoingAt = parseICSDate(value, params)
+		case name == "DUE":
+			due = parseICSDate(value, params)
+		}
+	}
+
+	return rows, nil
+}
+
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+// parseICSLine splits a content line "NAME;PARAM=VALUE:value" into its name, parameters and value
+func parseICSLine(line string) (name string, params string, value string) {
+	head, value, _ := strings.Cut(line, ":")
+	name, params, _ = strings.Cut(head, ";")
+
+	return strings.ToUpper(name), strings.ToUpper(params), value
+}
+
+// parseICSDate converts DATE (20250610) or DATE-TIME (20250610T090000Z) value into 2006-01-02,
+// UTC date-times are converted to Asia/Jakarta date. Invalid value is returned as is so it is reported by validation
+func parseICSDate(value string, params string) string {
+	if t, err := time.Parse(icsDateTimeLayout, value); err == nil && !strings.Contains(params, "VALUE=DATE") {
+		return helper.ConvertToJakartaDate(t)
+	}
+	if len(value) >= len(icsDateLayout) {
+		if t, err := time.Parse(icsDateLayout, value[:len(icsDateLayout)]); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+
+	return value
+}
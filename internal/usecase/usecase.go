@@ -1,38 +1,26 @@
 package usecase
 
 import (
-	"encoding/json"
-	"reflect"
+	"context"
 
-	"github.com/go-playground/locales/id"
-	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
-	id_translations "github.com/go-playground/validator/v10/translations/id"
 	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
 )
 
-var validate = validator.New()
-var uni *ut.UniversalTranslator
-
-// func init() {
-// 	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
-// 		return field.Tag.Get("name")
-// 	})
-// }
-
-func ValidateStructProcess(data interface{}) []entity.ErrorResponse {
-	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
-		return field.Tag.Get("name")
-	})
-
-	id := id.New()
-	uni = ut.New(id, id)
-
-	trans, _ := uni.GetTranslator("id")
-	id_translations.RegisterDefaultTranslations(validate, trans)
+// ValidateStructProcess validates data, when fields (Go field names) are given only those fields are validated.
+// Messages are in the language of ctx (see i18n.Language)
+func ValidateStructProcess(ctx context.Context, data interface{}, fields ...string) []entity.ErrorResponse {
+	validate, trans := i18n.Validator(i18n.Language(ctx))
 
 	var errors []entity.ErrorResponse
-	err := validate.Struct(data)
+	var err error
+	if len(fields) > 0 {
+		err = validate.StructPartial(data, fields...)
+	} else {
+		err = validate.Struct(data)
+	}
 
 	if err != nil {
 		for _, err := range err.(validator.ValidationErrors) {
@@ -42,19 +30,6 @@ func ValidateStructProcess(data interface{}) []entity.ErrorResponse {
 			element.Value = err.Param()
 			element.Message = err.Translate(trans)
 
-			// Available responses
-			// fmt.Println(err.Namespace())
-			// fmt.Println(err.Field())
-			// fmt.Println(err.StructNamespace())
-			// fmt.Println(err.StructField())
-			// fmt.Println(err.Tag())
-			// fmt.Println(err.ActualTag())
-			// fmt.Println(err.Kind())
-			// fmt.Println(err.Type())
-			// fmt.Println(err.Value())
-			// fmt.Println(err.Param())
-			// fmt.Println(err.Translate(trans))
-
 			errors = append(errors, element)
 		}
 	}
@@ -62,13 +37,20 @@ func ValidateStructProcess(data interface{}) []entity.ErrorResponse {
 	return errors
 }
 
-func ValidateStruct(data interface{}) string {
-	validate := ValidateStructProcess(data)
-	jsonString, _ := json.Marshal(validate)
+// ValidateStruct returns validation error (apperr.ErrValidation) with the failed fields, nil when data is valid
+func ValidateStruct(ctx context.Context, data interface{}) error {
+	if errs := ValidateStructProcess(ctx, data); len(errs) > 0 {
+		return apperr.Validation(errs)
+	}
+
+	return nil
+}
 
-	if string(jsonString) != "null" {
-		return string(jsonString) + "XX"
+// ValidateStructPartial is ValidateStruct for the given fields only (ex. fields supplied in a PATCH request)
+func ValidateStructPartial(ctx context.Context, data interface{}, fields ...string) error {
+	if errs := ValidateStructProcess(ctx, data, fields...); len(errs) > 0 {
+		return apperr.Validation(errs)
 	}
 
-	return ""
+	return nil
 }
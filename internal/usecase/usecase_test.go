@@ -1,16 +1,18 @@
 package usecase_test
 
 import (
-	"encoding/json"
+	"context"
 	"testing"
 
 	"github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase"
 	"github.com/stretchr/testify/assert"
 )
 
 type ValidationTestStruct struct {
-	Name  string `validate:"required" name:"Nama"`
+	Name  string `validate:"required" name:"Nama" name_en:"Name"`
 	Email string `validate:"required,email" name:"Email"`
 }
 
@@ -45,7 +47,7 @@ func TestValidateStructProcess(t *testing.T) {
 
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
-			errs := usecase.ValidateStructProcess(tt.data)
+			errs := usecase.ValidateStructProcess(context.Background(), tt.data)
 			if tt.wantErr {
 				assert.NotEmpty(t, errs)
 				// Optional: Verify strict fields
@@ -61,40 +63,69 @@ func TestValidateStructProcess(t *testing.T) {
 
 func TestValidateStruct(t *testing.T) {
 	testcases := []struct {
-		name       string
-		data       interface{}
-		wantSuffix string
-		wantEmpty  bool
+		name    string
+		data    interface{}
+		wantErr bool
 	}{
 		{
-			name:       "Success",
-			data:       ValidationTestStruct{Name: "Test", Email: "test@example.com"},
-			wantSuffix: "",
-			wantEmpty:  true,
+			name:    "Success",
+			data:    ValidationTestStruct{Name: "Test", Email: "test@example.com"},
+			wantErr: false,
 		},
 		{
-			name:       "Error",
-			data:       ValidationTestStruct{},
-			wantSuffix: "XX",
-			wantEmpty:  false,
+			name:    "Error",
+			data:    ValidationTestStruct{},
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
-			msg := usecase.ValidateStruct(tt.data)
-			if tt.wantEmpty {
-				assert.Empty(t, msg)
-			} else {
-				assert.NotEmpty(t, msg)
-				assert.Contains(t, msg, tt.wantSuffix)
-
-				// Verify it's a valid JSON + XX
-				jsonPart := msg[:len(msg)-2]
-				var errs []entity.ErrorResponse
-				err := json.Unmarshal([]byte(jsonPart), &errs)
+			err := usecase.ValidateStruct(context.Background(), tt.data)
+			if !tt.wantErr {
 				assert.NoError(t, err)
-				assert.NotEmpty(t, errs)
+				return
+			}
+
+			var appErr *apperr.Error
+			assert.ErrorIs(t, err, apperr.ErrValidation)
+			if assert.ErrorAs(t, err, &appErr) {
+				assert.Equal(t, entity.INVALID_PAYLOAD_CODE, appErr.Code)
+				assert.Len(t, appErr.Fields, 2)
+			}
+		})
+	}
+}
+
+func TestValidateStructProcessLanguage(t *testing.T) {
+	testcases := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "Default",
+			ctx:      context.Background(),
+			expected: "Nama wajib diisi",
+		},
+		{
+			name:     "English",
+			ctx:      i18n.NewContext(context.Background(), i18n.English),
+			expected: "Name is a required field",
+		},
+		{
+			name:     "Indonesian",
+			ctx:      i18n.NewContext(context.Background(), i18n.Indonesian),
+			expected: "Nama wajib diisi",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := usecase.ValidateStructProcess(tt.ctx, ValidationTestStruct{Email: "test@example.com"})
+			if assert.Len(t, errs, 1) {
+				assert.Equal(t, "Name", errs[0].FailedField)
+				assert.Equal(t, tt.expected, errs[0].Message)
 			}
 		})
 	}
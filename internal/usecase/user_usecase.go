@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	errwrap "github.com/pkg/errors"
 	"github.com/rahmatrdn/go-skeleton/entity"
 	apperr "github.com/rahmatrdn/go-skeleton/error"
 	"github.com/rahmatrdn/go-skeleton/internal/helper"
@@ -37,7 +36,7 @@ func (w *User) VerifyByEmailAndPassword(ctx context.Context, req *entity.LoginRe
 
 	user, err := w.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
-		helper.Log(entity.LogError, "userRepo.GetByEmail", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "userRepo.GetByEmail", funcName, err, captureFieldError, "")
 
 		if err == apperr.ErrUserNotFound() {
 			return nil, apperr.ErrInvalidEmailOrPassword()
@@ -52,7 +51,7 @@ func (w *User) VerifyByEmailAndPassword(ctx context.Context, req *entity.LoginRe
 
 	token, err := w.jwtAuth.GenerateToken(user)
 	if err != nil {
-		helper.Log(entity.LogError, "userRepo.GenerateToken", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "jwtAuth.GenerateToken", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
@@ -74,13 +73,13 @@ func (w *User) CreateAsGuest(ctx context.Context, createUserReq *entity.CreateUs
 		"name": createUserReq.Name,
 	}
 
-	if errMsg := ValidateStruct(*createUserReq); errMsg != "" {
-		return nil, errwrap.Wrap(fmt.Errorf(entity.INVALID_PAYLOAD_CODE), errMsg)
+	if err := ValidateStruct(ctx, *createUserReq); err != nil {
+		return nil, err
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(createUserReq.Password), bcrypt.DefaultCost)
 	if err != nil {
-		helper.LogError("bcrypt.GenerateFromPassword", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "bcrypt.GenerateFromPassword", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
@@ -95,14 +94,14 @@ func (w *User) CreateAsGuest(ctx context.Context, createUserReq *entity.CreateUs
 
 	err = w.userRepo.Create(ctx, nil, user)
 	if err != nil {
-		helper.LogError("userRepo.Create", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "userRepo.Create", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
 
 	token, err := w.jwtAuth.GenerateToken(user)
 	if err != nil {
-		helper.LogError("userRepo.GetByEmail", funcName, err, captureFieldError, "")
+		helper.LogErrorContext(ctx, "userRepo.GetByEmail", funcName, err, captureFieldError, "")
 
 		return nil, err
 	}
@@ -1,8 +1,10 @@
 package usecase_test
 
 import (
+	"context"
 	"testing"
 
+	apperr "github.com/rahmatrdn/go-skeleton/error"
 	"github.com/rahmatrdn/go-skeleton/internal/usecase"
 	"github.com/stretchr/testify/suite"
 )
@@ -56,7 +58,7 @@ func (s *ValidatorUsecaseTestSuite) TestValidate() {
 
 	for _, tt := range testcases {
 		s.Run(tt.name, func() {
-			errs := s.usecase.Validate(tt.data)
+			errs := s.usecase.Validate(context.Background(), tt.data)
 			if tt.wantErr {
 				s.NotEmpty(errs)
 			} else {
@@ -66,29 +68,36 @@ func (s *ValidatorUsecaseTestSuite) TestValidate() {
 	}
 }
 
-func (s *ValidatorUsecaseTestSuite) TestValidateWithMessage() {
+func (s *ValidatorUsecaseTestSuite) TestValidateWithError() {
 	testcases := []struct {
-		name       string
-		data       interface{}
-		wantSuffix string
+		name    string
+		data    interface{}
+		wantErr bool
 	}{
 		{
-			name:       "Success",
-			data:       ValidatorTestStruct{Name: "Test", Email: "test@example.com"},
-			wantSuffix: "XX",
+			name:    "Success",
+			data:    ValidatorTestStruct{Name: "Test", Email: "test@example.com"},
+			wantErr: false,
 		},
 		{
-			name:       "Error",
-			data:       ValidatorTestStruct{},
-			wantSuffix: "XX",
+			name:    "Error",
+			data:    ValidatorTestStruct{},
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range testcases {
 		s.Run(tt.name, func() {
-			msg := s.usecase.ValidateWithMessage(tt.data)
-			s.Contains(msg, tt.wantSuffix)
-			s.NotEmpty(msg)
+			err := s.usecase.ValidateWithError(context.Background(), tt.data)
+			if !tt.wantErr {
+				s.NoError(err)
+				return
+			}
+
+			var appErr *apperr.Error
+			s.ErrorIs(err, apperr.ErrValidation)
+			s.Require().ErrorAs(err, &appErr)
+			s.NotEmpty(appErr.Fields)
 		})
 	}
 }
@@ -0,0 +1,384 @@
+package webhook_usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/i18n"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/webhook/entity"
+)
+
+const (
+	webhookSecretPrefix   = "whsec_"
+	webhookDeliveryLimit  = 100
+	webhookDeliveryWindow = time.Minute
+)
+
+type CrudWebhookUsecase struct {
+	webhookRepo         mysql.IWebhookRepository
+	webhookDeliveryRepo mysql.IWebhookDeliveryRepository
+	queue               queue.Queue
+}
+
+// NewCrudWebhookUsecase creates webhook subscription usecase, redeliveries are published to the queue
+// (topic webhook.delivery), when queue is nil they are picked up by the retry scheduler
+func NewCrudWebhookUsecase(
+	webhookRepo mysql.IWebhookRepository,
+	webhookDeliveryRepo mysql.IWebhookDeliveryRepository,
+	queue queue.Queue,
+) *CrudWebhookUsecase {
+	return &CrudWebhookUsecase{webhookRepo, webhookDeliveryRepo, queue}
+}
+
+type ICrudWebhookUsecase interface {
+	GetByUserID(ctx context.Context, userID int64) ([]*entity.WebhookResponse, error)
+	GetByID(ctx context.Context, userID int64, webhookID int64) (*entity.WebhookResponse, error)
+	Create(ctx context.Context, webhookReq entity.WebhookReq) (*entity.WebhookResponse, error)
+	UpdateByID(ctx context.Context, webhookReq entity.WebhookReq) (*entity.WebhookResponse, error)
+	DeleteByID(ctx context.Context, userID int64, webhookID int64) error
+	GetDeliveries(ctx context.Context, userID int64, webhookID int64) ([]*entity.WebhookDeliveryResponse, error)
+	Redeliver(ctx context.Context, userID int64, webhookID int64, deliveryID int64) (*entity.WebhookDeliveryResponse, error)
+}
+
+func (t *CrudWebhookUsecase) GetByUserID(ctx context.Context, userID int64) ([]*entity.WebhookResponse, error) {
+	funcName := "CrudWebhookUsecase.GetByUserID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(userID),
+	}
+
+	result, err := t.webhookRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.GetByUserID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res := make([]*entity.WebhookResponse, 0, len(result))
+	for _, v := range result {
+		res = append(res, newWebhookResponse(v))
+	}
+
+	return res, nil
+}
+
+func (t *CrudWebhookUsecase) GetByID(ctx context.Context, userID int64, webhookID int64) (*entity.WebhookResponse, error) {
+	data, err := t.getOwnedWebhook(ctx, "CrudWebhookUsecase.GetByID", userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWebhookResponse(data), nil
+}
+
+// Create subscribes URL to event types, the generated secret is only returned here
+// so the receiver can verify X-Webhook-Signature
+func (t *CrudWebhookUsecase) Create(ctx context.Context, webhookReq entity.WebhookReq) (*entity.WebhookResponse, error) {
+	funcName := "CrudWebhookUsecase.Create"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id": helper.ToString(webhookReq.UserID),
+		"payload": helper.ToString(webhookReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, webhookReq); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookURL(ctx, webhookReq.URL); err != nil {
+		return nil, err
+	}
+
+	secret, err := helper.RandomHex(24)
+	if err != nil {
+		helper.LogErrorContext(ctx, "helper.RandomHex", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	webhook := &mentity.Webhook{
+		UserID:     webhookReq.UserID,
+		URL:        webhookReq.URL,
+		Secret:     webhookSecretPrefix + secret,
+		EventTypes: joinEventTypes(webhookReq.EventTypes),
+		IsActive:   true,
+		CreatedAt:  time.Now(),
+	}
+	if err := t.webhookRepo.Create(ctx, webhook); err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.Create", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res := newWebhookResponse(webhook)
+	res.Secret = webhook.Secret
+
+	return res, nil
+}
+
+// UpdateByID replaces URL and event types, activating a disabled webhook resets its failure count
+func (t *CrudWebhookUsecase) UpdateByID(ctx context.Context, webhookReq entity.WebhookReq) (*entity.WebhookResponse, error) {
+	funcName := "CrudWebhookUsecase.UpdateByID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":    helper.ToString(webhookReq.UserID),
+		"webhook_id": helper.ToString(webhookReq.ID),
+		"payload":    helper.ToString(webhookReq),
+	}
+
+	if err := usecase.ValidateStruct(ctx, webhookReq); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookURL(ctx, webhookReq.URL); err != nil {
+		return nil, err
+	}
+
+	data, err := t.getOwnedWebhook(ctx, funcName, webhookReq.UserID, webhookReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &mentity.Webhook{
+		URL:        webhookReq.URL,
+		EventTypes: joinEventTypes(webhookReq.EventTypes),
+		UpdatedAt:  time.Now(),
+	}
+	columns := []string{"url", "event_types", "updated_at"}
+	if webhookReq.IsActive != nil {
+		changes.IsActive = *webhookReq.IsActive
+		columns = append(columns, "is_active")
+		if changes.IsActive && !data.IsActive {
+			columns = append(columns, "failure_count", "disabled_at")
+		}
+	}
+
+	if err := t.webhookRepo.UpdateColumns(ctx, data, changes, columns...); err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.UpdateColumns", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	data.URL, data.EventTypes, data.UpdatedAt = changes.URL, changes.EventTypes, changes.UpdatedAt
+	if webhookReq.IsActive != nil {
+		if changes.IsActive && !data.IsActive {
+			data.FailureCount, data.DisabledAt = 0, nil
+		}
+		data.IsActive = changes.IsActive
+	}
+
+	return newWebhookResponse(data), nil
+}
+
+// DeleteByID deletes webhook together with its delivery log
+func (t *CrudWebhookUsecase) DeleteByID(ctx context.Context, userID int64, webhookID int64) error {
+	funcName := "CrudWebhookUsecase.DeleteByID"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":    helper.ToString(userID),
+		"webhook_id": helper.ToString(webhookID),
+	}
+
+	if _, err := t.getOwnedWebhook(ctx, funcName, userID, webhookID); err != nil {
+		return err
+	}
+
+	if err := t.webhookRepo.DeleteByID(ctx, webhookID); err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.DeleteByID", funcName, err, captureFieldError, "")
+
+		return err
+	}
+
+	return nil
+}
+
+// GetDeliveries returns the latest deliveries of a user webhook, newest first
+func (t *CrudWebhookUsecase) GetDeliveries(ctx context.Context, userID int64, webhookID int64) ([]*entity.WebhookDeliveryResponse, error) {
+	funcName := "CrudWebhookUsecase.GetDeliveries"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":    helper.ToString(userID),
+		"webhook_id": helper.ToString(webhookID),
+	}
+
+	if _, err := t.getOwnedWebhook(ctx, funcName, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	result, err := t.webhookDeliveryRepo.GetByWebhookID(ctx, webhookID, webhookDeliveryLimit)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.GetByWebhookID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+
+	res := make([]*entity.WebhookDeliveryResponse, 0, len(result))
+	for _, v := range result {
+		res = append(res, newWebhookDeliveryResponse(v))
+	}
+
+	return res, nil
+}
+
+// Redeliver sends the payload of a previous delivery again as a new delivery, the original delivery is kept in the log
+func (t *CrudWebhookUsecase) Redeliver(ctx context.Context, userID int64, webhookID int64, deliveryID int64) (*entity.WebhookDeliveryResponse, error) {
+	funcName := "CrudWebhookUsecase.Redeliver"
+	captureFieldError := generalEntity.CaptureFields{
+		"user_id":     helper.ToString(userID),
+		"webhook_id":  helper.ToString(webhookID),
+		"delivery_id": helper.ToString(deliveryID),
+	}
+
+	if _, err := t.getOwnedWebhook(ctx, funcName, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	original, err := t.webhookDeliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.GetByID", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+	if original == nil || original.WebhookID != webhookID {
+		return nil, apperr.ErrRecordNotFound()
+	}
+
+	delivery := newWebhookDelivery(webhookID, original.EventID, original.EventType, original.Payload)
+	if err := t.webhookDeliveryRepo.Create(ctx, delivery); err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.Create", funcName, err, captureFieldError, "")
+
+		return nil, err
+	}
+	publishWebhookDelivery(ctx, t.queue, funcName, delivery.ID)
+
+	return newWebhookDeliveryResponse(delivery), nil
+}
+
+// getOwnedWebhook returns user webhook, other users' webhook is treated as not exist
+func (t *CrudWebhookUsecase) getOwnedWebhook(ctx context.Context, funcName string, userID int64, webhookID int64) (*mentity.Webhook, error) {
+	data, err := t.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.GetByID", funcName, err, generalEntity.CaptureFields{
+			"user_id":    helper.ToString(userID),
+			"webhook_id": helper.ToString(webhookID),
+		}, "")
+
+		return nil, err
+	}
+	if data == nil || data.UserID != userID {
+		return nil, apperr.ErrRecordNotFound()
+	}
+
+	return data, nil
+}
+
+// newWebhookDelivery builds pending delivery, it is published right away and re-published by
+// the retry scheduler when it is still pending after webhookDeliveryWindow (ex. publish failed)
+func newWebhookDelivery(webhookID int64, eventID string, eventType string, payload string) *mentity.WebhookDelivery {
+	now := time.Now()
+	nextRetryAt := now.Add(webhookDeliveryWindow)
+
+	return &mentity.WebhookDelivery{
+		WebhookID:   webhookID,
+		EventID:     eventID,
+		EventType:   eventType,
+		Payload:     payload,
+		Status:      mentity.WebhookDeliveryPending,
+		NextRetryAt: &nextRetryAt,
+		CreatedAt:   now,
+	}
+}
+
+// publishWebhookDelivery publishes delivery to the worker, failures are only logged because
+// pending deliveries are re-published by the retry scheduler
+func publishWebhookDelivery(ctx context.Context, q queue.Queue, funcName string, deliveryID int64) {
+	if q == nil {
+		return
+	}
+
+	payload, _ := helper.Serialize(entity.WebhookDeliveryMessage{DeliveryID: deliveryID})
+	if err := q.PublishWithContext(ctx, queue.ProcessWebhookDelivery, payload, 1); err != nil {
+		helper.LogErrorContext(ctx, "queue.Publish", funcName, err, generalEntity.CaptureFields{
+			"delivery_id": helper.ToString(deliveryID),
+		}, "")
+	}
+}
+
+// joinEventTypes stores unique event types sorted as comma separated value (searched with FIND_IN_SET)
+func joinEventTypes(eventTypes []string) string {
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !helper.InArray(eventType, unique) {
+			unique = append(unique, eventType)
+		}
+	}
+	sort.Strings(unique)
+
+	return strings.Join(unique, ",")
+}
+
+func newWebhookResponse(data *mentity.Webhook) *entity.WebhookResponse {
+	res := &entity.WebhookResponse{
+		ID:           data.ID,
+		URL:          data.URL,
+		EventTypes:   strings.Split(data.EventTypes, ","),
+		IsActive:     data.IsActive,
+		FailureCount: data.FailureCount,
+		CreatedAt:    helper.ConvertToJakartaTime(data.CreatedAt),
+	}
+	if data.DisabledAt != nil {
+		res.DisabledAt = helper.ConvertToJakartaTime(*data.DisabledAt)
+	}
+	if !data.UpdatedAt.IsZero() {
+		res.UpdatedAt = helper.ConvertToJakartaTime(data.UpdatedAt)
+	}
+
+	return res
+}
+
+func newWebhookDeliveryResponse(data *mentity.WebhookDelivery) *entity.WebhookDeliveryResponse {
+	res := &entity.WebhookDeliveryResponse{
+		ID:             data.ID,
+		WebhookID:      data.WebhookID,
+		EventID:        data.EventID,
+		EventType:      data.EventType,
+		Payload:        json.RawMessage(data.Payload),
+		Status:         string(data.Status),
+		Attempts:       data.Attempts,
+		ResponseStatus: data.ResponseStatus,
+		ResponseBody:   data.ResponseBody,
+		Error:          data.Error,
+		CreatedAt:      helper.ConvertToJakartaTime(data.CreatedAt),
+	}
+	if data.NextRetryAt != nil && data.Status == mentity.WebhookDeliveryRetrying {
+		res.NextRetryAt = helper.ConvertToJakartaTime(*data.NextRetryAt)
+	}
+	if data.DeliveredAt != nil {
+		res.DeliveredAt = helper.ConvertToJakartaTime(*data.DeliveredAt)
+	}
+
+	return res
+}
+
+// validateWebhookURL rejects URLs that are not http or https or whose host is not public (ex. localhost, private
+// networks or the cloud metadata address) so webhooks can not be used to reach internal services. Deliveries are
+// checked again when dialing since DNS may change
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err == nil && u.Scheme != "http" && u.Scheme != "https" {
+		err = fmt.Errorf("scheme %q is not allowed", u.Scheme)
+	}
+	if err == nil {
+		err = helper.CheckPublicHost(ctx, u.Hostname())
+	}
+	if err != nil {
+		return apperr.InvalidField("URL", "public_url", rawURL, i18n.Message(ctx, i18n.MsgWebhookURL)).Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package webhook_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperr "github.com/rahmatrdn/go-skeleton/error"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
+	"github.com/rahmatrdn/go-skeleton/internal/usecase/webhook/entity"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CrudWebhookUsecaseTestSuite struct {
+	suite.Suite
+	usecase      *webhook_usecase.CrudWebhookUsecase
+	repo         *mocks.IWebhookRepository
+	deliveryRepo *mocks.IWebhookDeliveryRepository
+	queue        *mocks.Queue
+}
+
+func (s *CrudWebhookUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.IWebhookRepository{}
+	s.deliveryRepo = &mocks.IWebhookDeliveryRepository{}
+	s.queue = &mocks.Queue{}
+	s.usecase = webhook_usecase.NewCrudWebhookUsecase(s.repo, s.deliveryRepo, s.queue)
+}
+
+func TestCrudWebhookUsecase(t *testing.T) {
+	suite.Run(t, new(CrudWebhookUsecaseTestSuite))
+}
+
+func (s *CrudWebhookUsecaseTestSuite) TestCreate() {
+	ctx := context.Background()
+
+	testcases := []struct {
+		name     string
+		req      entity.WebhookReq
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"todo_list.deleted", "todo_list.created", "todo_list.created"},
+			},
+			mockFunc: func() {
+				s.repo.On("Create", ctx, mock.MatchedBy(func(w *mentity.Webhook) bool {
+					return w.UserID == 1 && w.IsActive && w.EventTypes == "todo_list.created,todo_list.deleted"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Validation Error (unknown event type)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"user.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (invalid URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "not a url",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (loopback URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "http://127.0.0.1:8080/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (localhost URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "http://localhost/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (private URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "https://10.0.0.5/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (metadata URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "http://169.254.169.254/latest/meta-data",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (IPv6 loopback URL)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "http://[::1]/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Validation Error (scheme)",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "ftp://example.com/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {},
+			wantErr:  true,
+		},
+		{
+			name: "Error Repo",
+			req: entity.WebhookReq{
+				UserID:     1,
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"todo_list.created"},
+			},
+			mockFunc: func() {
+				s.repo.On("Create", ctx, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Create(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				return
+			}
+			s.NoError(err)
+			s.Regexp("^whsec_[0-9a-f]{48}$", res.Secret)
+			s.Equal([]string{"todo_list.created", "todo_list.deleted"}, res.EventTypes)
+			s.True(res.IsActive)
+		})
+	}
+}
+
+func (s *CrudWebhookUsecaseTestSuite) TestUpdateByID() {
+	ctx := context.Background()
+	active := true
+	inactive := false
+
+	testcases := []struct {
+		name        string
+		req         entity.WebhookReq
+		current     *mentity.Webhook
+		wantColumns []string
+		wantErr     bool
+		errIs       error
+	}{
+		{
+			name:        "Success",
+			req:         entity.WebhookReq{ID: 1, UserID: 1, URL: "https://example.com/new", EventTypes: []string{"todo_list.updated"}},
+			current:     &mentity.Webhook{ID: 1, UserID: 1, IsActive: true},
+			wantColumns: []string{"url", "event_types", "updated_at"},
+		},
+		{
+			name:        "Success (activate disabled webhook)",
+			req:         entity.WebhookReq{ID: 1, UserID: 1, URL: "https://example.com/new", EventTypes: []string{"todo_list.updated"}, IsActive: &active},
+			current:     &mentity.Webhook{ID: 1, UserID: 1, FailureCount: 20},
+			wantColumns: []string{"url", "event_types", "updated_at", "is_active", "failure_count", "disabled_at"},
+		},
+		{
+			name:        "Success (deactivate)",
+			req:         entity.WebhookReq{ID: 1, UserID: 1, URL: "https://example.com/new", EventTypes: []string{"todo_list.updated"}, IsActive: &inactive},
+			current:     &mentity.Webhook{ID: 1, UserID: 1, IsActive: true},
+			wantColumns: []string{"url", "event_types", "updated_at", "is_active"},
+		},
+		{
+			name:    "Not Found (other user webhook)",
+			req:     entity.WebhookReq{ID: 1, UserID: 1, URL: "https://example.com/new", EventTypes: []string{"todo_list.updated"}},
+			current: &mentity.Webhook{ID: 1, UserID: 99, IsActive: true},
+			wantErr: true,
+			errIs:   apperr.ErrRecordNotFound(),
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			s.repo.On("GetByID", ctx, tt.req.ID).Return(tt.current, nil).Once()
+			if tt.wantColumns != nil {
+				s.repo.On("UpdateColumns", ctx, tt.current, mock.Anything, tt.wantColumns).Return(nil).Once()
+			}
+
+			res, err := s.usecase.UpdateByID(ctx, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				s.Equal(tt.errIs, err)
+				return
+			}
+			s.NoError(err)
+			s.Equal("https://example.com/new", res.URL)
+			if tt.req.IsActive != nil {
+				s.Equal(*tt.req.IsActive, res.IsActive)
+			}
+			if tt.req.IsActive != nil && *tt.req.IsActive {
+				s.Zero(res.FailureCount)
+			}
+			s.repo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CrudWebhookUsecaseTestSuite) TestRedeliver() {
+	ctx := context.Background()
+	userID := int64(1)
+	webhook := &mentity.Webhook{ID: 1, UserID: userID, IsActive: true}
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+		errIs    error
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(1)).Return(webhook, nil).Once()
+				s.deliveryRepo.On("GetByID", ctx, int64(5)).Return(&mentity.WebhookDelivery{
+					ID: 5, WebhookID: 1, EventID: "evt-1", EventType: "todo_list.created", Payload: `{"id":"evt-1"}`,
+					Status: mentity.WebhookDeliveryFailed, Attempts: 8,
+				}, nil).Once()
+				s.deliveryRepo.On("Create", ctx, mock.MatchedBy(func(d *mentity.WebhookDelivery) bool {
+					return d.WebhookID == 1 && d.EventID == "evt-1" && d.Status == mentity.WebhookDeliveryPending && d.Attempts == 0
+				})).Run(func(args mock.Arguments) {
+					args.Get(1).(*mentity.WebhookDelivery).ID = 6
+				}).Return(nil).Once()
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessWebhookDelivery, []byte("{\"delivery_id\":6}\n"), int32(1)).Return(nil).Once()
+			},
+		},
+		{
+			name: "Not Found (delivery of other webhook)",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(1)).Return(webhook, nil).Once()
+				s.deliveryRepo.On("GetByID", ctx, int64(5)).Return(&mentity.WebhookDelivery{ID: 5, WebhookID: 2}, nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRecordNotFound(),
+		},
+		{
+			name: "Not Found (other user webhook)",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(1)).Return(&mentity.Webhook{ID: 1, UserID: 99}, nil).Once()
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRecordNotFound(),
+		},
+		{
+			name: "Error Repo",
+			mockFunc: func() {
+				s.repo.On("GetByID", ctx, int64(1)).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := s.usecase.Redeliver(ctx, userID, 1, 5)
+			if tt.wantErr {
+				s.Error(err)
+				s.Nil(res)
+				if tt.errIs != nil {
+					s.Equal(tt.errIs, err)
+				}
+				return
+			}
+			s.NoError(err)
+			s.Equal(int64(6), res.ID)
+			s.Equal("pending", res.Status)
+			s.queue.AssertExpectations(s.T())
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package webhook_usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/helper"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+)
+
+const (
+	HeaderWebhookID        = "X-Webhook-Id"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookDelivery  = "X-Webhook-Delivery"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+
+	// webhookMaxAttempts is the number of attempts before a delivery is marked as failed,
+	// attempts are retried after webhookRetryBaseDelay doubled on every attempt (30s, 1m, 2m, ... up to webhookRetryMaxDelay)
+	webhookMaxAttempts    = 8
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+	// webhookMaxFailures is the number of consecutive failed attempts before a webhook is disabled
+	webhookMaxFailures = 20
+
+	webhookTimeout         = 10 * time.Second
+	webhookResponseLimit   = 1024
+	webhookErrorLimit      = 500
+	webhookRetryBatchLimit = 100
+)
+
+type DeliveryWebhookUsecase struct {
+	webhookRepo         mysql.IWebhookRepository
+	webhookDeliveryRepo mysql.IWebhookDeliveryRepository
+	queue               queue.Queue
+	httpClient          *http.Client
+}
+
+// NewDeliveryWebhookUsecase creates webhook delivery usecase used by the worker and retry scheduler,
+// client of newWebhookHTTPClient is used when httpClient is nil
+func NewDeliveryWebhookUsecase(
+	webhookRepo mysql.IWebhookRepository,
+	webhookDeliveryRepo mysql.IWebhookDeliveryRepository,
+	queue queue.Queue,
+	httpClient *http.Client,
+) *DeliveryWebhookUsecase {
+	if httpClient == nil {
+		httpClient = newWebhookHTTPClient()
+	}
+
+	return &DeliveryWebhookUsecase{webhookRepo, webhookDeliveryRepo, queue, httpClient}
+}
+
+type IDeliveryWebhookUsecase interface {
+	Dispatch(ctx context.Context, event entity.Event) error
+	Deliver(ctx context.Context, deliveryID int64) error
+	RetryDue(ctx context.Context) error
+}
+
+// Dispatch creates a delivery for every active user webhook subscribed to the event type
+// and publishes them to the worker (topic webhook.delivery), an event is dispatched once per webhook
+func (t *DeliveryWebhookUsecase) Dispatch(ctx context.Context, event entity.Event) error {
+	funcName := "DeliveryWebhookUsecase.Dispatch"
+	captureFieldError := entity.CaptureFields{
+		"user_id":    helper.ToString(event.UserID),
+		"event_id":   event.ID,
+		"event_type": event.Type,
+	}
+
+	webhooks, err := t.webhookRepo.GetActiveByEventType(ctx, event.UserID, event.Type)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.GetActiveByEventType", funcName, err, captureFieldError, "")
+
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := helper.Serialize(event)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		delivery := newWebhookDelivery(webhook.ID, event.ID, event.Type, string(bytes.TrimSpace(payload)))
+		delivery.DispatchKey = &event.ID
+
+		// The event is consumed again when a previous attempt failed, deliveries it created are skipped
+		// (they are re-published by the retry scheduler while pending)
+		created, err := t.webhookDeliveryRepo.CreateOnce(ctx, delivery)
+		if err != nil {
+			helper.LogErrorContext(ctx, "webhookDeliveryRepo.CreateOnce", funcName, err, captureFieldError, "")
+
+			return err
+		}
+		if created {
+			publishWebhookDelivery(ctx, t.queue, funcName, delivery.ID)
+		}
+	}
+
+	return nil
+}
+
+// Deliver sends a delivery to the webhook URL signed with HMAC-SHA256 of "timestamp.body".
+// Failed attempts are scheduled for retry with exponential backoff, the delivery is marked as failed
+// after webhookMaxAttempts and the webhook is disabled after webhookMaxFailures consecutive failures.
+// Error is only returned when the delivery state can not be read or written so the queue retries it
+func (t *DeliveryWebhookUsecase) Deliver(ctx context.Context, deliveryID int64) error {
+	funcName := "DeliveryWebhookUsecase.Deliver"
+	captureFieldError := entity.CaptureFields{
+		"delivery_id": helper.ToString(deliveryID),
+	}
+
+	delivery, err := t.webhookDeliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.GetByID", funcName, err, captureFieldError, "")
+
+		return err
+	}
+	// Already delivered or given up (ex. message published twice)
+	if delivery == nil || delivery.Status == mentity.WebhookDeliverySuccess || delivery.Status == mentity.WebhookDeliveryFailed {
+		return nil
+	}
+
+	webhook, err := t.webhookRepo.GetByID(ctx, delivery.WebhookID)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.GetByID", funcName, err, captureFieldError, "")
+
+		return err
+	}
+
+	now := time.Now()
+	changes := &mentity.WebhookDelivery{Attempts: delivery.Attempts + 1, UpdatedAt: now}
+	columns := []string{"status", "attempts", "response_status", "response_body", "error", "next_retry_at", "delivered_at", "updated_at"}
+
+	switch {
+	case webhook == nil || !webhook.IsActive:
+		changes.Attempts = delivery.Attempts
+		changes.Status = mentity.WebhookDeliveryFailed
+		changes.Error = "webhook is disabled or deleted"
+	default:
+		changes.ResponseStatus, changes.ResponseBody, err = t.send(ctx, webhook, delivery, now)
+		if err == nil {
+			changes.Status = mentity.WebhookDeliverySuccess
+			changes.DeliveredAt = &now
+			break
+		}
+
+		changes.Error = truncate(err.Error(), webhookErrorLimit)
+		changes.Status = mentity.WebhookDeliveryFailed
+		if changes.Attempts < webhookMaxAttempts {
+			nextRetryAt := now.Add(webhookRetryDelay(changes.Attempts))
+			changes.Status = mentity.WebhookDeliveryRetrying
+			changes.NextRetryAt = &nextRetryAt
+		}
+	}
+
+	if err := t.webhookDeliveryRepo.UpdateColumns(ctx, delivery, changes, columns...); err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.UpdateColumns", funcName, err, captureFieldError, "")
+
+		return err
+	}
+
+	if webhook == nil || !webhook.IsActive {
+		return nil
+	}
+
+	if changes.Status == mentity.WebhookDeliverySuccess {
+		err = t.webhookRepo.ResetFailure(ctx, webhook.ID)
+	} else {
+		err = t.webhookRepo.RecordFailure(ctx, webhook.ID, webhookMaxFailures)
+	}
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookRepo.RecordFailure", funcName, err, captureFieldError, "")
+	}
+
+	return nil
+}
+
+// RetryDue re-publishes deliveries that are due for retry or still pending after webhookDeliveryWindow,
+// called periodically by the scheduler
+func (t *DeliveryWebhookUsecase) RetryDue(ctx context.Context) error {
+	funcName := "DeliveryWebhookUsecase.RetryDue"
+
+	now := time.Now()
+	deliveries, err := t.webhookDeliveryRepo.GetDueRetries(ctx, now, webhookRetryBatchLimit)
+	if err != nil {
+		helper.LogErrorContext(ctx, "webhookDeliveryRepo.GetDueRetries", funcName, err, entity.CaptureFields{}, "")
+
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		// Push next_retry_at forward so the delivery is not published again while the worker processes it
+		nextRetryAt := now.Add(webhookDeliveryWindow)
+		changes := &mentity.WebhookDelivery{NextRetryAt: &nextRetryAt}
+		if err := t.webhookDeliveryRepo.UpdateColumns(ctx, delivery, changes, "next_retry_at"); err != nil {
+			helper.LogErrorContext(ctx, "webhookDeliveryRepo.UpdateColumns", funcName, err, entity.CaptureFields{
+				"delivery_id": helper.ToString(delivery.ID),
+			}, "")
+
+			return err
+		}
+
+		publishWebhookDelivery(ctx, t.queue, funcName, delivery.ID)
+	}
+
+	return nil
+}
+
+// send posts the payload and returns response status and (truncated) body, non 2xx response is an error
+func (t *DeliveryWebhookUsecase) send(ctx context.Context, webhook *mentity.Webhook, delivery *mentity.WebhookDelivery, now time.Time) (int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-skeleton-webhook/1.0")
+	req.Header.Set(HeaderWebhookID, delivery.EventID)
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, "sha256="+SignPayload(webhook.Secret, timestamp, body))
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseLimit))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, string(respBody), fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, string(respBody), nil
+}
+
+// newWebhookHTTPClient returns client with webhookTimeout that only connects to public addresses (see
+// helper.PublicDialControl) so webhooks can not reach internal services, also after redirects or DNS changes.
+// Proxies are not used since they would connect on behalf of the client
+func newWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: helper.PublicDialControl}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: webhookTimeout, Transport: transport}
+}
+
+// SignPayload returns X-Webhook-Signature value (without "sha256=" prefix), receivers verify it by computing
+// HMAC-SHA256 of X-Webhook-Timestamp, "." and the raw request body with the webhook secret
+func SignPayload(secret string, timestamp string, body []byte) string {
+	message := make([]byte, 0, len(timestamp)+1+len(body))
+	message = append(message, timestamp...)
+	message = append(message, '.')
+	message = append(message, body...)
+
+	return helper.SignHMACSHA256(secret, message)
+}
+
+// webhookRetryDelay returns delay before the next attempt, doubled on every attempt
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts && delay < webhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, webhookRetryMaxDelay)
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+
+	return s[:limit]
+}
@@ -0,0 +1,249 @@
+package webhook_usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	generalEntity "github.com/rahmatrdn/go-skeleton/entity"
+	"github.com/rahmatrdn/go-skeleton/internal/queue"
+	mentity "github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
+	webhook_usecase "github.com/rahmatrdn/go-skeleton/internal/usecase/webhook"
+	"github.com/rahmatrdn/go-skeleton/tests/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const testWebhookSecret = "whsec_test"
+
+type DeliveryWebhookUsecaseTestSuite struct {
+	suite.Suite
+	usecase      *webhook_usecase.DeliveryWebhookUsecase
+	repo         *mocks.IWebhookRepository
+	deliveryRepo *mocks.IWebhookDeliveryRepository
+	queue        *mocks.Queue
+	server       *httptest.Server
+	// status is returned by the receiver, signatureValid is set by the last received request
+	status         int
+	signatureValid bool
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) SetupTest() {
+	s.repo = &mocks.IWebhookRepository{}
+	s.deliveryRepo = &mocks.IWebhookDeliveryRepository{}
+	s.queue = &mocks.Queue{}
+	s.status = http.StatusOK
+	s.signatureValid = false
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get(webhook_usecase.HeaderWebhookTimestamp)
+		s.signatureValid = r.Header.Get(webhook_usecase.HeaderWebhookSignature) == "sha256="+webhook_usecase.SignPayload(testWebhookSecret, timestamp, body) &&
+			r.Header.Get(webhook_usecase.HeaderWebhookEvent) == "todo_list.created"
+
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte("received"))
+	}))
+
+	s.usecase = webhook_usecase.NewDeliveryWebhookUsecase(s.repo, s.deliveryRepo, s.queue, s.server.Client())
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestDeliveryWebhookUsecase(t *testing.T) {
+	suite.Run(t, new(DeliveryWebhookUsecaseTestSuite))
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TestDispatch() {
+	ctx := context.Background()
+	event := generalEntity.Event{ID: "evt-1", Type: generalEntity.EventTodoListCreated, UserID: 1, OccurredAt: time.Now()}
+
+	s.repo.On("GetActiveByEventType", ctx, int64(1), "todo_list.created").Return([]*mentity.Webhook{
+		{ID: 1, UserID: 1, IsActive: true},
+		{ID: 2, UserID: 1, IsActive: true},
+	}, nil).Once()
+	matchDelivery := func(webhookID int64) interface{} {
+		return mock.MatchedBy(func(d *mentity.WebhookDelivery) bool {
+			var payload generalEntity.Event
+			return json.Unmarshal([]byte(d.Payload), &payload) == nil && payload.ID == "evt-1" && d.WebhookID == webhookID &&
+				d.EventID == "evt-1" && d.DispatchKey != nil && *d.DispatchKey == "evt-1" && d.Status == mentity.WebhookDeliveryPending
+		})
+	}
+	s.deliveryRepo.On("CreateOnce", ctx, matchDelivery(1)).Return(true, nil).Once()
+	// Created by a previous attempt of the event
+	s.deliveryRepo.On("CreateOnce", ctx, matchDelivery(2)).Return(false, nil).Once()
+	s.queue.On("PublishWithContext", mock.Anything, queue.ProcessWebhookDelivery, mock.Anything, int32(1)).Return(nil).Once()
+
+	s.NoError(s.usecase.Dispatch(ctx, event))
+	s.deliveryRepo.AssertExpectations(s.T())
+	s.queue.AssertExpectations(s.T())
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TestDeliver() {
+	ctx := context.Background()
+
+	testcases := []struct {
+		name          string
+		status        int
+		delivery      *mentity.WebhookDelivery
+		webhook       *mentity.Webhook
+		mockFunc      func()
+		wantStatus    mentity.WebhookDeliveryStatus
+		wantRetry     bool
+		wantSignature bool
+		wantErr       bool
+	}{
+		{
+			name:     "Success",
+			status:   http.StatusOK,
+			delivery: &mentity.WebhookDelivery{ID: 10, WebhookID: 1, EventID: "evt-1", EventType: "todo_list.created", Payload: `{"id":"evt-1"}`, Status: mentity.WebhookDeliveryPending},
+			webhook:  &mentity.Webhook{ID: 1, URL: s.server.URL, Secret: testWebhookSecret, IsActive: true},
+			mockFunc: func() {
+				s.repo.On("ResetFailure", ctx, int64(1)).Return(nil).Once()
+			},
+			wantStatus:    mentity.WebhookDeliverySuccess,
+			wantSignature: true,
+		},
+		{
+			name:     "Receiver Error (retrying)",
+			status:   http.StatusInternalServerError,
+			delivery: &mentity.WebhookDelivery{ID: 10, WebhookID: 1, EventID: "evt-1", EventType: "todo_list.created", Payload: `{"id":"evt-1"}`, Status: mentity.WebhookDeliveryPending},
+			webhook:  &mentity.Webhook{ID: 1, URL: s.server.URL, Secret: testWebhookSecret, IsActive: true},
+			mockFunc: func() {
+				s.repo.On("RecordFailure", ctx, int64(1), 20).Return(nil).Once()
+			},
+			wantStatus:    mentity.WebhookDeliveryRetrying,
+			wantRetry:     true,
+			wantSignature: true,
+		},
+		{
+			name:     "Receiver Error (max attempts)",
+			status:   http.StatusBadGateway,
+			delivery: &mentity.WebhookDelivery{ID: 10, WebhookID: 1, EventID: "evt-1", EventType: "todo_list.created", Payload: `{"id":"evt-1"}`, Status: mentity.WebhookDeliveryRetrying, Attempts: 7},
+			webhook:  &mentity.Webhook{ID: 1, URL: s.server.URL, Secret: testWebhookSecret, IsActive: true},
+			mockFunc: func() {
+				s.repo.On("RecordFailure", ctx, int64(1), 20).Return(nil).Once()
+			},
+			wantStatus:    mentity.WebhookDeliveryFailed,
+			wantSignature: true,
+		},
+		{
+			name:       "Disabled Webhook",
+			delivery:   &mentity.WebhookDelivery{ID: 10, WebhookID: 1, Status: mentity.WebhookDeliveryRetrying, Attempts: 3},
+			webhook:    &mentity.Webhook{ID: 1, Secret: testWebhookSecret, IsActive: false},
+			mockFunc:   func() {},
+			wantStatus: mentity.WebhookDeliveryFailed,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			s.status = tt.status
+			s.signatureValid = false
+			tt.mockFunc()
+
+			var changes *mentity.WebhookDelivery
+			s.deliveryRepo.On("GetByID", ctx, tt.delivery.ID).Return(tt.delivery, nil).Once()
+			s.repo.On("GetByID", ctx, tt.delivery.WebhookID).Return(tt.webhook, nil).Once()
+			s.deliveryRepo.On("UpdateColumns", ctx, tt.delivery, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				changes = args.Get(2).(*mentity.WebhookDelivery)
+			}).Return(nil).Once()
+
+			err := s.usecase.Deliver(ctx, tt.delivery.ID)
+			if tt.wantErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+			s.Require().NotNil(changes)
+			s.Equal(tt.wantStatus, changes.Status)
+			s.Equal(tt.wantRetry, changes.NextRetryAt != nil)
+			s.Equal(tt.wantSignature, s.signatureValid)
+			if tt.wantStatus == mentity.WebhookDeliverySuccess {
+				s.Equal(http.StatusOK, changes.ResponseStatus)
+				s.Equal("received", changes.ResponseBody)
+				s.NotNil(changes.DeliveredAt)
+			}
+			s.repo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TestDeliverNonPublicAddress() {
+	ctx := context.Background()
+	// Default client refuses the test receiver which listens on loopback
+	usecase := webhook_usecase.NewDeliveryWebhookUsecase(s.repo, s.deliveryRepo, s.queue, nil)
+
+	delivery := &mentity.WebhookDelivery{ID: 10, WebhookID: 1, EventID: "evt-1", EventType: "todo_list.created", Payload: `{"id":"evt-1"}`, Status: mentity.WebhookDeliveryPending}
+	var changes *mentity.WebhookDelivery
+	s.deliveryRepo.On("GetByID", ctx, int64(10)).Return(delivery, nil).Once()
+	s.repo.On("GetByID", ctx, int64(1)).Return(&mentity.Webhook{ID: 1, URL: s.server.URL, Secret: testWebhookSecret, IsActive: true}, nil).Once()
+	s.repo.On("RecordFailure", ctx, int64(1), 20).Return(nil).Once()
+	s.deliveryRepo.On("UpdateColumns", ctx, delivery, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		changes = args.Get(2).(*mentity.WebhookDelivery)
+	}).Return(nil).Once()
+
+	s.NoError(usecase.Deliver(ctx, 10))
+	s.Require().NotNil(changes)
+	s.Equal(mentity.WebhookDeliveryRetrying, changes.Status)
+	s.Contains(changes.Error, "address is not public")
+	s.False(s.signatureValid)
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TestDeliverSkipDelivered() {
+	ctx := context.Background()
+
+	s.deliveryRepo.On("GetByID", ctx, int64(10)).Return(&mentity.WebhookDelivery{ID: 10, Status: mentity.WebhookDeliverySuccess}, nil).Once()
+
+	s.NoError(s.usecase.Deliver(ctx, 10))
+	s.repo.AssertNotCalled(s.T(), "GetByID", mock.Anything, mock.Anything)
+}
+
+func (s *DeliveryWebhookUsecaseTestSuite) TestRetryDue() {
+	ctx := context.Background()
+
+	testcases := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				s.deliveryRepo.On("GetDueRetries", ctx, mock.Anything, 100).Return([]*mentity.WebhookDelivery{
+					{ID: 10, Status: mentity.WebhookDeliveryRetrying},
+					{ID: 11, Status: mentity.WebhookDeliveryPending},
+				}, nil).Once()
+				s.deliveryRepo.On("UpdateColumns", ctx, mock.Anything, mock.Anything, []string{"next_retry_at"}).Return(nil).Twice()
+				s.queue.On("PublishWithContext", mock.Anything, queue.ProcessWebhookDelivery, mock.Anything, int32(1)).Return(nil).Twice()
+			},
+		},
+		{
+			name: "Error Repo",
+			mockFunc: func() {
+				s.deliveryRepo.On("GetDueRetries", ctx, mock.Anything, 100).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		s.Run(tt.name, func() {
+			tt.mockFunc()
+			err := s.usecase.RetryDue(ctx)
+			if tt.wantErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+			s.queue.AssertExpectations(s.T())
+		})
+	}
+}
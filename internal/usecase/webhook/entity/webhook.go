@@ -0,0 +1,62 @@
+package entity
+
+import "encoding/json"
+
+type WebhookReq struct {
+	ID         int64    `json:"id,omitempty" swaggerignore:"true"`
+	UserID     int64    `json:"user_id,omitempty" swaggerignore:"true"`
+	URL        string   `json:"url" validate:"required,url,max=500" name:"URL" name_en:"URL"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,dive,oneof=todo_list.created todo_list.updated todo_list.completed todo_list.deleted" name:"Tipe Event" name_en:"Event Types"`
+	// IsActive is used on update, activating a disabled webhook resets its failure count
+	IsActive *bool `json:"is_active,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID         int64    `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// Secret is only returned when the webhook is created
+	Secret       string `json:"secret,omitempty"`
+	IsActive     bool   `json:"is_active"`
+	FailureCount int    `json:"failure_count"`
+	DisabledAt   string `json:"disabled_at,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	NextRetryAt    string          `json:"next_retry_at,omitempty"`
+	DeliveredAt    string          `json:"delivered_at,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+}
+
+// WebhookDeliveryMessage is the queue payload of a delivery sent by the worker
+type WebhookDeliveryMessage struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+func (m *WebhookDeliveryMessage) LoadFromMap(payload map[string]interface{}) error {
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, m)
+	}
+	return err
+}
+
+func (r *WebhookReq) SetID(ID int64) {
+	r.ID = ID
+}
+
+func (r *WebhookReq) SetUserID(UserID int64) {
+	r.UserID = UserID
+}
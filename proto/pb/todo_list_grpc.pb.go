@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: todo_list.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TodoListService_ListTodoLists_FullMethodName  = "/goskeleton.v1.TodoListService/ListTodoLists"
+	TodoListService_GetTodoList_FullMethodName    = "/goskeleton.v1.TodoListService/GetTodoList"
+	TodoListService_CreateTodoList_FullMethodName = "/goskeleton.v1.TodoListService/CreateTodoList"
+	TodoListService_UpdateTodoList_FullMethodName = "/goskeleton.v1.TodoListService/UpdateTodoList"
+	TodoListService_DeleteTodoList_FullMethodName = "/goskeleton.v1.TodoListService/DeleteTodoList"
+)
+
+// TodoListServiceClient is the client API for TodoListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TodoListService manages the todo lists of the authenticated user
+type TodoListServiceClient interface {
+	ListTodoLists(ctx context.Context, in *ListTodoListsRequest, opts ...grpc.CallOption) (*ListTodoListsResponse, error)
+	GetTodoList(ctx context.Context, in *GetTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	CreateTodoList(ctx context.Context, in *CreateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	UpdateTodoList(ctx context.Context, in *UpdateTodoListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteTodoList(ctx context.Context, in *DeleteTodoListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type todoListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTodoListServiceClient(cc grpc.ClientConnInterface) TodoListServiceClient {
+	return &todoListServiceClient{cc}
+}
+
+func (c *todoListServiceClient) ListTodoLists(ctx context.Context, in *ListTodoListsRequest, opts ...grpc.CallOption) (*ListTodoListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTodoListsResponse)
+	err := c.cc.Invoke(ctx, TodoListService_ListTodoLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoListServiceClient) GetTodoList(ctx context.Context, in *GetTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_GetTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoListServiceClient) CreateTodoList(ctx context.Context, in *CreateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_CreateTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoListServiceClient) UpdateTodoList(ctx context.Context, in *UpdateTodoListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TodoListService_UpdateTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoListServiceClient) DeleteTodoList(ctx context.Context, in *DeleteTodoListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TodoListService_DeleteTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoListServiceServer is the server API for TodoListService service.
+// All implementations must embed UnimplementedTodoListServiceServer
+// for forward compatibility.
+//
+// TodoListService manages the todo lists of the authenticated user
+type TodoListServiceServer interface {
+	ListTodoLists(context.Context, *ListTodoListsRequest) (*ListTodoListsResponse, error)
+	GetTodoList(context.Context, *GetTodoListRequest) (*TodoList, error)
+	CreateTodoList(context.Context, *CreateTodoListRequest) (*TodoList, error)
+	UpdateTodoList(context.Context, *UpdateTodoListRequest) (*emptypb.Empty, error)
+	DeleteTodoList(context.Context, *DeleteTodoListRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTodoListServiceServer()
+}
+
+// UnimplementedTodoListServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTodoListServiceServer struct{}
+
+func (UnimplementedTodoListServiceServer) ListTodoLists(context.Context, *ListTodoListsRequest) (*ListTodoListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTodoLists not implemented")
+}
+func (UnimplementedTodoListServiceServer) GetTodoList(context.Context, *GetTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) CreateTodoList(context.Context, *CreateTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) UpdateTodoList(context.Context, *UpdateTodoListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) DeleteTodoList(context.Context, *DeleteTodoListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) mustEmbedUnimplementedTodoListServiceServer() {}
+func (UnimplementedTodoListServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTodoListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoListServiceServer will
+// result in compilation errors.
+type UnsafeTodoListServiceServer interface {
+	mustEmbedUnimplementedTodoListServiceServer()
+}
+
+func RegisterTodoListServiceServer(s grpc.ServiceRegistrar, srv TodoListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTodoListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TodoListService_ServiceDesc, srv)
+}
+
+func _TodoListService_ListTodoLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).ListTodoLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_ListTodoLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).ListTodoLists(ctx, req.(*ListTodoListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoListService_GetTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).GetTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_GetTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).GetTodoList(ctx, req.(*GetTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoListService_CreateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).CreateTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_CreateTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).CreateTodoList(ctx, req.(*CreateTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoListService_UpdateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).UpdateTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_UpdateTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).UpdateTodoList(ctx, req.(*UpdateTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoListService_DeleteTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).DeleteTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_DeleteTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).DeleteTodoList(ctx, req.(*DeleteTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TodoListService_ServiceDesc is the grpc.ServiceDesc for TodoListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TodoListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goskeleton.v1.TodoListService",
+	HandlerType: (*TodoListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTodoLists",
+			Handler:    _TodoListService_ListTodoLists_Handler,
+		},
+		{
+			MethodName: "GetTodoList",
+			Handler:    _TodoListService_GetTodoList_Handler,
+		},
+		{
+			MethodName: "CreateTodoList",
+			Handler:    _TodoListService_CreateTodoList_Handler,
+		},
+		{
+			MethodName: "UpdateTodoList",
+			Handler:    _TodoListService_UpdateTodoList_Handler,
+		},
+		{
+			MethodName: "DeleteTodoList",
+			Handler:    _TodoListService_DeleteTodoList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "todo_list.proto",
+}
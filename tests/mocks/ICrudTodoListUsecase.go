@@ -38,6 +38,74 @@ func (_m *ICrudTodoListUsecase) EXPECT() *ICrudTodoListUsecase_Expecter {
 	return &ICrudTodoListUsecase_Expecter{mock: &_m.Mock}
 }
 
+// Bulk provides a mock function for the type ICrudTodoListUsecase
+func (_mock *ICrudTodoListUsecase) Bulk(ctx context.Context, bulkReq entity.BulkTodoListReq) (*entity.BulkTodoListResponse, error) {
+	ret := _mock.Called(ctx, bulkReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bulk")
+	}
+
+	var r0 *entity.BulkTodoListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.BulkTodoListReq) (*entity.BulkTodoListResponse, error)); ok {
+		return returnFunc(ctx, bulkReq)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.BulkTodoListReq) *entity.BulkTodoListResponse); ok {
+		r0 = returnFunc(ctx, bulkReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BulkTodoListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.BulkTodoListReq) error); ok {
+		r1 = returnFunc(ctx, bulkReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ICrudTodoListUsecase_Bulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bulk'
+type ICrudTodoListUsecase_Bulk_Call struct {
+	*mock.Call
+}
+
+// Bulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bulkReq entity.BulkTodoListReq
+func (_e *ICrudTodoListUsecase_Expecter) Bulk(ctx interface{}, bulkReq interface{}) *ICrudTodoListUsecase_Bulk_Call {
+	return &ICrudTodoListUsecase_Bulk_Call{Call: _e.mock.On("Bulk", ctx, bulkReq)}
+}
+
+func (_c *ICrudTodoListUsecase_Bulk_Call) Run(run func(ctx context.Context, bulkReq entity.BulkTodoListReq)) *ICrudTodoListUsecase_Bulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.BulkTodoListReq
+		if args[1] != nil {
+			arg1 = args[1].(entity.BulkTodoListReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_Bulk_Call) Return(bulkTodoListResponse *entity.BulkTodoListResponse, err error) *ICrudTodoListUsecase_Bulk_Call {
+	_c.Call.Return(bulkTodoListResponse, err)
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_Bulk_Call) RunAndReturn(run func(ctx context.Context, bulkReq entity.BulkTodoListReq) (*entity.BulkTodoListResponse, error)) *ICrudTodoListUsecase_Bulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type ICrudTodoListUsecase
 func (_mock *ICrudTodoListUsecase) Create(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error) {
 	ret := _mock.Called(ctx, todoListReq)
@@ -107,16 +175,16 @@ func (_c *ICrudTodoListUsecase_Create_Call) RunAndReturn(run func(ctx context.Co
 }
 
 // DeleteByID provides a mock function for the type ICrudTodoListUsecase
-func (_mock *ICrudTodoListUsecase) DeleteByID(ctx context.Context, todoListID int64) error {
-	ret := _mock.Called(ctx, todoListID)
+func (_mock *ICrudTodoListUsecase) DeleteByID(ctx context.Context, userID int64, todoListID int64) error {
+	ret := _mock.Called(ctx, userID, todoListID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteByID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, todoListID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userID, todoListID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -130,12 +198,13 @@ type ICrudTodoListUsecase_DeleteByID_Call struct {
 
 // DeleteByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID int64
 //   - todoListID int64
-func (_e *ICrudTodoListUsecase_Expecter) DeleteByID(ctx interface{}, todoListID interface{}) *ICrudTodoListUsecase_DeleteByID_Call {
-	return &ICrudTodoListUsecase_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, todoListID)}
+func (_e *ICrudTodoListUsecase_Expecter) DeleteByID(ctx interface{}, userID interface{}, todoListID interface{}) *ICrudTodoListUsecase_DeleteByID_Call {
+	return &ICrudTodoListUsecase_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, userID, todoListID)}
 }
 
-func (_c *ICrudTodoListUsecase_DeleteByID_Call) Run(run func(ctx context.Context, todoListID int64)) *ICrudTodoListUsecase_DeleteByID_Call {
+func (_c *ICrudTodoListUsecase_DeleteByID_Call) Run(run func(ctx context.Context, userID int64, todoListID int64)) *ICrudTodoListUsecase_DeleteByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -145,9 +214,14 @@ func (_c *ICrudTodoListUsecase_DeleteByID_Call) Run(run func(ctx context.Context
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -158,14 +232,14 @@ func (_c *ICrudTodoListUsecase_DeleteByID_Call) Return(err error) *ICrudTodoList
 	return _c
 }
 
-func (_c *ICrudTodoListUsecase_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, todoListID int64) error) *ICrudTodoListUsecase_DeleteByID_Call {
+func (_c *ICrudTodoListUsecase_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, userID int64, todoListID int64) error) *ICrudTodoListUsecase_DeleteByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByID provides a mock function for the type ICrudTodoListUsecase
-func (_mock *ICrudTodoListUsecase) GetByID(ctx context.Context, todoListID int64) (*entity.TodoListResponse, error) {
-	ret := _mock.Called(ctx, todoListID)
+func (_mock *ICrudTodoListUsecase) GetByID(ctx context.Context, userID int64, todoListID int64) (*entity.TodoListResponse, error) {
+	ret := _mock.Called(ctx, userID, todoListID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -173,18 +247,18 @@ func (_mock *ICrudTodoListUsecase) GetByID(ctx context.Context, todoListID int64
 
 	var r0 *entity.TodoListResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*entity.TodoListResponse, error)); ok {
-		return returnFunc(ctx, todoListID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*entity.TodoListResponse, error)); ok {
+		return returnFunc(ctx, userID, todoListID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *entity.TodoListResponse); ok {
-		r0 = returnFunc(ctx, todoListID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *entity.TodoListResponse); ok {
+		r0 = returnFunc(ctx, userID, todoListID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.TodoListResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, todoListID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userID, todoListID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -198,12 +272,13 @@ type ICrudTodoListUsecase_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID int64
 //   - todoListID int64
-func (_e *ICrudTodoListUsecase_Expecter) GetByID(ctx interface{}, todoListID interface{}) *ICrudTodoListUsecase_GetByID_Call {
-	return &ICrudTodoListUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, todoListID)}
+func (_e *ICrudTodoListUsecase_Expecter) GetByID(ctx interface{}, userID interface{}, todoListID interface{}) *ICrudTodoListUsecase_GetByID_Call {
+	return &ICrudTodoListUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userID, todoListID)}
 }
 
-func (_c *ICrudTodoListUsecase_GetByID_Call) Run(run func(ctx context.Context, todoListID int64)) *ICrudTodoListUsecase_GetByID_Call {
+func (_c *ICrudTodoListUsecase_GetByID_Call) Run(run func(ctx context.Context, userID int64, todoListID int64)) *ICrudTodoListUsecase_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -213,9 +288,14 @@ func (_c *ICrudTodoListUsecase_GetByID_Call) Run(run func(ctx context.Context, t
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -226,7 +306,7 @@ func (_c *ICrudTodoListUsecase_GetByID_Call) Return(todoListResponse *entity.Tod
 	return _c
 }
 
-func (_c *ICrudTodoListUsecase_GetByID_Call) RunAndReturn(run func(ctx context.Context, todoListID int64) (*entity.TodoListResponse, error)) *ICrudTodoListUsecase_GetByID_Call {
+func (_c *ICrudTodoListUsecase_GetByID_Call) RunAndReturn(run func(ctx context.Context, userID int64, todoListID int64) (*entity.TodoListResponse, error)) *ICrudTodoListUsecase_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -299,21 +379,242 @@ func (_c *ICrudTodoListUsecase_GetByUserID_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetHistory provides a mock function for the type ICrudTodoListUsecase
+func (_mock *ICrudTodoListUsecase) GetHistory(ctx context.Context, userID int64, todoListID int64) ([]*entity.TodoListHistoryResponse, error) {
+	ret := _mock.Called(ctx, userID, todoListID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []*entity.TodoListHistoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*entity.TodoListHistoryResponse, error)); ok {
+		return returnFunc(ctx, userID, todoListID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) []*entity.TodoListHistoryResponse); ok {
+		r0 = returnFunc(ctx, userID, todoListID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TodoListHistoryResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userID, todoListID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ICrudTodoListUsecase_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type ICrudTodoListUsecase_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - todoListID int64
+func (_e *ICrudTodoListUsecase_Expecter) GetHistory(ctx interface{}, userID interface{}, todoListID interface{}) *ICrudTodoListUsecase_GetHistory_Call {
+	return &ICrudTodoListUsecase_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, userID, todoListID)}
+}
+
+func (_c *ICrudTodoListUsecase_GetHistory_Call) Run(run func(ctx context.Context, userID int64, todoListID int64)) *ICrudTodoListUsecase_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_GetHistory_Call) Return(res []*entity.TodoListHistoryResponse, err error) *ICrudTodoListUsecase_GetHistory_Call {
+	_c.Call.Return(res, err)
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_GetHistory_Call) RunAndReturn(run func(ctx context.Context, userID int64, todoListID int64) ([]*entity.TodoListHistoryResponse, error)) *ICrudTodoListUsecase_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Move provides a mock function for the type ICrudTodoListUsecase
+func (_mock *ICrudTodoListUsecase) Move(ctx context.Context, moveReq entity.MoveTodoListReq) (*entity.TodoListResponse, error) {
+	ret := _mock.Called(ctx, moveReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 *entity.TodoListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.MoveTodoListReq) (*entity.TodoListResponse, error)); ok {
+		return returnFunc(ctx, moveReq)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.MoveTodoListReq) *entity.TodoListResponse); ok {
+		r0 = returnFunc(ctx, moveReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TodoListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.MoveTodoListReq) error); ok {
+		r1 = returnFunc(ctx, moveReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ICrudTodoListUsecase_Move_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Move'
+type ICrudTodoListUsecase_Move_Call struct {
+	*mock.Call
+}
+
+// Move is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moveReq entity.MoveTodoListReq
+func (_e *ICrudTodoListUsecase_Expecter) Move(ctx interface{}, moveReq interface{}) *ICrudTodoListUsecase_Move_Call {
+	return &ICrudTodoListUsecase_Move_Call{Call: _e.mock.On("Move", ctx, moveReq)}
+}
+
+func (_c *ICrudTodoListUsecase_Move_Call) Run(run func(ctx context.Context, moveReq entity.MoveTodoListReq)) *ICrudTodoListUsecase_Move_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.MoveTodoListReq
+		if args[1] != nil {
+			arg1 = args[1].(entity.MoveTodoListReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_Move_Call) Return(todoListResponse *entity.TodoListResponse, err error) *ICrudTodoListUsecase_Move_Call {
+	_c.Call.Return(todoListResponse, err)
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_Move_Call) RunAndReturn(run func(ctx context.Context, moveReq entity.MoveTodoListReq) (*entity.TodoListResponse, error)) *ICrudTodoListUsecase_Move_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchByID provides a mock function for the type ICrudTodoListUsecase
+func (_mock *ICrudTodoListUsecase) PatchByID(ctx context.Context, patchReq entity.PatchTodoListReq) (*entity.TodoListResponse, error) {
+	ret := _mock.Called(ctx, patchReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchByID")
+	}
+
+	var r0 *entity.TodoListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.PatchTodoListReq) (*entity.TodoListResponse, error)); ok {
+		return returnFunc(ctx, patchReq)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.PatchTodoListReq) *entity.TodoListResponse); ok {
+		r0 = returnFunc(ctx, patchReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TodoListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.PatchTodoListReq) error); ok {
+		r1 = returnFunc(ctx, patchReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ICrudTodoListUsecase_PatchByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchByID'
+type ICrudTodoListUsecase_PatchByID_Call struct {
+	*mock.Call
+}
+
+// PatchByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - patchReq entity.PatchTodoListReq
+func (_e *ICrudTodoListUsecase_Expecter) PatchByID(ctx interface{}, patchReq interface{}) *ICrudTodoListUsecase_PatchByID_Call {
+	return &ICrudTodoListUsecase_PatchByID_Call{Call: _e.mock.On("PatchByID", ctx, patchReq)}
+}
+
+func (_c *ICrudTodoListUsecase_PatchByID_Call) Run(run func(ctx context.Context, patchReq entity.PatchTodoListReq)) *ICrudTodoListUsecase_PatchByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.PatchTodoListReq
+		if args[1] != nil {
+			arg1 = args[1].(entity.PatchTodoListReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_PatchByID_Call) Return(res *entity.TodoListResponse, err error) *ICrudTodoListUsecase_PatchByID_Call {
+	_c.Call.Return(res, err)
+	return _c
+}
+
+func (_c *ICrudTodoListUsecase_PatchByID_Call) RunAndReturn(run func(ctx context.Context, patchReq entity.PatchTodoListReq) (*entity.TodoListResponse, error)) *ICrudTodoListUsecase_PatchByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateByID provides a mock function for the type ICrudTodoListUsecase
-func (_mock *ICrudTodoListUsecase) UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) error {
+func (_mock *ICrudTodoListUsecase) UpdateByID(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error) {
 	ret := _mock.Called(ctx, todoListReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateByID")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.TodoListReq) error); ok {
+	var r0 *entity.TodoListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.TodoListReq) (*entity.TodoListResponse, error)); ok {
+		return returnFunc(ctx, todoListReq)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.TodoListReq) *entity.TodoListResponse); ok {
 		r0 = returnFunc(ctx, todoListReq)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TodoListResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.TodoListReq) error); ok {
+		r1 = returnFunc(ctx, todoListReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ICrudTodoListUsecase_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
@@ -346,12 +647,12 @@ func (_c *ICrudTodoListUsecase_UpdateByID_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *ICrudTodoListUsecase_UpdateByID_Call) Return(err error) *ICrudTodoListUsecase_UpdateByID_Call {
-	_c.Call.Return(err)
+func (_c *ICrudTodoListUsecase_UpdateByID_Call) Return(todoListResponse *entity.TodoListResponse, err error) *ICrudTodoListUsecase_UpdateByID_Call {
+	_c.Call.Return(todoListResponse, err)
 	return _c
 }
 
-func (_c *ICrudTodoListUsecase_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, todoListReq entity.TodoListReq) error) *ICrudTodoListUsecase_UpdateByID_Call {
+func (_c *ICrudTodoListUsecase_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, todoListReq entity.TodoListReq) (*entity.TodoListResponse, error)) *ICrudTodoListUsecase_UpdateByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,7 +6,9 @@ package mocks
 
 import (
 	"context"
+	"time"
 
+	entity0 "github.com/rahmatrdn/go-skeleton/entity"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql"
 	"github.com/rahmatrdn/go-skeleton/internal/repository/mysql/entity"
 	mock "github.com/stretchr/testify/mock"
@@ -94,6 +96,144 @@ func (_c *ITodoListRepository_Begin_Call) RunAndReturn(run func() (mysql.TrxObj,
 	return _c
 }
 
+// BulkCreate provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) BulkCreate(ctx context.Context, dbTrx mysql.TrxObj, params []*entity.TodoList, batchSize int) error {
+	ret := _mock.Called(ctx, dbTrx, params, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, []*entity.TodoList, int) error); ok {
+		r0 = returnFunc(ctx, dbTrx, params, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ITodoListRepository_BulkCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreate'
+type ITodoListRepository_BulkCreate_Call struct {
+	*mock.Call
+}
+
+// BulkCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - params []*entity.TodoList
+//   - batchSize int
+func (_e *ITodoListRepository_Expecter) BulkCreate(ctx interface{}, dbTrx interface{}, params interface{}, batchSize interface{}) *ITodoListRepository_BulkCreate_Call {
+	return &ITodoListRepository_BulkCreate_Call{Call: _e.mock.On("BulkCreate", ctx, dbTrx, params, batchSize)}
+}
+
+func (_c *ITodoListRepository_BulkCreate_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, params []*entity.TodoList, batchSize int)) *ITodoListRepository_BulkCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 []*entity.TodoList
+		if args[2] != nil {
+			arg2 = args[2].([]*entity.TodoList)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_BulkCreate_Call) Return(err error) *ITodoListRepository_BulkCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ITodoListRepository_BulkCreate_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, params []*entity.TodoList, batchSize int) error) *ITodoListRepository_BulkCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChunkByUserID provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) ChunkByUserID(ctx context.Context, userID int64, batchSize int, fn func(result []*entity.TodoList) error) error {
+	ret := _mock.Called(ctx, userID, batchSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChunkByUserID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, func(result []*entity.TodoList) error) error); ok {
+		r0 = returnFunc(ctx, userID, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ITodoListRepository_ChunkByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkByUserID'
+type ITodoListRepository_ChunkByUserID_Call struct {
+	*mock.Call
+}
+
+// ChunkByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - batchSize int
+//   - fn func(result []*entity.TodoList) error
+func (_e *ITodoListRepository_Expecter) ChunkByUserID(ctx interface{}, userID interface{}, batchSize interface{}, fn interface{}) *ITodoListRepository_ChunkByUserID_Call {
+	return &ITodoListRepository_ChunkByUserID_Call{Call: _e.mock.On("ChunkByUserID", ctx, userID, batchSize, fn)}
+}
+
+func (_c *ITodoListRepository_ChunkByUserID_Call) Run(run func(ctx context.Context, userID int64, batchSize int, fn func(result []*entity.TodoList) error)) *ITodoListRepository_ChunkByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 func(result []*entity.TodoList) error
+		if args[3] != nil {
+			arg3 = args[3].(func(result []*entity.TodoList) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_ChunkByUserID_Call) Return(err error) *ITodoListRepository_ChunkByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ITodoListRepository_ChunkByUserID_Call) RunAndReturn(run func(ctx context.Context, userID int64, batchSize int, fn func(result []*entity.TodoList) error) error) *ITodoListRepository_ChunkByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type ITodoListRepository
 func (_mock *ITodoListRepository) Create(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, nonZeroVal bool) error {
 	ret := _mock.Called(ctx, dbTrx, params, nonZeroVal)
@@ -226,6 +366,86 @@ func (_c *ITodoListRepository_DeleteByID_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetByDoingAtRange provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetByDoingAtRange(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*entity.TodoList, error) {
+	ret := _mock.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByDoingAtRange")
+	}
+
+	var r0 []*entity.TodoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]*entity.TodoList, error)); ok {
+		return returnFunc(ctx, userID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []*entity.TodoList); ok {
+		r0 = returnFunc(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TodoList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_GetByDoingAtRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByDoingAtRange'
+type ITodoListRepository_GetByDoingAtRange_Call struct {
+	*mock.Call
+}
+
+// GetByDoingAtRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *ITodoListRepository_Expecter) GetByDoingAtRange(ctx interface{}, userID interface{}, from interface{}, to interface{}) *ITodoListRepository_GetByDoingAtRange_Call {
+	return &ITodoListRepository_GetByDoingAtRange_Call{Call: _e.mock.On("GetByDoingAtRange", ctx, userID, from, to)}
+}
+
+func (_c *ITodoListRepository_GetByDoingAtRange_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time)) *ITodoListRepository_GetByDoingAtRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_GetByDoingAtRange_Call) Return(result []*entity.TodoList, err error) *ITodoListRepository_GetByDoingAtRange_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_GetByDoingAtRange_Call) RunAndReturn(run func(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*entity.TodoList, error)) *ITodoListRepository_GetByDoingAtRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type ITodoListRepository
 func (_mock *ITodoListRepository) GetByID(ctx context.Context, ID int64) (*entity.TodoList, error) {
 	ret := _mock.Called(ctx, ID)
@@ -362,128 +582,133 @@ func (_c *ITodoListRepository_GetByUserID_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
-// LockByID provides a mock function for the type ITodoListRepository
-func (_mock *ITodoListRepository) LockByID(ctx context.Context, dbTrx mysql.TrxObj, ID int64) (*entity.TodoList, error) {
-	ret := _mock.Called(ctx, dbTrx, ID)
+// GetCompletionDays provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetCompletionDays(ctx context.Context, userID int64) ([]time.Time, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LockByID")
+		panic("no return value specified for GetCompletionDays")
 	}
 
-	var r0 *entity.TodoList
+	var r0 []time.Time
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) (*entity.TodoList, error)); ok {
-		return returnFunc(ctx, dbTrx, ID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]time.Time, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) *entity.TodoList); ok {
-		r0 = returnFunc(ctx, dbTrx, ID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []time.Time); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.TodoList)
+			r0 = ret.Get(0).([]time.Time)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, mysql.TrxObj, int64) error); ok {
-		r1 = returnFunc(ctx, dbTrx, ID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// ITodoListRepository_LockByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByID'
-type ITodoListRepository_LockByID_Call struct {
+// ITodoListRepository_GetCompletionDays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompletionDays'
+type ITodoListRepository_GetCompletionDays_Call struct {
 	*mock.Call
 }
 
-// LockByID is a helper method to define mock.On call
+// GetCompletionDays is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dbTrx mysql.TrxObj
-//   - ID int64
-func (_e *ITodoListRepository_Expecter) LockByID(ctx interface{}, dbTrx interface{}, ID interface{}) *ITodoListRepository_LockByID_Call {
-	return &ITodoListRepository_LockByID_Call{Call: _e.mock.On("LockByID", ctx, dbTrx, ID)}
+//   - userID int64
+func (_e *ITodoListRepository_Expecter) GetCompletionDays(ctx interface{}, userID interface{}) *ITodoListRepository_GetCompletionDays_Call {
+	return &ITodoListRepository_GetCompletionDays_Call{Call: _e.mock.On("GetCompletionDays", ctx, userID)}
 }
 
-func (_c *ITodoListRepository_LockByID_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, ID int64)) *ITodoListRepository_LockByID_Call {
+func (_c *ITodoListRepository_GetCompletionDays_Call) Run(run func(ctx context.Context, userID int64)) *ITodoListRepository_GetCompletionDays_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 mysql.TrxObj
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(mysql.TrxObj)
-		}
-		var arg2 int64
-		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *ITodoListRepository_LockByID_Call) Return(result *entity.TodoList, err error) *ITodoListRepository_LockByID_Call {
+func (_c *ITodoListRepository_GetCompletionDays_Call) Return(result []time.Time, err error) *ITodoListRepository_GetCompletionDays_Call {
 	_c.Call.Return(result, err)
 	return _c
 }
 
-func (_c *ITodoListRepository_LockByID_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, ID int64) (*entity.TodoList, error)) *ITodoListRepository_LockByID_Call {
+func (_c *ITodoListRepository_GetCompletionDays_Call) RunAndReturn(run func(ctx context.Context, userID int64) ([]time.Time, error)) *ITodoListRepository_GetCompletionDays_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type ITodoListRepository
-func (_mock *ITodoListRepository) Update(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList) error {
-	ret := _mock.Called(ctx, dbTrx, params, changes)
+// GetDailyCount provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetDailyCount(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*entity.TodoListDailyCount, error) {
+	ret := _mock.Called(ctx, userID, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetDailyCount")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, *entity.TodoList, *entity.TodoList) error); ok {
-		r0 = returnFunc(ctx, dbTrx, params, changes)
+	var r0 []*entity.TodoListDailyCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]*entity.TodoListDailyCount, error)); ok {
+		return returnFunc(ctx, userID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []*entity.TodoListDailyCount); ok {
+		r0 = returnFunc(ctx, userID, from, to)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TodoListDailyCount)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// ITodoListRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type ITodoListRepository_Update_Call struct {
+// ITodoListRepository_GetDailyCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyCount'
+type ITodoListRepository_GetDailyCount_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// GetDailyCount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dbTrx mysql.TrxObj
-//   - params *entity.TodoList
-//   - changes *entity.TodoList
-func (_e *ITodoListRepository_Expecter) Update(ctx interface{}, dbTrx interface{}, params interface{}, changes interface{}) *ITodoListRepository_Update_Call {
-	return &ITodoListRepository_Update_Call{Call: _e.mock.On("Update", ctx, dbTrx, params, changes)}
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *ITodoListRepository_Expecter) GetDailyCount(ctx interface{}, userID interface{}, from interface{}, to interface{}) *ITodoListRepository_GetDailyCount_Call {
+	return &ITodoListRepository_GetDailyCount_Call{Call: _e.mock.On("GetDailyCount", ctx, userID, from, to)}
 }
 
-func (_c *ITodoListRepository_Update_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList)) *ITodoListRepository_Update_Call {
+func (_c *ITodoListRepository_GetDailyCount_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time)) *ITodoListRepository_GetDailyCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 mysql.TrxObj
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(mysql.TrxObj)
+			arg1 = args[1].(int64)
 		}
-		var arg2 *entity.TodoList
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(*entity.TodoList)
+			arg2 = args[2].(time.Time)
 		}
-		var arg3 *entity.TodoList
+		var arg3 time.Time
 		if args[3] != nil {
-			arg3 = args[3].(*entity.TodoList)
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
@@ -495,12 +720,649 @@ func (_c *ITodoListRepository_Update_Call) Run(run func(ctx context.Context, dbT
 	return _c
 }
 
-func (_c *ITodoListRepository_Update_Call) Return(err error) *ITodoListRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *ITodoListRepository_GetDailyCount_Call) Return(result []*entity.TodoListDailyCount, err error) *ITodoListRepository_GetDailyCount_Call {
+	_c.Call.Return(result, err)
 	return _c
 }
 
-func (_c *ITodoListRepository_Update_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList) error) *ITodoListRepository_Update_Call {
+func (_c *ITodoListRepository_GetDailyCount_Call) RunAndReturn(run func(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*entity.TodoListDailyCount, error)) *ITodoListRepository_GetDailyCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastPosition provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetLastPosition(ctx context.Context, dbTrx mysql.TrxObj, userID int64) (string, error) {
+	ret := _mock.Called(ctx, dbTrx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) (string, error)); ok {
+		return returnFunc(ctx, dbTrx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) string); ok {
+		r0 = returnFunc(ctx, dbTrx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, mysql.TrxObj, int64) error); ok {
+		r1 = returnFunc(ctx, dbTrx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_GetLastPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastPosition'
+type ITodoListRepository_GetLastPosition_Call struct {
+	*mock.Call
+}
+
+// GetLastPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - userID int64
+func (_e *ITodoListRepository_Expecter) GetLastPosition(ctx interface{}, dbTrx interface{}, userID interface{}) *ITodoListRepository_GetLastPosition_Call {
+	return &ITodoListRepository_GetLastPosition_Call{Call: _e.mock.On("GetLastPosition", ctx, dbTrx, userID)}
+}
+
+func (_c *ITodoListRepository_GetLastPosition_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64)) *ITodoListRepository_GetLastPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_GetLastPosition_Call) Return(position string, err error) *ITodoListRepository_GetLastPosition_Call {
+	_c.Call.Return(position, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_GetLastPosition_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64) (string, error)) *ITodoListRepository_GetLastPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextPosition provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetNextPosition(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64) (string, error) {
+	ret := _mock.Called(ctx, dbTrx, userID, position, excludeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64, string, int64) (string, error)); ok {
+		return returnFunc(ctx, dbTrx, userID, position, excludeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64, string, int64) string); ok {
+		r0 = returnFunc(ctx, dbTrx, userID, position, excludeID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, mysql.TrxObj, int64, string, int64) error); ok {
+		r1 = returnFunc(ctx, dbTrx, userID, position, excludeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_GetNextPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextPosition'
+type ITodoListRepository_GetNextPosition_Call struct {
+	*mock.Call
+}
+
+// GetNextPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - userID int64
+//   - position string
+//   - excludeID int64
+func (_e *ITodoListRepository_Expecter) GetNextPosition(ctx interface{}, dbTrx interface{}, userID interface{}, position interface{}, excludeID interface{}) *ITodoListRepository_GetNextPosition_Call {
+	return &ITodoListRepository_GetNextPosition_Call{Call: _e.mock.On("GetNextPosition", ctx, dbTrx, userID, position, excludeID)}
+}
+
+func (_c *ITodoListRepository_GetNextPosition_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64)) *ITodoListRepository_GetNextPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_GetNextPosition_Call) Return(result string, err error) *ITodoListRepository_GetNextPosition_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_GetNextPosition_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64) (string, error)) *ITodoListRepository_GetNextPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrevPosition provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetPrevPosition(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64) (string, error) {
+	ret := _mock.Called(ctx, dbTrx, userID, position, excludeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrevPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64, string, int64) (string, error)); ok {
+		return returnFunc(ctx, dbTrx, userID, position, excludeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64, string, int64) string); ok {
+		r0 = returnFunc(ctx, dbTrx, userID, position, excludeID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, mysql.TrxObj, int64, string, int64) error); ok {
+		r1 = returnFunc(ctx, dbTrx, userID, position, excludeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_GetPrevPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrevPosition'
+type ITodoListRepository_GetPrevPosition_Call struct {
+	*mock.Call
+}
+
+// GetPrevPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - userID int64
+//   - position string
+//   - excludeID int64
+func (_e *ITodoListRepository_Expecter) GetPrevPosition(ctx interface{}, dbTrx interface{}, userID interface{}, position interface{}, excludeID interface{}) *ITodoListRepository_GetPrevPosition_Call {
+	return &ITodoListRepository_GetPrevPosition_Call{Call: _e.mock.On("GetPrevPosition", ctx, dbTrx, userID, position, excludeID)}
+}
+
+func (_c *ITodoListRepository_GetPrevPosition_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64)) *ITodoListRepository_GetPrevPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_GetPrevPosition_Call) Return(result string, err error) *ITodoListRepository_GetPrevPosition_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_GetPrevPosition_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, userID int64, position string, excludeID int64) (string, error)) *ITodoListRepository_GetPrevPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatusCount provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) GetStatusCount(ctx context.Context, userID int64, from time.Time, to time.Time, today time.Time) (*entity.TodoListStatusCount, error) {
+	ret := _mock.Called(ctx, userID, from, to, today)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusCount")
+	}
+
+	var r0 *entity.TodoListStatusCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, time.Time) (*entity.TodoListStatusCount, error)); ok {
+		return returnFunc(ctx, userID, from, to, today)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, time.Time) *entity.TodoListStatusCount); ok {
+		r0 = returnFunc(ctx, userID, from, to, today)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TodoListStatusCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, from, to, today)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_GetStatusCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusCount'
+type ITodoListRepository_GetStatusCount_Call struct {
+	*mock.Call
+}
+
+// GetStatusCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+//   - today time.Time
+func (_e *ITodoListRepository_Expecter) GetStatusCount(ctx interface{}, userID interface{}, from interface{}, to interface{}, today interface{}) *ITodoListRepository_GetStatusCount_Call {
+	return &ITodoListRepository_GetStatusCount_Call{Call: _e.mock.On("GetStatusCount", ctx, userID, from, to, today)}
+}
+
+func (_c *ITodoListRepository_GetStatusCount_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, today time.Time)) *ITodoListRepository_GetStatusCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_GetStatusCount_Call) Return(result *entity.TodoListStatusCount, err error) *ITodoListRepository_GetStatusCount_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_GetStatusCount_Call) RunAndReturn(run func(ctx context.Context, userID int64, from time.Time, to time.Time, today time.Time) (*entity.TodoListStatusCount, error)) *ITodoListRepository_GetStatusCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockByID provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) LockByID(ctx context.Context, dbTrx mysql.TrxObj, ID int64) (*entity.TodoList, error) {
+	ret := _mock.Called(ctx, dbTrx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByID")
+	}
+
+	var r0 *entity.TodoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) (*entity.TodoList, error)); ok {
+		return returnFunc(ctx, dbTrx, ID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, int64) *entity.TodoList); ok {
+		r0 = returnFunc(ctx, dbTrx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TodoList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, mysql.TrxObj, int64) error); ok {
+		r1 = returnFunc(ctx, dbTrx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_LockByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByID'
+type ITodoListRepository_LockByID_Call struct {
+	*mock.Call
+}
+
+// LockByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - ID int64
+func (_e *ITodoListRepository_Expecter) LockByID(ctx interface{}, dbTrx interface{}, ID interface{}) *ITodoListRepository_LockByID_Call {
+	return &ITodoListRepository_LockByID_Call{Call: _e.mock.On("LockByID", ctx, dbTrx, ID)}
+}
+
+func (_c *ITodoListRepository_LockByID_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, ID int64)) *ITodoListRepository_LockByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_LockByID_Call) Return(result *entity.TodoList, err error) *ITodoListRepository_LockByID_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_LockByID_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, ID int64) (*entity.TodoList, error)) *ITodoListRepository_LockByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) Search(ctx context.Context, userID int64, query string, limit int, offset int) ([]*entity0.TodoListSearchResult, error) {
+	ret := _mock.Called(ctx, userID, query, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*entity0.TodoListSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, int, int) ([]*entity0.TodoListSearchResult, error)); ok {
+		return returnFunc(ctx, userID, query, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, int, int) []*entity0.TodoListSearchResult); ok {
+		r0 = returnFunc(ctx, userID, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity0.TodoListSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, query, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ITodoListRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type ITodoListRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - query string
+//   - limit int
+//   - offset int
+func (_e *ITodoListRepository_Expecter) Search(ctx interface{}, userID interface{}, query interface{}, limit interface{}, offset interface{}) *ITodoListRepository_Search_Call {
+	return &ITodoListRepository_Search_Call{Call: _e.mock.On("Search", ctx, userID, query, limit, offset)}
+}
+
+func (_c *ITodoListRepository_Search_Call) Run(run func(ctx context.Context, userID int64, query string, limit int, offset int)) *ITodoListRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_Search_Call) Return(result []*entity0.TodoListSearchResult, err error) *ITodoListRepository_Search_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *ITodoListRepository_Search_Call) RunAndReturn(run func(ctx context.Context, userID int64, query string, limit int, offset int) ([]*entity0.TodoListSearchResult, error)) *ITodoListRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) Update(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList) error {
+	ret := _mock.Called(ctx, dbTrx, params, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, *entity.TodoList, *entity.TodoList) error); ok {
+		r0 = returnFunc(ctx, dbTrx, params, changes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ITodoListRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ITodoListRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - params *entity.TodoList
+//   - changes *entity.TodoList
+func (_e *ITodoListRepository_Expecter) Update(ctx interface{}, dbTrx interface{}, params interface{}, changes interface{}) *ITodoListRepository_Update_Call {
+	return &ITodoListRepository_Update_Call{Call: _e.mock.On("Update", ctx, dbTrx, params, changes)}
+}
+
+func (_c *ITodoListRepository_Update_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList)) *ITodoListRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 *entity.TodoList
+		if args[2] != nil {
+			arg2 = args[2].(*entity.TodoList)
+		}
+		var arg3 *entity.TodoList
+		if args[3] != nil {
+			arg3 = args[3].(*entity.TodoList)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_Update_Call) Return(err error) *ITodoListRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ITodoListRepository_Update_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList) error) *ITodoListRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateColumns provides a mock function for the type ITodoListRepository
+func (_mock *ITodoListRepository) UpdateColumns(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList, columns ...string) error {
+	var tmpRet mock.Arguments
+	if len(columns) > 0 {
+		tmpRet = _mock.Called(ctx, dbTrx, params, changes, columns)
+	} else {
+		tmpRet = _mock.Called(ctx, dbTrx, params, changes)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumns")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, mysql.TrxObj, *entity.TodoList, *entity.TodoList, ...string) error); ok {
+		r0 = returnFunc(ctx, dbTrx, params, changes, columns...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ITodoListRepository_UpdateColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateColumns'
+type ITodoListRepository_UpdateColumns_Call struct {
+	*mock.Call
+}
+
+// UpdateColumns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTrx mysql.TrxObj
+//   - params *entity.TodoList
+//   - changes *entity.TodoList
+//   - columns ...string
+func (_e *ITodoListRepository_Expecter) UpdateColumns(ctx interface{}, dbTrx interface{}, params interface{}, changes interface{}, columns ...interface{}) *ITodoListRepository_UpdateColumns_Call {
+	return &ITodoListRepository_UpdateColumns_Call{Call: _e.mock.On("UpdateColumns",
+		append([]interface{}{ctx, dbTrx, params, changes}, columns...)...)}
+}
+
+func (_c *ITodoListRepository_UpdateColumns_Call) Run(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList, columns ...string)) *ITodoListRepository_UpdateColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 mysql.TrxObj
+		if args[1] != nil {
+			arg1 = args[1].(mysql.TrxObj)
+		}
+		var arg2 *entity.TodoList
+		if args[2] != nil {
+			arg2 = args[2].(*entity.TodoList)
+		}
+		var arg3 *entity.TodoList
+		if args[3] != nil {
+			arg3 = args[3].(*entity.TodoList)
+		}
+		var arg4 []string
+		if len(args) > 4 {
+			arg4 = args[4].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *ITodoListRepository_UpdateColumns_Call) Return(err error) *ITodoListRepository_UpdateColumns_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ITodoListRepository_UpdateColumns_Call) RunAndReturn(run func(ctx context.Context, dbTrx mysql.TrxObj, params *entity.TodoList, changes *entity.TodoList, columns ...string) error) *ITodoListRepository_UpdateColumns_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/rahmatrdn/go-skeleton/entity"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -94,6 +96,70 @@ func (_c *LogUsecase_Error_Call) RunAndReturn(run func(process string, funcName
 	return _c
 }
 
+// ErrorContext provides a mock function for the type LogUsecase
+func (_mock *LogUsecase) ErrorContext(ctx context.Context, process string, funcName string, err error, logFields map[string]string) {
+	_mock.Called(ctx, process, funcName, err, logFields)
+	return
+}
+
+// LogUsecase_ErrorContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ErrorContext'
+type LogUsecase_ErrorContext_Call struct {
+	*mock.Call
+}
+
+// ErrorContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - process string
+//   - funcName string
+//   - err error
+//   - logFields map[string]string
+func (_e *LogUsecase_Expecter) ErrorContext(ctx interface{}, process interface{}, funcName interface{}, err interface{}, logFields interface{}) *LogUsecase_ErrorContext_Call {
+	return &LogUsecase_ErrorContext_Call{Call: _e.mock.On("ErrorContext", ctx, process, funcName, err, logFields)}
+}
+
+func (_c *LogUsecase_ErrorContext_Call) Run(run func(ctx context.Context, process string, funcName string, err error, logFields map[string]string)) *LogUsecase_ErrorContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 error
+		if args[3] != nil {
+			arg3 = args[3].(error)
+		}
+		var arg4 map[string]string
+		if args[4] != nil {
+			arg4 = args[4].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *LogUsecase_ErrorContext_Call) Return() *LogUsecase_ErrorContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LogUsecase_ErrorContext_Call) RunAndReturn(run func(ctx context.Context, process string, funcName string, err error, logFields map[string]string)) *LogUsecase_ErrorContext_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Info provides a mock function for the type LogUsecase
 func (_mock *LogUsecase) Info(message string, funcName string, logFields map[string]string, processName string) {
 	_mock.Called(message, funcName, logFields, processName)
@@ -152,6 +218,70 @@ func (_c *LogUsecase_Info_Call) RunAndReturn(run func(message string, funcName s
 	return _c
 }
 
+// InfoContext provides a mock function for the type LogUsecase
+func (_mock *LogUsecase) InfoContext(ctx context.Context, message string, funcName string, logFields map[string]string, processName string) {
+	_mock.Called(ctx, message, funcName, logFields, processName)
+	return
+}
+
+// LogUsecase_InfoContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InfoContext'
+type LogUsecase_InfoContext_Call struct {
+	*mock.Call
+}
+
+// InfoContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message string
+//   - funcName string
+//   - logFields map[string]string
+//   - processName string
+func (_e *LogUsecase_Expecter) InfoContext(ctx interface{}, message interface{}, funcName interface{}, logFields interface{}, processName interface{}) *LogUsecase_InfoContext_Call {
+	return &LogUsecase_InfoContext_Call{Call: _e.mock.On("InfoContext", ctx, message, funcName, logFields, processName)}
+}
+
+func (_c *LogUsecase_InfoContext_Call) Run(run func(ctx context.Context, message string, funcName string, logFields map[string]string, processName string)) *LogUsecase_InfoContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *LogUsecase_InfoContext_Call) Return() *LogUsecase_InfoContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LogUsecase_InfoContext_Call) RunAndReturn(run func(ctx context.Context, message string, funcName string, logFields map[string]string, processName string)) *LogUsecase_InfoContext_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Log provides a mock function for the type LogUsecase
 func (_mock *LogUsecase) Log(status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string) {
 	_mock.Called(status, message, funcName, err, logFields, processName)
@@ -221,3 +351,79 @@ func (_c *LogUsecase_Log_Call) RunAndReturn(run func(status entity.LogType, mess
 	_c.Run(run)
 	return _c
 }
+
+// LogContext provides a mock function for the type LogUsecase
+func (_mock *LogUsecase) LogContext(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string) {
+	_mock.Called(ctx, status, message, funcName, err, logFields, processName)
+	return
+}
+
+// LogUsecase_LogContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogContext'
+type LogUsecase_LogContext_Call struct {
+	*mock.Call
+}
+
+// LogContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status entity.LogType
+//   - message string
+//   - funcName string
+//   - err error
+//   - logFields map[string]string
+//   - processName string
+func (_e *LogUsecase_Expecter) LogContext(ctx interface{}, status interface{}, message interface{}, funcName interface{}, err interface{}, logFields interface{}, processName interface{}) *LogUsecase_LogContext_Call {
+	return &LogUsecase_LogContext_Call{Call: _e.mock.On("LogContext", ctx, status, message, funcName, err, logFields, processName)}
+}
+
+func (_c *LogUsecase_LogContext_Call) Run(run func(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string)) *LogUsecase_LogContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.LogType
+		if args[1] != nil {
+			arg1 = args[1].(entity.LogType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 error
+		if args[4] != nil {
+			arg4 = args[4].(error)
+		}
+		var arg5 map[string]string
+		if args[5] != nil {
+			arg5 = args[5].(map[string]string)
+		}
+		var arg6 string
+		if args[6] != nil {
+			arg6 = args[6].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *LogUsecase_LogContext_Call) Return() *LogUsecase_LogContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LogUsecase_LogContext_Call) RunAndReturn(run func(ctx context.Context, status entity.LogType, message string, funcName string, err error, logFields map[string]string, processName string)) *LogUsecase_LogContext_Call {
+	_c.Run(run)
+	return _c
+}
@@ -382,6 +382,68 @@ func (_c *Parser_ParserIntIDFromPathParams_Call) RunAndReturn(run func(c *fiber.
 	return _c
 }
 
+// ParserPatchRequest provides a mock function for the type Parser
+func (_mock *Parser) ParserPatchRequest(c *fiber.Ctx) (parser.MergePatch, error) {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParserPatchRequest")
+	}
+
+	var r0 parser.MergePatch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*fiber.Ctx) (parser.MergePatch, error)); ok {
+		return returnFunc(c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*fiber.Ctx) parser.MergePatch); ok {
+		r0 = returnFunc(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(parser.MergePatch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*fiber.Ctx) error); ok {
+		r1 = returnFunc(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Parser_ParserPatchRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParserPatchRequest'
+type Parser_ParserPatchRequest_Call struct {
+	*mock.Call
+}
+
+// ParserPatchRequest is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *Parser_Expecter) ParserPatchRequest(c interface{}) *Parser_ParserPatchRequest_Call {
+	return &Parser_ParserPatchRequest_Call{Call: _e.mock.On("ParserPatchRequest", c)}
+}
+
+func (_c *Parser_ParserPatchRequest_Call) Run(run func(c *fiber.Ctx)) *Parser_ParserPatchRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *fiber.Ctx
+		if args[0] != nil {
+			arg0 = args[0].(*fiber.Ctx)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Parser_ParserPatchRequest_Call) Return(mergePatch parser.MergePatch, err error) *Parser_ParserPatchRequest_Call {
+	_c.Call.Return(mergePatch, err)
+	return _c
+}
+
+func (_c *Parser_ParserPatchRequest_Call) RunAndReturn(run func(c *fiber.Ctx) (parser.MergePatch, error)) *Parser_ParserPatchRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ParserUserID provides a mock function for the type Parser
 func (_mock *Parser) ParserUserID(c *fiber.Ctx) (int64, error) {
 	ret := _mock.Called(c)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/rabbitmq/amqp091-go"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -293,6 +295,75 @@ func (_c *Queue_Publish_Call) RunAndReturn(run func(key string, message []byte,
 	return _c
 }
 
+// PublishWithContext provides a mock function for the type Queue
+func (_mock *Queue) PublishWithContext(ctx context.Context, key string, message []byte, attempts int32) error {
+	ret := _mock.Called(ctx, key, message, attempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishWithContext")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, int32) error); ok {
+		r0 = returnFunc(ctx, key, message, attempts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Queue_PublishWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishWithContext'
+type Queue_PublishWithContext_Call struct {
+	*mock.Call
+}
+
+// PublishWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - message []byte
+//   - attempts int32
+func (_e *Queue_Expecter) PublishWithContext(ctx interface{}, key interface{}, message interface{}, attempts interface{}) *Queue_PublishWithContext_Call {
+	return &Queue_PublishWithContext_Call{Call: _e.mock.On("PublishWithContext", ctx, key, message, attempts)}
+}
+
+func (_c *Queue_PublishWithContext_Call) Run(run func(ctx context.Context, key string, message []byte, attempts int32)) *Queue_PublishWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Queue_PublishWithContext_Call) Return(err error) *Queue_PublishWithContext_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Queue_PublishWithContext_Call) RunAndReturn(run func(ctx context.Context, key string, message []byte, attempts int32) error) *Queue_PublishWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reconnect provides a mock function for the type Queue
 func (_mock *Queue) Reconnect() error {
 	ret := _mock.Called()
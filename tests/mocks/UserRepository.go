@@ -299,6 +299,74 @@ func (_c *UserRepository_GetByEmailAndRole_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetByID provides a mock function for the type UserRepository
+func (_mock *UserRepository) GetByID(ctx context.Context, ID int64) (*entity.User, error) {
+	ret := _mock.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*entity.User, error)); ok {
+		return returnFunc(ctx, ID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *entity.User); ok {
+		r0 = returnFunc(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UserRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID int64
+func (_e *UserRepository_Expecter) GetByID(ctx interface{}, ID interface{}) *UserRepository_GetByID_Call {
+	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, ID)}
+}
+
+func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, ID int64)) *UserRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) Return(user *entity.User, err error) *UserRepository_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, ID int64) (*entity.User, error)) *UserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LockByID provides a mock function for the type UserRepository
 func (_mock *UserRepository) LockByID(ctx context.Context, dbTrx mysql.TrxObj, ID int64) (*entity.User, error) {
 	ret := _mock.Called(ctx, dbTrx, ID)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/rahmatrdn/go-skeleton/entity"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,16 +39,16 @@ func (_m *ValidatorUsecase) EXPECT() *ValidatorUsecase_Expecter {
 }
 
 // Validate provides a mock function for the type ValidatorUsecase
-func (_mock *ValidatorUsecase) Validate(data interface{}) []entity.ErrorResponse {
-	ret := _mock.Called(data)
+func (_mock *ValidatorUsecase) Validate(ctx context.Context, data interface{}) []entity.ErrorResponse {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Validate")
 	}
 
 	var r0 []entity.ErrorResponse
-	if returnFunc, ok := ret.Get(0).(func(interface{}) []entity.ErrorResponse); ok {
-		r0 = returnFunc(data)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, interface{}) []entity.ErrorResponse); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.ErrorResponse)
@@ -61,19 +63,25 @@ type ValidatorUsecase_Validate_Call struct {
 }
 
 // Validate is a helper method to define mock.On call
+//   - ctx context.Context
 //   - data interface{}
-func (_e *ValidatorUsecase_Expecter) Validate(data interface{}) *ValidatorUsecase_Validate_Call {
-	return &ValidatorUsecase_Validate_Call{Call: _e.mock.On("Validate", data)}
+func (_e *ValidatorUsecase_Expecter) Validate(ctx interface{}, data interface{}) *ValidatorUsecase_Validate_Call {
+	return &ValidatorUsecase_Validate_Call{Call: _e.mock.On("Validate", ctx, data)}
 }
 
-func (_c *ValidatorUsecase_Validate_Call) Run(run func(data interface{})) *ValidatorUsecase_Validate_Call {
+func (_c *ValidatorUsecase_Validate_Call) Run(run func(ctx context.Context, data interface{})) *ValidatorUsecase_Validate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 interface{}
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(interface{})
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 interface{}
+		if args[1] != nil {
+			arg1 = args[1].(interface{})
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -84,58 +92,64 @@ func (_c *ValidatorUsecase_Validate_Call) Return(errorResponses []entity.ErrorRe
 	return _c
 }
 
-func (_c *ValidatorUsecase_Validate_Call) RunAndReturn(run func(data interface{}) []entity.ErrorResponse) *ValidatorUsecase_Validate_Call {
+func (_c *ValidatorUsecase_Validate_Call) RunAndReturn(run func(ctx context.Context, data interface{}) []entity.ErrorResponse) *ValidatorUsecase_Validate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ValidateWithMessage provides a mock function for the type ValidatorUsecase
-func (_mock *ValidatorUsecase) ValidateWithMessage(data interface{}) string {
-	ret := _mock.Called(data)
+// ValidateWithError provides a mock function for the type ValidatorUsecase
+func (_mock *ValidatorUsecase) ValidateWithError(ctx context.Context, data interface{}) error {
+	ret := _mock.Called(ctx, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ValidateWithMessage")
+		panic("no return value specified for ValidateWithError")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(interface{}) string); ok {
-		r0 = returnFunc(data)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, interface{}) error); ok {
+		r0 = returnFunc(ctx, data)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// ValidatorUsecase_ValidateWithMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateWithMessage'
-type ValidatorUsecase_ValidateWithMessage_Call struct {
+// ValidatorUsecase_ValidateWithError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateWithError'
+type ValidatorUsecase_ValidateWithError_Call struct {
 	*mock.Call
 }
 
-// ValidateWithMessage is a helper method to define mock.On call
+// ValidateWithError is a helper method to define mock.On call
+//   - ctx context.Context
 //   - data interface{}
-func (_e *ValidatorUsecase_Expecter) ValidateWithMessage(data interface{}) *ValidatorUsecase_ValidateWithMessage_Call {
-	return &ValidatorUsecase_ValidateWithMessage_Call{Call: _e.mock.On("ValidateWithMessage", data)}
+func (_e *ValidatorUsecase_Expecter) ValidateWithError(ctx interface{}, data interface{}) *ValidatorUsecase_ValidateWithError_Call {
+	return &ValidatorUsecase_ValidateWithError_Call{Call: _e.mock.On("ValidateWithError", ctx, data)}
 }
 
-func (_c *ValidatorUsecase_ValidateWithMessage_Call) Run(run func(data interface{})) *ValidatorUsecase_ValidateWithMessage_Call {
+func (_c *ValidatorUsecase_ValidateWithError_Call) Run(run func(ctx context.Context, data interface{})) *ValidatorUsecase_ValidateWithError_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 interface{}
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(interface{})
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 interface{}
+		if args[1] != nil {
+			arg1 = args[1].(interface{})
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *ValidatorUsecase_ValidateWithMessage_Call) Return(s string) *ValidatorUsecase_ValidateWithMessage_Call {
-	_c.Call.Return(s)
+func (_c *ValidatorUsecase_ValidateWithError_Call) Return(err error) *ValidatorUsecase_ValidateWithError_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *ValidatorUsecase_ValidateWithMessage_Call) RunAndReturn(run func(data interface{}) string) *ValidatorUsecase_ValidateWithMessage_Call {
+func (_c *ValidatorUsecase_ValidateWithError_Call) RunAndReturn(run func(ctx context.Context, data interface{}) error) *ValidatorUsecase_ValidateWithError_Call {
 	_c.Call.Return(run)
 	return _c
 }